@@ -0,0 +1,109 @@
+package audit
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryAuditEvent "github.com/anonychun/bibit/internal/repository/audit_event"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRecorder)
+}
+
+const (
+	ActionSignUp               = "auth.sign_up"
+	ActionSignInSucceeded      = "auth.sign_in.succeeded"
+	ActionSignInFailed         = "auth.sign_in.failed"
+	ActionSignOut              = "auth.sign_out"
+	ActionSessionRevoked       = "auth.session.revoked"
+	ActionOtherSessionsRevoked = "auth.other_sessions.revoked"
+	ActionAllSessionsRevoked   = "auth.all_sessions.revoked"
+)
+
+const (
+	TargetUser        = "user"
+	TargetUserSession = "user_session"
+)
+
+type Event struct {
+	Action string
+	// ActorId defaults to the current user. Set it for actions taken before
+	// there is one, such as signing up or signing in.
+	ActorId    uuid.UUID
+	TargetType string
+	TargetId   uuid.UUID
+	Changes    map[string]entity.AuditEventChange
+	Metadata   map[string]any
+}
+
+type IRecorder interface {
+	Record(ctx context.Context, event Event) error
+}
+
+type Recorder struct {
+	auditEventRepository repositoryAuditEvent.IRepository
+}
+
+var _ IRecorder = (*Recorder)(nil)
+
+func NewRecorder(i do.Injector) (*Recorder, error) {
+	return &Recorder{
+		auditEventRepository: do.MustInvoke[*repositoryAuditEvent.Repository](i),
+	}, nil
+}
+
+// Record stores event together with the actor and the request it came from.
+// It writes through ctx, so calling it inside repository.Transaction commits
+// or rolls back the event together with the change it describes.
+func (r *Recorder) Record(ctx context.Context, event Event) error {
+	auditEvent := &entity.AuditEvent{
+		Action:     event.Action,
+		ActorId:    event.ActorId,
+		TargetType: event.TargetType,
+		TargetId:   event.TargetId,
+		IpAddress:  current.IpAddress(ctx),
+		UserAgent:  current.UserAgent(ctx),
+		RequestId:  current.RequestId(ctx),
+		Changes:    event.Changes,
+		Metadata:   event.Metadata,
+	}
+
+	user := current.User(ctx)
+	if auditEvent.ActorId == uuid.Nil && user != nil {
+		auditEvent.ActorId = user.Id
+	}
+
+	impersonator := current.Impersonator(ctx)
+	if impersonator != nil {
+		auditEvent.ImpersonatorId = impersonator.Id
+	}
+
+	return r.auditEventRepository.Create(ctx, auditEvent)
+}
+
+// Diff returns the fields whose values differ between before and after. A
+// nil before describes a newly created record.
+func Diff(before, after map[string]any) map[string]entity.AuditEventChange {
+	changes := map[string]entity.AuditEventChange{}
+	for field, to := range after {
+		from := before[field]
+		if !reflect.DeepEqual(from, to) {
+			changes[field] = entity.AuditEventChange{From: from, To: to}
+		}
+	}
+
+	for field, from := range before {
+		_, ok := after[field]
+		if !ok {
+			changes[field] = entity.AuditEventChange{From: from}
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,93 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryAuditEvent "github.com/anonychun/bibit/internal/repository/audit_event"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecorder_Record(t *testing.T) {
+	t.Run("captures the current user, impersonator and request", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		impersonator := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		targetId := uuid.New()
+		ctx := current.SetUser(context.Background(), user)
+		ctx = current.SetImpersonator(ctx, impersonator)
+		ctx = current.SetRequestId(ctx, "request-id")
+		ctx = current.SetIpAddress(ctx, "192.0.2.1")
+		ctx = current.SetUserAgent(ctx, "Go test")
+		auditEventRepository := repositoryAuditEvent.NewMockIRepository(t)
+		recorder := &Recorder{auditEventRepository: auditEventRepository}
+
+		auditEventRepository.EXPECT().Create(ctx, &entity.AuditEvent{
+			Action:         ActionSessionRevoked,
+			ActorId:        user.Id,
+			ImpersonatorId: impersonator.Id,
+			TargetType:     TargetUserSession,
+			TargetId:       targetId,
+			IpAddress:      "192.0.2.1",
+			UserAgent:      "Go test",
+			RequestId:      "request-id",
+		}).Return(nil).Once()
+
+		err := recorder.Record(ctx, Event{Action: ActionSessionRevoked, TargetType: TargetUserSession, TargetId: targetId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("keeps an explicit actor when there is no current user", func(t *testing.T) {
+		ctx := context.Background()
+		actorId := uuid.New()
+		auditEventRepository := repositoryAuditEvent.NewMockIRepository(t)
+		recorder := &Recorder{auditEventRepository: auditEventRepository}
+
+		auditEventRepository.EXPECT().Create(ctx, mock.MatchedBy(func(auditEvent *entity.AuditEvent) bool {
+			return auditEvent.Action == ActionSignInSucceeded && auditEvent.ActorId == actorId && auditEvent.ImpersonatorId == uuid.Nil
+		})).Return(nil).Once()
+
+		err := recorder.Record(ctx, Event{Action: ActionSignInSucceeded, ActorId: actorId})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("create audit event")
+		auditEventRepository := repositoryAuditEvent.NewMockIRepository(t)
+		recorder := &Recorder{auditEventRepository: auditEventRepository}
+
+		auditEventRepository.EXPECT().Create(ctx, mock.Anything).Return(expectedErr).Once()
+
+		err := recorder.Record(ctx, Event{Action: ActionSignOut})
+
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestDiff(t *testing.T) {
+	t.Run("returns only the fields that changed", func(t *testing.T) {
+		changes := Diff(
+			map[string]any{"name": "Ada", "emailAddress": "ada@example.com", "nickname": "ada"},
+			map[string]any{"name": "Ada Lovelace", "emailAddress": "ada@example.com"},
+		)
+
+		assert.Equal(t, map[string]entity.AuditEventChange{
+			"name":     {From: "Ada", To: "Ada Lovelace"},
+			"nickname": {From: "ada"},
+		}, changes)
+	})
+
+	t.Run("treats a nil before as a newly created record", func(t *testing.T) {
+		changes := Diff(nil, map[string]any{"name": "Ada Lovelace"})
+
+		assert.Equal(t, map[string]entity.AuditEventChange{"name": {To: "Ada Lovelace"}}, changes)
+	})
+}
@@ -0,0 +1,364 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewClient)
+}
+
+var (
+	ErrUnknownProvider = errors.New("oidc: unknown provider")
+	ErrInvalidIdToken  = errors.New("oidc: invalid id token")
+)
+
+type AuthorizationRequest struct {
+	RedirectUri   string
+	State         string
+	Nonce         string
+	CodeChallenge string
+}
+
+type ExchangeRequest struct {
+	RedirectUri  string
+	Code         string
+	CodeVerifier string
+	Nonce        string
+}
+
+type Claims struct {
+	Subject       string    `json:"sub"`
+	Name          string    `json:"name"`
+	EmailAddress  string    `json:"email"`
+	EmailVerified claimBool `json:"email_verified"`
+}
+
+type IClient interface {
+	AuthorizationUrl(ctx context.Context, provider string, req AuthorizationRequest) (string, error)
+	Exchange(ctx context.Context, provider string, req ExchangeRequest) (*Claims, error)
+}
+
+type Client struct {
+	providers  map[string]config.OidcProvider
+	httpClient *http.Client
+
+	mu       sync.Mutex
+	metadata map[string]*providerMetadata
+}
+
+type providerMetadata struct {
+	Issuer                string `json:"issuer"`
+	AuthorizationEndpoint string `json:"authorization_endpoint"`
+	TokenEndpoint         string `json:"token_endpoint"`
+	JwksUri               string `json:"jwks_uri"`
+
+	keys map[string]*rsa.PublicKey
+}
+
+var _ IClient = (*Client)(nil)
+
+func NewClient(i do.Injector) (*Client, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	return newClient(cfg.OidcProviders(), &http.Client{Timeout: 10 * time.Second}), nil
+}
+
+func newClient(providers map[string]config.OidcProvider, httpClient *http.Client) *Client {
+	return &Client{
+		providers:  providers,
+		httpClient: httpClient,
+		metadata:   map[string]*providerMetadata{},
+	}
+}
+
+func (c *Client) AuthorizationUrl(ctx context.Context, provider string, req AuthorizationRequest) (string, error) {
+	providerConfig, metadata, err := c.discover(ctx, provider)
+	if err != nil {
+		return "", err
+	}
+
+	authorizationUrl, err := url.Parse(metadata.AuthorizationEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	query := authorizationUrl.Query()
+	query.Set("response_type", "code")
+	query.Set("client_id", providerConfig.ClientId)
+	query.Set("redirect_uri", req.RedirectUri)
+	query.Set("scope", strings.Join(providerConfig.Scopes, " "))
+	query.Set("state", req.State)
+	query.Set("nonce", req.Nonce)
+	query.Set("code_challenge", req.CodeChallenge)
+	query.Set("code_challenge_method", "S256")
+	authorizationUrl.RawQuery = query.Encode()
+
+	return authorizationUrl.String(), nil
+}
+
+func (c *Client) Exchange(ctx context.Context, provider string, req ExchangeRequest) (*Claims, error) {
+	providerConfig, metadata, err := c.discover(ctx, provider)
+	if err != nil {
+		return nil, err
+	}
+
+	form := url.Values{
+		"grant_type":    {"authorization_code"},
+		"code":          {req.Code},
+		"redirect_uri":  {req.RedirectUri},
+		"client_id":     {providerConfig.ClientId},
+		"client_secret": {providerConfig.ClientSecret},
+		"code_verifier": {req.CodeVerifier},
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, metadata.TokenEndpoint, strings.NewReader(form.Encode()))
+	if err != nil {
+		return nil, err
+	}
+	httpReq.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	httpReq.Header.Set("Accept", "application/json")
+
+	httpRes, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return nil, err
+	}
+	defer httpRes.Body.Close()
+
+	tokenRes := struct {
+		IdToken string `json:"id_token"`
+		Error   string `json:"error"`
+	}{}
+	err = json.NewDecoder(httpRes.Body).Decode(&tokenRes)
+	if err != nil {
+		return nil, err
+	}
+
+	if httpRes.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("oidc: token exchange failed with status %d: %s", httpRes.StatusCode, tokenRes.Error)
+	}
+
+	return c.verifyIdToken(ctx, providerConfig, metadata, tokenRes.IdToken, req.Nonce)
+}
+
+func (c *Client) discover(ctx context.Context, provider string) (config.OidcProvider, *providerMetadata, error) {
+	providerConfig, ok := c.providers[provider]
+	if !ok {
+		return config.OidcProvider{}, nil, ErrUnknownProvider
+	}
+
+	c.mu.Lock()
+	metadata, ok := c.metadata[provider]
+	c.mu.Unlock()
+	if ok {
+		return providerConfig, metadata, nil
+	}
+
+	metadata = &providerMetadata{}
+	err := c.getJson(ctx, strings.TrimSuffix(providerConfig.Issuer, "/")+"/.well-known/openid-configuration", metadata)
+	if err != nil {
+		return config.OidcProvider{}, nil, err
+	}
+
+	if strings.TrimSuffix(metadata.Issuer, "/") != strings.TrimSuffix(providerConfig.Issuer, "/") {
+		return config.OidcProvider{}, nil, fmt.Errorf("oidc: discovered issuer %q does not match %q", metadata.Issuer, providerConfig.Issuer)
+	}
+
+	c.mu.Lock()
+	c.metadata[provider] = metadata
+	c.mu.Unlock()
+
+	return providerConfig, metadata, nil
+}
+
+func (c *Client) verifyIdToken(ctx context.Context, providerConfig config.OidcProvider, metadata *providerMetadata, rawIdToken, nonce string) (*Claims, error) {
+	parts := strings.Split(rawIdToken, ".")
+	if len(parts) != 3 {
+		return nil, ErrInvalidIdToken
+	}
+
+	header := struct {
+		Alg string `json:"alg"`
+		Kid string `json:"kid"`
+	}{}
+	err := decodeSegment(parts[0], &header)
+	if err != nil || header.Alg != "RS256" {
+		return nil, ErrInvalidIdToken
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(parts[2])
+	if err != nil {
+		return nil, ErrInvalidIdToken
+	}
+
+	key, err := c.publicKey(ctx, metadata, header.Kid)
+	if err != nil {
+		return nil, err
+	}
+
+	digest := sha256.Sum256([]byte(parts[0] + "." + parts[1]))
+	err = rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], signature)
+	if err != nil {
+		return nil, ErrInvalidIdToken
+	}
+
+	idToken := struct {
+		Claims
+		Issuer    string   `json:"iss"`
+		Audience  audience `json:"aud"`
+		ExpiresAt int64    `json:"exp"`
+		Nonce     string   `json:"nonce"`
+	}{}
+	err = decodeSegment(parts[1], &idToken)
+	if err != nil {
+		return nil, ErrInvalidIdToken
+	}
+
+	switch {
+	case idToken.Issuer != metadata.Issuer,
+		!slices.Contains(idToken.Audience, providerConfig.ClientId),
+		!time.Now().Before(time.Unix(idToken.ExpiresAt, 0)),
+		subtle.ConstantTimeCompare([]byte(idToken.Nonce), []byte(nonce)) != 1,
+		idToken.Subject == "":
+		return nil, ErrInvalidIdToken
+	}
+
+	return &idToken.Claims, nil
+}
+
+// publicKey refetches the key set once when kid is unknown so that provider
+// key rotation does not require a restart.
+func (c *Client) publicKey(ctx context.Context, metadata *providerMetadata, kid string) (*rsa.PublicKey, error) {
+	c.mu.Lock()
+	key, ok := metadata.keys[kid]
+	c.mu.Unlock()
+	if ok {
+		return key, nil
+	}
+
+	jwks := struct {
+		Keys []struct {
+			Kty string `json:"kty"`
+			Kid string `json:"kid"`
+			N   string `json:"n"`
+			E   string `json:"e"`
+		} `json:"keys"`
+	}{}
+	err := c.getJson(ctx, metadata.JwksUri, &jwks)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := map[string]*rsa.PublicKey{}
+	for _, jwk := range jwks.Keys {
+		if jwk.Kty != "RSA" {
+			continue
+		}
+
+		n, err := base64.RawURLEncoding.DecodeString(jwk.N)
+		if err != nil {
+			continue
+		}
+
+		e, err := base64.RawURLEncoding.DecodeString(jwk.E)
+		if err != nil {
+			continue
+		}
+
+		keys[jwk.Kid] = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	}
+
+	c.mu.Lock()
+	metadata.keys = keys
+	c.mu.Unlock()
+
+	key, ok = keys[kid]
+	if !ok {
+		return nil, ErrInvalidIdToken
+	}
+
+	return key, nil
+}
+
+func (c *Client) getJson(ctx context.Context, endpoint string, v any) error {
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return err
+	}
+	httpReq.Header.Set("Accept", "application/json")
+
+	httpRes, err := c.httpClient.Do(httpReq)
+	if err != nil {
+		return err
+	}
+	defer httpRes.Body.Close()
+
+	if httpRes.StatusCode != http.StatusOK {
+		return fmt.Errorf("oidc: GET %s failed with status %d", endpoint, httpRes.StatusCode)
+	}
+
+	return json.NewDecoder(httpRes.Body).Decode(v)
+}
+
+func decodeSegment(segment string, v any) error {
+	data, err := base64.RawURLEncoding.DecodeString(segment)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+type audience []string
+
+func (a *audience) UnmarshalJSON(data []byte) error {
+	var single string
+	if json.Unmarshal(data, &single) == nil {
+		*a = audience{single}
+		return nil
+	}
+
+	var multiple []string
+	err := json.Unmarshal(data, &multiple)
+	if err != nil {
+		return err
+	}
+
+	*a = multiple
+	return nil
+}
+
+// claimBool accepts both JSON booleans and the "true"/"false" strings some
+// providers send for email_verified.
+type claimBool bool
+
+func (b *claimBool) UnmarshalJSON(data []byte) error {
+	switch strings.Trim(string(data), `"`) {
+	case "true":
+		*b = true
+	case "false", "null":
+		*b = false
+	default:
+		return fmt.Errorf("oidc: invalid boolean claim %s", data)
+	}
+
+	return nil
+}
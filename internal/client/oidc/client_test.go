@@ -0,0 +1,245 @@
+package oidc
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_AuthorizationUrl(t *testing.T) {
+	t.Run("builds an authorization code request with pkce, state and nonce", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := provider.client()
+
+		authorizationUrl, err := client.AuthorizationUrl(context.Background(), "test", AuthorizationRequest{
+			RedirectUri:   "http://localhost:3000/auth/oidc/test/callback",
+			State:         "state",
+			Nonce:         "nonce",
+			CodeChallenge: "challenge",
+		})
+
+		require.NoError(t, err)
+		parsedUrl, err := url.Parse(authorizationUrl)
+		require.NoError(t, err)
+		assert.Equal(t, provider.server.URL+"/authorize", parsedUrl.Scheme+"://"+parsedUrl.Host+parsedUrl.Path)
+
+		query := parsedUrl.Query()
+		assert.Equal(t, "code", query.Get("response_type"))
+		assert.Equal(t, "client-id", query.Get("client_id"))
+		assert.Equal(t, "http://localhost:3000/auth/oidc/test/callback", query.Get("redirect_uri"))
+		assert.Equal(t, "openid email profile", query.Get("scope"))
+		assert.Equal(t, "state", query.Get("state"))
+		assert.Equal(t, "nonce", query.Get("nonce"))
+		assert.Equal(t, "challenge", query.Get("code_challenge"))
+		assert.Equal(t, "S256", query.Get("code_challenge_method"))
+	})
+
+	t.Run("returns unknown provider for unconfigured providers", func(t *testing.T) {
+		client := newClient(map[string]config.OidcProvider{}, http.DefaultClient)
+
+		_, err := client.AuthorizationUrl(context.Background(), "test", AuthorizationRequest{})
+
+		require.ErrorIs(t, err, ErrUnknownProvider)
+	})
+}
+
+func TestClient_Exchange(t *testing.T) {
+	t.Run("exchanges the code and returns the verified id token claims", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := provider.client()
+
+		claims, err := client.Exchange(context.Background(), "test", ExchangeRequest{
+			RedirectUri:  "http://localhost:3000/auth/oidc/test/callback",
+			Code:         "authorization-code",
+			CodeVerifier: "code-verifier",
+			Nonce:        "nonce",
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "subject-1", claims.Subject)
+		assert.Equal(t, "Ada Lovelace", claims.Name)
+		assert.Equal(t, "ada@example.com", claims.EmailAddress)
+		assert.True(t, bool(claims.EmailVerified))
+
+		assert.Equal(t, "authorization_code", provider.tokenForm.Get("grant_type"))
+		assert.Equal(t, "authorization-code", provider.tokenForm.Get("code"))
+		assert.Equal(t, "code-verifier", provider.tokenForm.Get("code_verifier"))
+		assert.Equal(t, "client-id", provider.tokenForm.Get("client_id"))
+		assert.Equal(t, "client-secret", provider.tokenForm.Get("client_secret"))
+		assert.Equal(t, "http://localhost:3000/auth/oidc/test/callback", provider.tokenForm.Get("redirect_uri"))
+	})
+
+	t.Run("accepts string email_verified claims and audience arrays", func(t *testing.T) {
+		provider := newTestProvider(t)
+		provider.claims["email_verified"] = "true"
+		provider.claims["aud"] = []string{"another-client", "client-id"}
+		client := provider.client()
+
+		claims, err := client.Exchange(context.Background(), "test", ExchangeRequest{Code: "authorization-code", Nonce: "nonce"})
+
+		require.NoError(t, err)
+		assert.True(t, bool(claims.EmailVerified))
+	})
+
+	t.Run("rejects id tokens with a different nonce", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := provider.client()
+
+		claims, err := client.Exchange(context.Background(), "test", ExchangeRequest{Code: "authorization-code", Nonce: "other-nonce"})
+
+		require.ErrorIs(t, err, ErrInvalidIdToken)
+		assert.Nil(t, claims)
+	})
+
+	t.Run("rejects id tokens issued for another client", func(t *testing.T) {
+		provider := newTestProvider(t)
+		provider.claims["aud"] = "another-client"
+		client := provider.client()
+
+		_, err := client.Exchange(context.Background(), "test", ExchangeRequest{Code: "authorization-code", Nonce: "nonce"})
+
+		require.ErrorIs(t, err, ErrInvalidIdToken)
+	})
+
+	t.Run("rejects expired id tokens", func(t *testing.T) {
+		provider := newTestProvider(t)
+		provider.claims["exp"] = time.Now().Add(-time.Minute).Unix()
+		client := provider.client()
+
+		_, err := client.Exchange(context.Background(), "test", ExchangeRequest{Code: "authorization-code", Nonce: "nonce"})
+
+		require.ErrorIs(t, err, ErrInvalidIdToken)
+	})
+
+	t.Run("rejects id tokens signed by an unknown key", func(t *testing.T) {
+		provider := newTestProvider(t)
+		otherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+		require.NoError(t, err)
+		provider.signingKey = otherKey
+		client := provider.client()
+
+		_, err = client.Exchange(context.Background(), "test", ExchangeRequest{Code: "authorization-code", Nonce: "nonce"})
+
+		require.ErrorIs(t, err, ErrInvalidIdToken)
+	})
+
+	t.Run("returns token endpoint errors", func(t *testing.T) {
+		provider := newTestProvider(t)
+		client := provider.client()
+
+		_, err := client.Exchange(context.Background(), "test", ExchangeRequest{Code: "invalid-code", Nonce: "nonce"})
+
+		require.ErrorContains(t, err, "invalid_grant")
+	})
+}
+
+type testProvider struct {
+	server     *httptest.Server
+	key        *rsa.PrivateKey
+	signingKey *rsa.PrivateKey
+	claims     map[string]any
+	tokenForm  url.Values
+}
+
+func newTestProvider(t *testing.T) *testProvider {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	provider := &testProvider{key: key, signingKey: key}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("GET /.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"issuer":                 provider.server.URL,
+			"authorization_endpoint": provider.server.URL + "/authorize",
+			"token_endpoint":         provider.server.URL + "/token",
+			"jwks_uri":               provider.server.URL + "/jwks",
+		})
+	})
+	mux.HandleFunc("GET /jwks", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]any{
+			"keys": []map[string]string{{
+				"kty": "RSA",
+				"kid": "test-key",
+				"n":   base64.RawURLEncoding.EncodeToString(provider.key.N.Bytes()),
+				"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(provider.key.E)).Bytes()),
+			}},
+		})
+	})
+	mux.HandleFunc("POST /token", func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, r.ParseForm())
+		provider.tokenForm = r.PostForm
+
+		if r.PostForm.Get("code") != "authorization-code" {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+
+		_ = json.NewEncoder(w).Encode(map[string]string{
+			"access_token": "access-token",
+			"token_type":   "Bearer",
+			"id_token":     provider.signIdToken(t),
+		})
+	})
+
+	provider.server = httptest.NewServer(mux)
+	t.Cleanup(provider.server.Close)
+
+	provider.claims = map[string]any{
+		"iss":            provider.server.URL,
+		"aud":            "client-id",
+		"sub":            "subject-1",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"nonce":          "nonce",
+		"name":           "Ada Lovelace",
+		"email":          "ada@example.com",
+		"email_verified": true,
+	}
+
+	return provider
+}
+
+func (p *testProvider) client() *Client {
+	return newClient(map[string]config.OidcProvider{
+		"test": {
+			Issuer:       p.server.URL,
+			ClientId:     "client-id",
+			ClientSecret: "client-secret",
+			Scopes:       []string{"openid", "email", "profile"},
+		},
+	}, p.server.Client())
+}
+
+func (p *testProvider) signIdToken(t *testing.T) string {
+	t.Helper()
+
+	header, err := json.Marshal(map[string]string{"alg": "RS256", "kid": "test-key", "typ": "JWT"})
+	require.NoError(t, err)
+
+	payload, err := json.Marshal(p.claims)
+	require.NoError(t, err)
+
+	signingInput := base64.RawURLEncoding.EncodeToString(header) + "." + base64.RawURLEncoding.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(signingInput))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, p.signingKey, crypto.SHA256, digest[:])
+	require.NoError(t, err)
+
+	return signingInput + "." + base64.RawURLEncoding.EncodeToString(signature)
+}
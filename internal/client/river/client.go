@@ -2,12 +2,16 @@ package river
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/current"
 	dbSql "github.com/anonychun/bibit/internal/db/sql"
 	"github.com/anonychun/bibit/internal/observability"
 	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
 	"github.com/riverqueue/river"
+	"github.com/riverqueue/river/riverdriver/riverdatabasesql"
 	"github.com/riverqueue/river/riverdriver/riverpgxv5"
 	"github.com/samber/do/v2"
 )
@@ -19,10 +23,12 @@ func init() {
 type IClient interface {
 	Client() *river.Client[pgx.Tx]
 	Workers() *river.Workers
+	Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) error
 }
 
 type Client struct {
 	riverClient *river.Client[pgx.Tx]
+	sqlClient   *river.Client[*sql.Tx]
 	workers     *river.Workers
 }
 
@@ -46,8 +52,16 @@ func NewClient(i do.Injector) (*Client, error) {
 		return nil, err
 	}
 
+	sqlClient, err := river.NewClient(riverdatabasesql.New(stdlib.OpenDBFromPool(sqlDB.PgxPool(ctx))), &river.Config{
+		Logger: o11y.Logger(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	return &Client{
 		riverClient: riverClient,
+		sqlClient:   sqlClient,
 		workers:     workers,
 	}, nil
 }
@@ -59,3 +73,16 @@ func (c *Client) Client() *river.Client[pgx.Tx] {
 func (c *Client) Workers() *river.Workers {
 	return c.workers
 }
+
+// Insert enqueues a job within the transaction carried by ctx, if any, so the
+// job is only visible to workers once that transaction commits.
+func (c *Client) Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) error {
+	tx := current.Tx(ctx)
+	if tx != nil {
+		_, err := c.sqlClient.InsertTx(ctx, tx.Tx, args, opts)
+		return err
+	}
+
+	_, err := c.riverClient.Insert(ctx, args, opts)
+	return err
+}
@@ -5,6 +5,8 @@
 package river
 
 import (
+	"context"
+
 	"github.com/jackc/pgx/v5"
 	"github.com/riverqueue/river"
 	mock "github.com/stretchr/testify/mock"
@@ -83,6 +85,69 @@ func (_c *MockIClient_Client_Call) RunAndReturn(run func() *river.Client[pgx.Tx]
 	return _c
 }
 
+// Insert provides a mock function for the type MockIClient
+func (_mock *MockIClient) Insert(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) error {
+	ret := _mock.Called(ctx, args, opts)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Insert")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, river.JobArgs, *river.InsertOpts) error); ok {
+		r0 = returnFunc(ctx, args, opts)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIClient_Insert_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Insert'
+type MockIClient_Insert_Call struct {
+	*mock.Call
+}
+
+// Insert is a helper method to define mock.On call
+//   - ctx context.Context
+//   - args river.JobArgs
+//   - opts *river.InsertOpts
+func (_e *MockIClient_Expecter) Insert(ctx interface{}, args interface{}, opts interface{}) *MockIClient_Insert_Call {
+	return &MockIClient_Insert_Call{Call: _e.mock.On("Insert", ctx, args, opts)}
+}
+
+func (_c *MockIClient_Insert_Call) Run(run func(ctx context.Context, args river.JobArgs, opts *river.InsertOpts)) *MockIClient_Insert_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 river.JobArgs
+		if args[1] != nil {
+			arg1 = args[1].(river.JobArgs)
+		}
+		var arg2 *river.InsertOpts
+		if args[2] != nil {
+			arg2 = args[2].(*river.InsertOpts)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIClient_Insert_Call) Return(err error) *MockIClient_Insert_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIClient_Insert_Call) RunAndReturn(run func(ctx context.Context, args river.JobArgs, opts *river.InsertOpts) error) *MockIClient_Insert_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Workers provides a mock function for the type MockIClient
 func (_mock *MockIClient) Workers() *river.Workers {
 	ret := _mock.Called()
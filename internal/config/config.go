@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/util"
 	_ "github.com/joho/godotenv/autoload"
 	"github.com/kelseyhightower/envconfig"
 	"github.com/samber/do/v2"
@@ -14,8 +15,23 @@ func init() {
 }
 
 type Config struct {
+	App struct {
+		Url       string `envconfig:"url" default:"http://localhost:3000"`
+		SecretKey string `envconfig:"secret_key"`
+	} `envconfig:"app"`
+
 	Http struct {
 		Port int `envconfig:"port"`
+
+		Cookie struct {
+			Domain   string `envconfig:"domain"`
+			Secure   bool   `envconfig:"secure" default:"true"`
+			SameSite string `envconfig:"same_site" default:"lax"`
+		} `envconfig:"cookie"`
+
+		Csrf struct {
+			TrustedOrigins []string `envconfig:"trusted_origins"`
+		} `envconfig:"csrf"`
 	} `envconfig:"http"`
 
 	Grpc struct {
@@ -32,17 +48,137 @@ type Config struct {
 		} `envconfig:"sql"`
 	} `envconfig:"db"`
 
+	Auth struct {
+		Session struct {
+			IdleTimeout time.Duration `envconfig:"idle_timeout" default:"336h"`
+			MaxLifetime time.Duration `envconfig:"max_lifetime" default:"720h"`
+		} `envconfig:"session"`
+
+		Password struct {
+			Argon2id Argon2id `envconfig:"argon2id"`
+		} `envconfig:"password"`
+
+		PasswordReset struct {
+			TokenLifetime time.Duration `envconfig:"token_lifetime" default:"1h"`
+		} `envconfig:"password_reset"`
+
+		MagicLink struct {
+			TokenLifetime  time.Duration `envconfig:"token_lifetime" default:"15m"`
+			ResendInterval time.Duration `envconfig:"resend_interval" default:"1m"`
+		} `envconfig:"magic_link"`
+
+		EmailVerification struct {
+			RequiredOnSignIn bool          `envconfig:"required_on_signin" default:"false"`
+			TokenLifetime    time.Duration `envconfig:"token_lifetime" default:"24h"`
+			ResendInterval   time.Duration `envconfig:"resend_interval" default:"1m"`
+		} `envconfig:"email_verification"`
+
+		Impersonation struct {
+			SessionLifetime time.Duration `envconfig:"session_lifetime" default:"1h"`
+		} `envconfig:"impersonation"`
+
+		Reauthentication struct {
+			MaxAge time.Duration `envconfig:"max_age" default:"10m"`
+		} `envconfig:"reauthentication"`
+
+		Totp struct {
+			Issuer            string        `envconfig:"issuer" default:"Bibit"`
+			ChallengeLifetime time.Duration `envconfig:"challenge_lifetime" default:"5m"`
+			MaxAttempts       int           `envconfig:"max_attempts" default:"5"`
+		} `envconfig:"totp"`
+
+		Lockout struct {
+			MaxFailedAttemptsPerAccount int           `envconfig:"max_failed_attempts_per_account" default:"5"`
+			MaxFailedAttemptsPerIp      int           `envconfig:"max_failed_attempts_per_ip" default:"20"`
+			Window                      time.Duration `envconfig:"window" default:"15m"`
+			Duration                    time.Duration `envconfig:"duration" default:"15m"`
+			MaxDuration                 time.Duration `envconfig:"max_duration" default:"24h"`
+		} `envconfig:"lockout"`
+
+		Oidc struct {
+			StateLifetime time.Duration `envconfig:"state_lifetime" default:"10m"`
+			Google        OidcProvider  `envconfig:"google"`
+			Gitlab        OidcProvider  `envconfig:"gitlab"`
+		} `envconfig:"oidc"`
+	} `envconfig:"auth"`
+
+	Mailer struct {
+		Transport string `envconfig:"transport" default:"file"`
+		From      string `envconfig:"from" default:"Bibit <no-reply@localhost>"`
+
+		Smtp struct {
+			Host     string `envconfig:"host"`
+			Port     int    `envconfig:"port" default:"587"`
+			Username string `envconfig:"username"`
+			Password string `envconfig:"password"`
+		} `envconfig:"smtp"`
+
+		File struct {
+			Dir string `envconfig:"dir" default:"tmp/mails"`
+		} `envconfig:"file"`
+	} `envconfig:"mailer"`
+
 	Storage struct {
+		Driver           string        `envconfig:"driver"`
+		UrlExpiration    time.Duration `envconfig:"url_expiration"`
+		UploadExpiration time.Duration `envconfig:"upload_expiration" default:"1h"`
+
+		Local struct {
+			Dir        string `envconfig:"dir" default:"tmp/storage"`
+			Url        string `envconfig:"url"`
+			SigningKey string `envconfig:"signing_key"`
+		} `envconfig:"local"`
+
 		S3 struct {
-			Endpoint        string        `envconfig:"endpoint"`
-			Bucket          string        `envconfig:"bucket"`
-			AccessKeyId     string        `envconfig:"access_key_id"`
-			SecretAccessKey string        `envconfig:"secret_access_key"`
-			UrlExpiration   time.Duration `envconfig:"url_expiration"`
+			Endpoint        string `envconfig:"endpoint"`
+			Bucket          string `envconfig:"bucket"`
+			AccessKeyId     string `envconfig:"access_key_id"`
+			SecretAccessKey string `envconfig:"secret_access_key"`
+
+			// Deprecated: replaced by Storage.UrlExpiration, still read when
+			// that one is unset.
+			UrlExpiration time.Duration `envconfig:"url_expiration"`
 		} `envconfig:"s3"`
 	} `envconfig:"storage"`
 }
 
+type OidcProvider struct {
+	Issuer       string   `envconfig:"issuer"`
+	ClientId     string   `envconfig:"client_id"`
+	ClientSecret string   `envconfig:"client_secret"`
+	Scopes       []string `envconfig:"scopes" default:"openid,email,profile"`
+}
+
+type Argon2id struct {
+	Memory      uint32 `envconfig:"memory" default:"65536"`
+	Iterations  uint32 `envconfig:"iterations" default:"3"`
+	Parallelism uint8  `envconfig:"parallelism" default:"2"`
+	SaltLength  uint32 `envconfig:"salt_length" default:"16"`
+	KeyLength   uint32 `envconfig:"key_length" default:"32"`
+}
+
+// OidcProviders returns the identity providers that have a client id
+// configured, keyed by the name used in sign in URLs.
+func (c *Config) OidcProviders() map[string]OidcProvider {
+	providers := map[string]OidcProvider{}
+	for name, provider := range map[string]OidcProvider{
+		"google": c.Auth.Oidc.Google,
+		"gitlab": c.Auth.Oidc.Gitlab,
+	} {
+		if provider.ClientId != "" {
+			providers[name] = provider
+		}
+	}
+
+	return providers
+}
+
+// PasswordHashParams returns the argon2id parameters new password digests
+// are created with. Digests using other parameters are rehashed on sign in.
+func (c *Config) PasswordHashParams() util.Argon2idParams {
+	return util.Argon2idParams(c.Auth.Password.Argon2id)
+}
+
 func NewConfig(i do.Injector) (*Config, error) {
 	config := &Config{}
 	err := envconfig.Process("", config)
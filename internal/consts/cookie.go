@@ -2,4 +2,5 @@ package consts
 
 const (
 	CookieUserSession = "user_session"
+	CookieOidcState   = "oidc_state"
 )
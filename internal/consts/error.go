@@ -8,6 +8,40 @@ import (
 
 var (
 	ErrUnauthorized                  = &api.Error{Status: http.StatusUnauthorized, Errors: "You are not allowed to perform this action"}
+	ErrForbidden                     = &api.Error{Status: http.StatusForbidden, Errors: "You do not have permission to perform this action"}
+	ErrInvalidRequestOrigin          = &api.Error{Status: http.StatusForbidden, Errors: "Request origin is not allowed"}
+	ErrSessionExpired                = &api.Error{Status: http.StatusUnauthorized, Errors: "Your session has expired"}
+	ErrUserNotFound                  = &api.Error{Status: http.StatusNotFound, Errors: "User not found"}
+	ErrUserSessionNotFound           = &api.Error{Status: http.StatusNotFound, Errors: "Session not found"}
+	ErrApiKeyNotFound                = &api.Error{Status: http.StatusNotFound, Errors: "API key not found"}
+	ErrInsufficientScope             = &api.Error{Status: http.StatusForbidden, Errors: "Your API key is not allowed to perform this action"}
 	ErrInvalidCredentials            = &api.Error{Status: http.StatusUnauthorized, Errors: "Invalid email or password"}
+	ErrImpersonationNotAllowed       = &api.Error{Status: http.StatusForbidden, Errors: "You cannot impersonate this user"}
+	ErrImpersonationRestricted       = &api.Error{Status: http.StatusForbidden, Errors: "This action is not available while impersonating a user"}
+	ErrIncorrectPassword             = &api.Error{Status: http.StatusUnprocessableEntity, Errors: "Current password is incorrect"}
+	ErrReauthenticationRequired      = &api.Error{Status: http.StatusUnprocessableEntity, Errors: "Please sign in again to confirm this change"}
 	ErrEmailAddressAlreadyRegistered = &api.Error{Status: http.StatusConflict, Errors: "Email address already registered"}
+	ErrInvalidPasswordResetToken     = &api.Error{Status: http.StatusBadRequest, Errors: "Password reset link is invalid or has expired"}
+	ErrInvalidMagicLinkToken         = &api.Error{Status: http.StatusBadRequest, Errors: "Sign in link is invalid or has expired"}
+	ErrInvalidEmailVerificationToken = &api.Error{Status: http.StatusBadRequest, Errors: "Email verification link is invalid or has expired"}
+	ErrEmailAddressNotVerified       = &api.Error{Status: http.StatusForbidden, Errors: "Please verify your email address first"}
+	ErrInvalidSignInChallenge        = &api.Error{Status: http.StatusUnauthorized, Errors: "Your sign in attempt has expired, please sign in again"}
+	ErrInvalidTotpCode               = &api.Error{Status: http.StatusBadRequest, Errors: "Invalid authentication code"}
+	ErrTotpNotEnrolled               = &api.Error{Status: http.StatusBadRequest, Errors: "Two-factor authentication has not been set up"}
+	ErrTotpAlreadyEnabled            = &api.Error{Status: http.StatusConflict, Errors: "Two-factor authentication is already enabled"}
+	ErrOidcProviderNotFound          = &api.Error{Status: http.StatusNotFound, Errors: "Sign in provider not found"}
+	ErrInvalidOidcState              = &api.Error{Status: http.StatusBadRequest, Errors: "Your sign in attempt has expired, please try again"}
+	ErrOidcSignInFailed              = &api.Error{Status: http.StatusUnauthorized, Errors: "Unable to sign in with this provider"}
+	ErrOidcEmailAddressNotVerified   = &api.Error{Status: http.StatusForbidden, Errors: "Your email address has not been verified by this provider"}
+	ErrInvalidAuditEventFilter       = &api.Error{Status: http.StatusBadRequest, Errors: "Audit event filter is invalid"}
+	ErrAttachmentNotFound            = &api.Error{Status: http.StatusNotFound, Errors: "Attachment not found"}
+	ErrUploadExpired                 = &api.Error{Status: http.StatusGone, Errors: "Upload has expired, please upload the file again"}
+	ErrUploadIncomplete              = &api.Error{Status: http.StatusConflict, Errors: "File has not been uploaded yet"}
+	ErrUploadMismatch                = &api.Error{Status: http.StatusUnprocessableEntity, Errors: "Uploaded file does not match the declared size or checksum"}
+	ErrUploadContentTypeMismatch     = &api.Error{Status: http.StatusUnprocessableEntity, Errors: "Uploaded file does not match the declared content type"}
+	ErrFileTooLarge                  = &api.Error{Status: http.StatusRequestEntityTooLarge, Errors: "File is too large"}
+	ErrObjectNotFound                = &api.Error{Status: http.StatusNotFound, Errors: "File not found"}
+	ErrInvalidSignedUrl              = &api.Error{Status: http.StatusForbidden, Errors: "File link is invalid or has expired"}
+	ErrChecksumMismatch              = &api.Error{Status: http.StatusBadRequest, Errors: "File checksum does not match"}
+	ErrSignInLockedOut               = &api.Error{Status: http.StatusTooManyRequests, Errors: "Too many failed sign in attempts, please try again later"}
 )
@@ -0,0 +1,11 @@
+package consts
+
+const (
+	HeaderSessionDelivery = "X-Session-Delivery"
+	HeaderOidcStateDigest = "X-Oidc-State-Digest"
+)
+
+const (
+	SessionDeliveryCookie = "cookie"
+	SessionDeliveryToken  = "token"
+)
@@ -0,0 +1,24 @@
+package consts
+
+const (
+	PermissionUsersRead   = "users:read"
+	PermissionUsersManage = "users:manage"
+	PermissionRolesManage = "roles:manage"
+
+	PermissionUsersImpersonate = "users:impersonate"
+	PermissionAuditEventsRead  = "audit_events:read"
+)
+
+// Permissions lists every permission a role may be granted.
+var Permissions = []string{
+	PermissionUsersRead,
+	PermissionUsersManage,
+	PermissionRolesManage,
+	PermissionUsersImpersonate,
+	PermissionAuditEventsRead,
+}
+
+const (
+	RoleAdmin   = "admin"
+	RoleSupport = "support"
+)
@@ -0,0 +1,10 @@
+package consts
+
+const (
+	ScopeProfileRead = "profile:read"
+)
+
+// Scopes lists every scope an API key may be granted.
+var Scopes = []string{
+	ScopeProfileRead,
+}
@@ -0,0 +1,105 @@
+package cookie
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewCookie)
+}
+
+type ICookie interface {
+	SetUserSession(c *echo.Context, token string, expiresAt time.Time)
+	ClearUserSession(c *echo.Context)
+	SetOidcState(c *echo.Context, stateDigest string, expiresAt time.Time)
+	ClearOidcState(c *echo.Context)
+}
+
+type Cookie struct {
+	domain   string
+	secure   bool
+	sameSite http.SameSite
+}
+
+var _ ICookie = (*Cookie)(nil)
+
+func NewCookie(i do.Injector) (*Cookie, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+
+	sameSite, err := parseSameSite(cfg.Http.Cookie.SameSite)
+	if err != nil {
+		return nil, err
+	}
+
+	if sameSite == http.SameSiteNoneMode && !cfg.Http.Cookie.Secure {
+		return nil, fmt.Errorf("cookie: SameSite=None requires secure cookies")
+	}
+
+	return &Cookie{
+		domain:   cfg.Http.Cookie.Domain,
+		secure:   cfg.Http.Cookie.Secure,
+		sameSite: sameSite,
+	}, nil
+}
+
+func (ck *Cookie) SetUserSession(c *echo.Context, token string, expiresAt time.Time) {
+	cookie := ck.new(consts.CookieUserSession, token)
+	cookie.Expires = expiresAt
+	cookie.MaxAge = int(time.Until(expiresAt).Seconds())
+	c.SetCookie(cookie)
+}
+
+func (ck *Cookie) ClearUserSession(c *echo.Context) {
+	cookie := ck.new(consts.CookieUserSession, "")
+	cookie.MaxAge = -1
+	c.SetCookie(cookie)
+}
+
+func (ck *Cookie) SetOidcState(c *echo.Context, stateDigest string, expiresAt time.Time) {
+	cookie := ck.new(consts.CookieOidcState, stateDigest)
+	cookie.Expires = expiresAt
+	cookie.MaxAge = int(time.Until(expiresAt).Seconds())
+	c.SetCookie(cookie)
+}
+
+func (ck *Cookie) ClearOidcState(c *echo.Context) {
+	cookie := ck.new(consts.CookieOidcState, "")
+	cookie.MaxAge = -1
+	c.SetCookie(cookie)
+}
+
+// new returns a cookie carrying the configured attributes. Every cookie the
+// application sets goes through here so that they cannot drift apart.
+func (ck *Cookie) new(name, value string) *http.Cookie {
+	return &http.Cookie{
+		Name:     name,
+		Value:    value,
+		Path:     "/",
+		Domain:   ck.domain,
+		Secure:   ck.secure,
+		HttpOnly: true,
+		SameSite: ck.sameSite,
+	}
+}
+
+func parseSameSite(sameSite string) (http.SameSite, error) {
+	switch strings.ToLower(sameSite) {
+	case "lax":
+		return http.SameSiteLaxMode, nil
+	case "strict":
+		return http.SameSiteStrictMode, nil
+	case "none":
+		return http.SameSiteNoneMode, nil
+	default:
+		return http.SameSiteDefaultMode, fmt.Errorf("cookie: invalid SameSite value %q", sameSite)
+	}
+}
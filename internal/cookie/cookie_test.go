@@ -0,0 +1,136 @@
+package cookie
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCookie(t *testing.T) {
+	t.Run("accepts samesite values case insensitively", func(t *testing.T) {
+		for sameSite, expected := range map[string]http.SameSite{
+			"lax":    http.SameSiteLaxMode,
+			"Strict": http.SameSiteStrictMode,
+			"NONE":   http.SameSiteNoneMode,
+		} {
+			cfg := &config.Config{}
+			cfg.Http.Cookie.Secure = true
+			cfg.Http.Cookie.SameSite = sameSite
+
+			cookie, err := NewCookie(newTestInjector(cfg))
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, cookie.sameSite)
+		}
+	})
+
+	t.Run("rejects unknown samesite values", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Http.Cookie.SameSite = "sometimes"
+
+		_, err := NewCookie(newTestInjector(cfg))
+
+		require.Error(t, err)
+	})
+
+	t.Run("rejects samesite none without secure", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Http.Cookie.SameSite = "none"
+
+		_, err := NewCookie(newTestInjector(cfg))
+
+		require.Error(t, err)
+	})
+}
+
+func TestCookie_SetUserSession(t *testing.T) {
+	t.Run("sets the session cookie with the configured attributes", func(t *testing.T) {
+		cookie := &Cookie{domain: "example.com", secure: true, sameSite: http.SameSiteStrictMode}
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		cookie.SetUserSession(ctx, "session-token", expiresAt)
+
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, consts.CookieUserSession, cookies[0].Name)
+		assert.Equal(t, "session-token", cookies[0].Value)
+		assert.Equal(t, "/", cookies[0].Path)
+		assert.Equal(t, "example.com", cookies[0].Domain)
+		assert.True(t, cookies[0].Secure)
+		assert.True(t, cookies[0].HttpOnly)
+		assert.Equal(t, http.SameSiteStrictMode, cookies[0].SameSite)
+		assert.True(t, expiresAt.Equal(cookies[0].Expires))
+		assert.Positive(t, cookies[0].MaxAge)
+	})
+}
+
+func TestCookie_ClearUserSession(t *testing.T) {
+	t.Run("expires the session cookie with the same attributes it was set with", func(t *testing.T) {
+		cookie := &Cookie{domain: "example.com", secure: true, sameSite: http.SameSiteLaxMode}
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+
+		cookie.ClearUserSession(ctx)
+
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, consts.CookieUserSession, cookies[0].Name)
+		assert.Empty(t, cookies[0].Value)
+		assert.Equal(t, "example.com", cookies[0].Domain)
+		assert.True(t, cookies[0].Secure)
+		assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+		assert.Negative(t, cookies[0].MaxAge)
+	})
+}
+
+func TestCookie_SetOidcState(t *testing.T) {
+	t.Run("sets the oidc state cookie until the state expires", func(t *testing.T) {
+		cookie := &Cookie{domain: "example.com", secure: true, sameSite: http.SameSiteLaxMode}
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodGet, "/", nil), rec)
+		expiresAt := time.Now().Add(10 * time.Minute).Truncate(time.Second)
+
+		cookie.SetOidcState(ctx, "state-digest", expiresAt)
+
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, consts.CookieOidcState, cookies[0].Name)
+		assert.Equal(t, "state-digest", cookies[0].Value)
+		assert.True(t, cookies[0].HttpOnly)
+		assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+		assert.True(t, expiresAt.Equal(cookies[0].Expires))
+		assert.Positive(t, cookies[0].MaxAge)
+	})
+}
+
+func TestCookie_ClearOidcState(t *testing.T) {
+	t.Run("expires the oidc state cookie", func(t *testing.T) {
+		cookie := &Cookie{domain: "example.com", secure: true, sameSite: http.SameSiteLaxMode}
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), rec)
+
+		cookie.ClearOidcState(ctx)
+
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, consts.CookieOidcState, cookies[0].Name)
+		assert.Empty(t, cookies[0].Value)
+		assert.Negative(t, cookies[0].MaxAge)
+	})
+}
+
+func newTestInjector(cfg *config.Config) do.Injector {
+	i := do.New()
+	do.ProvideValue(i, cfg)
+	return i
+}
@@ -2,6 +2,7 @@ package current
 
 import (
 	"context"
+	"slices"
 
 	"github.com/anonychun/bibit/internal/entity"
 	"github.com/uptrace/bun"
@@ -12,6 +13,13 @@ type key int
 const (
 	txKey key = iota
 	userKey
+	userSessionKey
+	apiKeyKey
+	permissionsKey
+	impersonatorKey
+	requestIdKey
+	ipAddressKey
+	userAgentKey
 )
 
 func Tx(ctx context.Context) *bun.Tx {
@@ -23,6 +31,33 @@ func SetTx(ctx context.Context, tx *bun.Tx) context.Context {
 	return context.WithValue(ctx, txKey, tx)
 }
 
+func RequestId(ctx context.Context) string {
+	requestId, _ := ctx.Value(requestIdKey).(string)
+	return requestId
+}
+
+func SetRequestId(ctx context.Context, requestId string) context.Context {
+	return context.WithValue(ctx, requestIdKey, requestId)
+}
+
+func IpAddress(ctx context.Context) string {
+	ipAddress, _ := ctx.Value(ipAddressKey).(string)
+	return ipAddress
+}
+
+func SetIpAddress(ctx context.Context, ipAddress string) context.Context {
+	return context.WithValue(ctx, ipAddressKey, ipAddress)
+}
+
+func UserAgent(ctx context.Context) string {
+	userAgent, _ := ctx.Value(userAgentKey).(string)
+	return userAgent
+}
+
+func SetUserAgent(ctx context.Context, userAgent string) context.Context {
+	return context.WithValue(ctx, userAgentKey, userAgent)
+}
+
 func User(ctx context.Context) *entity.User {
 	user, _ := ctx.Value(userKey).(*entity.User)
 	return user
@@ -31,3 +66,68 @@ func User(ctx context.Context) *entity.User {
 func SetUser(ctx context.Context, user *entity.User) context.Context {
 	return context.WithValue(ctx, userKey, user)
 }
+
+func UserSession(ctx context.Context) *entity.UserSession {
+	userSession, _ := ctx.Value(userSessionKey).(*entity.UserSession)
+	return userSession
+}
+
+func SetUserSession(ctx context.Context, userSession *entity.UserSession) context.Context {
+	return context.WithValue(ctx, userSessionKey, userSession)
+}
+
+// Impersonator is the admin acting as User through an impersonated session.
+func Impersonator(ctx context.Context) *entity.User {
+	impersonator, _ := ctx.Value(impersonatorKey).(*entity.User)
+	return impersonator
+}
+
+func SetImpersonator(ctx context.Context, impersonator *entity.User) context.Context {
+	return context.WithValue(ctx, impersonatorKey, impersonator)
+}
+
+// RealUser is the person behind the request: the impersonator when the
+// session is impersonated, otherwise the effective User.
+func RealUser(ctx context.Context) *entity.User {
+	impersonator := Impersonator(ctx)
+	if impersonator != nil {
+		return impersonator
+	}
+
+	return User(ctx)
+}
+
+func ApiKey(ctx context.Context) *entity.ApiKey {
+	apiKey, _ := ctx.Value(apiKeyKey).(*entity.ApiKey)
+	return apiKey
+}
+
+func SetApiKey(ctx context.Context, apiKey *entity.ApiKey) context.Context {
+	return context.WithValue(ctx, apiKeyKey, apiKey)
+}
+
+// HasScope reports whether the request may act within scope. Sessions carry
+// every scope; API keys only carry the scopes they were granted.
+func HasScope(ctx context.Context, scope string) bool {
+	apiKey := ApiKey(ctx)
+	if apiKey == nil {
+		return true
+	}
+
+	return apiKey.HasScope(scope)
+}
+
+func Permissions(ctx context.Context) []string {
+	permissions, _ := ctx.Value(permissionsKey).([]string)
+	return permissions
+}
+
+func SetPermissions(ctx context.Context, permissions []string) context.Context {
+	return context.WithValue(ctx, permissionsKey, permissions)
+}
+
+// Can reports whether the current user was granted permission through any of
+// their roles.
+func Can(ctx context.Context, permission string) bool {
+	return slices.Contains(Permissions(ctx), permission)
+}
@@ -2,11 +2,15 @@ package seeder
 
 import (
 	"context"
+	"database/sql"
 	"fmt"
 	"net/url"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
 	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 	"github.com/jackc/pgx/v5/stdlib"
@@ -71,7 +75,60 @@ func NewDB(i do.Injector) (*DB, error) {
 }
 
 func (d *DB) Seed(ctx context.Context) error {
-	return nil
+	return d.bunDB.RunInTx(ctx, &sql.TxOptions{}, func(ctx context.Context, tx bun.Tx) error {
+		return seedRoles(ctx, tx)
+	})
+}
+
+var defaultRoles = []struct {
+	name        string
+	description string
+	permissions []string
+}{
+	{name: consts.RoleAdmin, description: "Full access to every administrative feature", permissions: consts.Permissions},
+	{name: consts.RoleSupport, description: "Read-only access to user accounts", permissions: []string{consts.PermissionUsersRead}},
+}
+
+// seedRoles upserts every permission and default role so that it can run
+// against a database that has been seeded before.
+func seedRoles(ctx context.Context, db bun.IDB) error {
+	permissions := make([]*entity.Permission, len(consts.Permissions))
+	for i, name := range consts.Permissions {
+		permissions[i] = &entity.Permission{Name: name}
+	}
+
+	_, err := db.NewInsert().Model(&permissions).On("CONFLICT (name) DO UPDATE").Set("name = EXCLUDED.name").Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	permissionIds := make(map[string]uuid.UUID, len(permissions))
+	for _, permission := range permissions {
+		permissionIds[permission.Name] = permission.Id
+	}
+
+	roles := make([]*entity.Role, len(defaultRoles))
+	for i, defaultRole := range defaultRoles {
+		roles[i] = &entity.Role{Name: defaultRole.name, Description: defaultRole.description}
+	}
+
+	_, err = db.NewInsert().Model(&roles).On("CONFLICT (name) DO UPDATE").Set("description = EXCLUDED.description").Exec(ctx)
+	if err != nil {
+		return err
+	}
+
+	rolePermissions := make([]*entity.RolePermission, 0)
+	for i, defaultRole := range defaultRoles {
+		for _, permission := range defaultRole.permissions {
+			rolePermissions = append(rolePermissions, &entity.RolePermission{
+				RoleId:       roles[i].Id,
+				PermissionId: permissionIds[permission],
+			})
+		}
+	}
+
+	_, err = db.NewInsert().Model(&rolePermissions).On("CONFLICT DO NOTHING").Exec(ctx)
+	return err
 }
 
 func (d *DB) Shutdown(ctx context.Context) error {
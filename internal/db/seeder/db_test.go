@@ -0,0 +1,42 @@
+package seeder
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestDB_Seed(t *testing.T) {
+	t.Run("upserts the permissions and default roles and grants the role permissions", func(t *testing.T) {
+		ctx := context.Background()
+		rawDB, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		db := &DB{bunDB: bun.NewDB(rawDB, pgdialect.New())}
+
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectQuery(`INSERT INTO "permissions" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, 'users:read'\), \(DEFAULT, DEFAULT, DEFAULT, 'users:manage'\), \(DEFAULT, DEFAULT, DEFAULT, 'roles:manage'\), \(DEFAULT, DEFAULT, DEFAULT, 'users:impersonate'\), \(DEFAULT, DEFAULT, DEFAULT, 'audit_events:read'\) ON CONFLICT \(name\) DO UPDATE SET name = EXCLUDED.name RETURNING`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).
+				AddRow(uuid.New().String()).
+				AddRow(uuid.New().String()).
+				AddRow(uuid.New().String()).
+				AddRow(uuid.New().String()).
+				AddRow(uuid.New().String()))
+		sqlMock.ExpectQuery(`INSERT INTO "roles" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, 'admin', '[^']+'\), \(DEFAULT, DEFAULT, DEFAULT, 'support', '[^']+'\) ON CONFLICT \(name\) DO UPDATE SET description = EXCLUDED.description RETURNING`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}).
+				AddRow(uuid.New().String()).
+				AddRow(uuid.New().String()))
+		sqlMock.ExpectQuery(`INSERT INTO "role_permissions" .* ON CONFLICT DO NOTHING RETURNING`).
+			WillReturnRows(sqlmock.NewRows([]string{"created_at"}))
+		sqlMock.ExpectCommit()
+
+		err = db.Seed(ctx)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
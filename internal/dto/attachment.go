@@ -5,17 +5,18 @@ import (
 
 	"github.com/anonychun/bibit/internal/bootstrap"
 	"github.com/anonychun/bibit/internal/entity"
-	storageS3 "github.com/anonychun/bibit/internal/storage/s3"
-	"github.com/aws/aws-sdk-go-v2/aws"
-	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/anonychun/bibit/internal/storage"
 	"github.com/google/uuid"
 	"github.com/samber/do/v2"
 )
 
 type AttachmentBlueprint struct {
-	Id       uuid.UUID `json:"id"`
-	FileName string    `json:"fileName"`
-	Url      string    `json:"url"`
+	Id          uuid.UUID      `json:"id"`
+	FileName    string         `json:"fileName"`
+	ContentType string         `json:"contentType"`
+	ByteSize    int64          `json:"byteSize"`
+	Metadata    map[string]any `json:"metadata"`
+	Url         string         `json:"url"`
 }
 
 func NewAttachmentBlueprint(ctx context.Context, attachment *entity.Attachment) (*AttachmentBlueprint, error) {
@@ -23,21 +24,40 @@ func NewAttachmentBlueprint(ctx context.Context, attachment *entity.Attachment)
 		return nil, nil
 	}
 
-	s3Storage, err := do.Invoke[*storageS3.Storage](bootstrap.Injector)
+	objectStorage, err := do.Invoke[*storage.Storage](bootstrap.Injector)
 	if err != nil {
 		return nil, err
 	}
 
-	presignResult, err := s3Storage.PresignGetObject(ctx, &s3.GetObjectInput{
-		Key: aws.String(attachment.ObjectName),
-	})
+	url, err := objectStorage.PresignGet(ctx, attachment.ObjectName)
 	if err != nil {
 		return nil, err
 	}
 
 	return &AttachmentBlueprint{
-		Id:       attachment.Id,
-		FileName: attachment.FileName,
-		Url:      presignResult.URL,
+		Id:          attachment.Id,
+		FileName:    attachment.FileName,
+		ContentType: attachment.ContentType,
+		ByteSize:    attachment.ByteSize,
+		Metadata:    attachment.Metadata,
+		Url:         url,
 	}, nil
 }
+
+// NewAttachmentBlueprints renders has-many attachments, e.g.
+// record.AttachmentLinks.Many(name), keeping their order.
+func NewAttachmentBlueprints(ctx context.Context, attachments []*entity.Attachment) ([]*AttachmentBlueprint, error) {
+	blueprints := make([]*AttachmentBlueprint, 0, len(attachments))
+	for _, attachment := range attachments {
+		blueprint, err := NewAttachmentBlueprint(ctx, attachment)
+		if err != nil {
+			return nil, err
+		}
+
+		if blueprint != nil {
+			blueprints = append(blueprints, blueprint)
+		}
+	}
+
+	return blueprints, nil
+}
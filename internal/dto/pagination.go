@@ -0,0 +1,40 @@
+package dto
+
+const (
+	DefaultPerPage = 20
+	MaxPerPage     = 100
+)
+
+type Pagination struct {
+	Page       int `json:"page"`
+	PerPage    int `json:"perPage"`
+	TotalCount int `json:"totalCount"`
+	TotalPages int `json:"totalPages"`
+}
+
+// NewPagination fills in the defaults for a requested page and clamps
+// perPage to MaxPerPage. TotalCount is set once the total is known.
+func NewPagination(page, perPage int) Pagination {
+	if page < 1 {
+		page = 1
+	}
+
+	if perPage < 1 {
+		perPage = DefaultPerPage
+	}
+
+	return Pagination{Page: page, PerPage: min(perPage, MaxPerPage)}
+}
+
+func (p *Pagination) Limit() int {
+	return p.PerPage
+}
+
+func (p *Pagination) Offset() int {
+	return (p.Page - 1) * p.PerPage
+}
+
+func (p *Pagination) SetTotalCount(totalCount int) {
+	p.TotalCount = totalCount
+	p.TotalPages = (totalCount + p.PerPage - 1) / p.PerPage
+}
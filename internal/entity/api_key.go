@@ -0,0 +1,53 @@
+package entity
+
+import (
+	"slices"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+)
+
+// ApiKeyPrefix marks bearer tokens that are API keys rather than sessions.
+const ApiKeyPrefix = "bibit_"
+
+// apiKeyVisibleLength is how much of the key stays visible after creation so
+// that users can tell their keys apart.
+const apiKeyVisibleLength = len(ApiKeyPrefix) + 8
+
+type ApiKey struct {
+	Base
+
+	UserId     uuid.UUID
+	User       *User `bun:"rel:belongs-to,join:user_id=id"`
+	Name       string
+	Prefix     string
+	Key        string `bun:"-"`
+	KeyDigest  string
+	Scopes     []string  `bun:",array"`
+	ExpiresAt  time.Time `bun:",nullzero"`
+	LastUsedAt time.Time `bun:",nullzero"`
+	RevokedAt  time.Time `bun:",nullzero"`
+}
+
+func (a *ApiKey) GenerateKey() {
+	a.Key = ApiKeyPrefix + util.GenerateToken()
+	a.Prefix = a.Key[:apiKeyVisibleLength]
+	a.KeyDigest = util.DigestToken(a.Key)
+}
+
+func (a *ApiKey) IsRevoked() bool {
+	return !a.RevokedAt.IsZero()
+}
+
+func (a *ApiKey) IsExpired() bool {
+	return !a.ExpiresAt.IsZero() && !time.Now().Before(a.ExpiresAt)
+}
+
+func (a *ApiKey) IsActive() bool {
+	return !a.IsRevoked() && !a.IsExpired()
+}
+
+func (a *ApiKey) HasScope(scope string) bool {
+	return slices.Contains(a.Scopes, scope)
+}
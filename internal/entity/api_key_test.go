@@ -0,0 +1,69 @@
+package entity
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApiKey_GenerateKey(t *testing.T) {
+	t.Run("stores a prefixed random key, its visible prefix and its digest", func(t *testing.T) {
+		apiKey := &ApiKey{}
+
+		apiKey.GenerateKey()
+
+		assert.True(t, strings.HasPrefix(apiKey.Key, ApiKeyPrefix))
+		assert.Len(t, apiKey.Prefix, len(ApiKeyPrefix)+8)
+		assert.True(t, strings.HasPrefix(apiKey.Key, apiKey.Prefix))
+		assert.Equal(t, util.DigestToken(apiKey.Key), apiKey.KeyDigest)
+	})
+
+	t.Run("generates a different key every time", func(t *testing.T) {
+		first := &ApiKey{}
+		second := &ApiKey{}
+
+		first.GenerateKey()
+		second.GenerateKey()
+
+		assert.NotEqual(t, first.Key, second.Key)
+		assert.NotEqual(t, first.KeyDigest, second.KeyDigest)
+	})
+}
+
+func TestApiKey_IsActive(t *testing.T) {
+	t.Run("returns true for keys without an expiry", func(t *testing.T) {
+		apiKey := &ApiKey{}
+
+		assert.True(t, apiKey.IsActive())
+	})
+
+	t.Run("returns true until the key expires", func(t *testing.T) {
+		apiKey := &ApiKey{ExpiresAt: time.Now().Add(time.Minute)}
+
+		assert.True(t, apiKey.IsActive())
+	})
+
+	t.Run("returns false once the key has expired", func(t *testing.T) {
+		apiKey := &ApiKey{ExpiresAt: time.Now().Add(-time.Second)}
+
+		assert.False(t, apiKey.IsActive())
+	})
+
+	t.Run("returns false for revoked keys", func(t *testing.T) {
+		apiKey := &ApiKey{RevokedAt: time.Now()}
+
+		assert.False(t, apiKey.IsActive())
+	})
+}
+
+func TestApiKey_HasScope(t *testing.T) {
+	t.Run("returns whether the scope was granted", func(t *testing.T) {
+		apiKey := &ApiKey{Scopes: []string{"profile:read"}}
+
+		assert.True(t, apiKey.HasScope("profile:read"))
+		assert.False(t, apiKey.HasScope("profile:write"))
+	})
+}
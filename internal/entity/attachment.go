@@ -1,19 +1,42 @@
 package entity
 
 import (
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
 	"mime/multipart"
+	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/oklog/ulid/v2"
 )
 
+const (
+	AttachmentStatusPending   = "pending"
+	AttachmentStatusCommitted = "committed"
+)
+
 type Attachment struct {
 	Base
 
-	ObjectName string
-	FileName   string
-	ByteSize   int64
+	UserId      uuid.UUID `bun:",nullzero"`
+	ObjectName  string
+	FileName    string
+	ByteSize    int64
+	ContentType string         `bun:",nullzero"`
+	Checksum    string         `bun:",nullzero"`
+	Metadata    map[string]any `bun:"type:jsonb,nullzero"`
+	Status      string
+	ExpiresAt   time.Time `bun:",nullzero"`
 }
 
 func NewAttachmentFromFile(file *os.File) (*Attachment, error) {
@@ -22,17 +45,110 @@ func NewAttachmentFromFile(file *os.File) (*Attachment, error) {
 		return nil, err
 	}
 
-	return &Attachment{
+	attachment := &Attachment{
 		ObjectName: ulid.Make().String() + filepath.Ext(fileInfo.Name()),
 		FileName:   fileInfo.Name(),
 		ByteSize:   fileInfo.Size(),
-	}, nil
+		Status:     AttachmentStatusCommitted,
+	}
+
+	err = attachment.analyze(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
 }
 
-func NewAttachmentFromFileHeader(fileHeader *multipart.FileHeader) *Attachment {
-	return &Attachment{
+func NewAttachmentFromFileHeader(fileHeader *multipart.FileHeader) (*Attachment, error) {
+	file, err := fileHeader.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	attachment := &Attachment{
 		ObjectName: ulid.Make().String() + filepath.Ext(fileHeader.Filename),
 		FileName:   fileHeader.Filename,
 		ByteSize:   fileHeader.Size,
+		Status:     AttachmentStatusCommitted,
+	}
+
+	err = attachment.analyze(file)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+// NewPendingAttachment describes a file the client is about to upload
+// directly to storage. It has to be committed before expiresAt.
+func NewPendingAttachment(fileName string, byteSize int64, contentType, checksum string, expiresAt time.Time) *Attachment {
+	return &Attachment{
+		ObjectName:  ulid.Make().String() + filepath.Ext(fileName),
+		FileName:    fileName,
+		ByteSize:    byteSize,
+		ContentType: contentType,
+		Checksum:    checksum,
+		Status:      AttachmentStatusPending,
+		ExpiresAt:   expiresAt,
+	}
+}
+
+func (a *Attachment) IsCommitted() bool {
+	return a.Status == AttachmentStatusCommitted
+}
+
+func (a *Attachment) IsExpired() bool {
+	return !a.IsCommitted() && !time.Now().Before(a.ExpiresAt)
+}
+
+// analyze detects the content type from the leading bytes of file rather than
+// its extension, computes the SHA-256 checksum and records the dimensions of
+// images. file is rewound afterwards so it can still be uploaded.
+func (a *Attachment) analyze(file io.ReadSeeker) error {
+	_, err := file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(file, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return err
 	}
+	head = head[:n]
+
+	hash := sha256.New()
+	hash.Write(head)
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return err
+	}
+
+	a.ContentType = http.DetectContentType(head)
+	a.Checksum = base64.StdEncoding.EncodeToString(hash.Sum(nil))
+
+	_, err = file.Seek(0, io.SeekStart)
+	if err != nil {
+		return err
+	}
+
+	if strings.HasPrefix(a.ContentType, "image/") {
+		imageConfig, _, err := image.DecodeConfig(file)
+		if err == nil {
+			a.Metadata = map[string]any{
+				"width":  imageConfig.Width,
+				"height": imageConfig.Height,
+			}
+		}
+
+		_, err = file.Seek(0, io.SeekStart)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
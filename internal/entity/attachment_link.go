@@ -0,0 +1,59 @@
+package entity
+
+import (
+	"slices"
+
+	"github.com/google/uuid"
+)
+
+// AttachmentLink attaches an attachment to any record under a name, e.g. the
+// "avatar" of a "user". Several links with the same name form an ordered
+// list.
+type AttachmentLink struct {
+	Base
+
+	AttachmentId uuid.UUID
+	Attachment   *Attachment `bun:"rel:belongs-to,join:attachment_id=id"`
+	RecordType   string
+	RecordId     uuid.UUID
+	Name         string
+	Position     int
+}
+
+// AttachmentLinks is the type of the polymorphic has-many relation entities
+// use to own attachments, e.g.
+//
+//	AttachmentLinks AttachmentLinks `bun:"rel:has-many,join:id=record_id,join:type=record_type,polymorphic:user"`
+type AttachmentLinks []*AttachmentLink
+
+// One returns the attachment linked under name, or nil if there is none.
+func (al AttachmentLinks) One(name string) *Attachment {
+	attachments := al.Many(name)
+	if len(attachments) == 0 {
+		return nil
+	}
+
+	return attachments[0]
+}
+
+// Many returns the committed attachments linked under name ordered by
+// position.
+func (al AttachmentLinks) Many(name string) []*Attachment {
+	links := make(AttachmentLinks, 0, len(al))
+	for _, link := range al {
+		if link.Name == name && link.Attachment != nil && link.Attachment.IsCommitted() {
+			links = append(links, link)
+		}
+	}
+
+	slices.SortStableFunc(links, func(a, b *AttachmentLink) int {
+		return a.Position - b.Position
+	})
+
+	attachments := make([]*Attachment, len(links))
+	for i, link := range links {
+		attachments[i] = link.Attachment
+	}
+
+	return attachments
+}
@@ -0,0 +1,46 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAttachmentLinks_Many(t *testing.T) {
+	t.Run("returns the attachments with the name ordered by position", func(t *testing.T) {
+		first := &Attachment{FileName: "first.png", Status: AttachmentStatusCommitted}
+		second := &Attachment{FileName: "second.png", Status: AttachmentStatusCommitted}
+		links := AttachmentLinks{
+			{Name: "photos", Position: 1, Attachment: second},
+			{Name: "avatar", Position: 0, Attachment: &Attachment{FileName: "avatar.png", Status: AttachmentStatusCommitted}},
+			{Name: "photos", Position: 0, Attachment: first},
+		}
+
+		assert.Equal(t, []*Attachment{first, second}, links.Many("photos"))
+		assert.Empty(t, links.Many("documents"))
+	})
+
+	t.Run("skips attachments that are not committed", func(t *testing.T) {
+		committed := &Attachment{FileName: "committed.png", Status: AttachmentStatusCommitted}
+		links := AttachmentLinks{
+			{Name: "photos", Position: 0, Attachment: &Attachment{FileName: "pending.png", Status: AttachmentStatusPending}},
+			{Name: "photos", Position: 1, Attachment: committed},
+		}
+
+		assert.Equal(t, []*Attachment{committed}, links.Many("photos"))
+	})
+}
+
+func TestAttachmentLinks_One(t *testing.T) {
+	t.Run("returns the first attachment with the name", func(t *testing.T) {
+		avatar := &Attachment{FileName: "avatar.png", Status: AttachmentStatusCommitted}
+		user := &User{AttachmentLinks: AttachmentLinks{{Name: UserAttachmentAvatar, Attachment: avatar}}}
+
+		assert.Equal(t, avatar, user.Avatar())
+	})
+
+	t.Run("returns nil when nothing is linked or links were not loaded", func(t *testing.T) {
+		assert.Nil(t, (&User{}).Avatar())
+		assert.Nil(t, AttachmentLinks{{Name: UserAttachmentAvatar}}.One(UserAttachmentAvatar))
+	})
+}
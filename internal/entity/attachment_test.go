@@ -1,11 +1,18 @@
 package entity
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/base64"
+	"image"
+	"image/png"
 	"mime/multipart"
+	"net/http/httptest"
 	"os"
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/oklog/ulid/v2"
 	"github.com/stretchr/testify/assert"
@@ -31,31 +38,107 @@ func TestNewAttachmentFromFile(t *testing.T) {
 		require.NotNil(t, attachment)
 		assert.Equal(t, "avatar.png", attachment.FileName)
 		assert.Equal(t, int64(len(fileContent)), attachment.ByteSize)
+		assert.Equal(t, testChecksum(fileContent), attachment.Checksum)
+		assert.True(t, attachment.IsCommitted())
 		require.True(t, strings.HasSuffix(attachment.ObjectName, extension))
 
 		objectToken := strings.TrimSuffix(attachment.ObjectName, extension)
 		_, err = ulid.ParseStrict(objectToken)
 		require.NoError(t, err)
 	})
+
+	t.Run("detects the content type from the bytes instead of the extension", func(t *testing.T) {
+		filePath := filepath.Join(t.TempDir(), "avatar.png")
+		require.NoError(t, os.WriteFile(filePath, []byte("%PDF-1.7 report"), 0o600))
+
+		file, err := os.Open(filePath)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			require.NoError(t, file.Close())
+		})
+
+		attachment, err := NewAttachmentFromFile(file)
+
+		require.NoError(t, err)
+		assert.Equal(t, "application/pdf", attachment.ContentType)
+		assert.Nil(t, attachment.Metadata)
+	})
 }
 
 func TestNewAttachmentFromFileHeader(t *testing.T) {
 	t.Run("builds attachment metadata from a multipart file header", func(t *testing.T) {
-		extension := ".pdf"
-		fileHeader := &multipart.FileHeader{
-			Filename: "report.pdf",
-			Size:     128,
-		}
+		extension := ".png"
+		fileContent := testPNG(t, 3, 2)
+		fileHeader := newFileHeader(t, "avatar.png", fileContent)
 
-		attachment := NewAttachmentFromFileHeader(fileHeader)
+		attachment, err := NewAttachmentFromFileHeader(fileHeader)
 
+		require.NoError(t, err)
 		require.NotNil(t, attachment)
-		assert.Equal(t, "report.pdf", attachment.FileName)
-		assert.Equal(t, int64(128), attachment.ByteSize)
+		assert.Equal(t, "avatar.png", attachment.FileName)
+		assert.Equal(t, int64(len(fileContent)), attachment.ByteSize)
+		assert.Equal(t, "image/png", attachment.ContentType)
+		assert.Equal(t, testChecksum(fileContent), attachment.Checksum)
+		assert.Equal(t, map[string]any{"width": 3, "height": 2}, attachment.Metadata)
+		assert.True(t, attachment.IsCommitted())
 		require.True(t, strings.HasSuffix(attachment.ObjectName, extension))
 
 		objectToken := strings.TrimSuffix(attachment.ObjectName, extension)
-		_, err := ulid.ParseStrict(objectToken)
+		_, err = ulid.ParseStrict(objectToken)
 		require.NoError(t, err)
 	})
 }
+
+func TestNewPendingAttachment(t *testing.T) {
+	t.Run("builds a pending attachment that expires", func(t *testing.T) {
+		expiresAt := time.Now().Add(time.Hour)
+
+		attachment := NewPendingAttachment("report.pdf", 128, "application/pdf", "checksum", expiresAt)
+
+		assert.Equal(t, "report.pdf", attachment.FileName)
+		assert.Equal(t, int64(128), attachment.ByteSize)
+		assert.Equal(t, "application/pdf", attachment.ContentType)
+		assert.Equal(t, "checksum", attachment.Checksum)
+		assert.Equal(t, expiresAt, attachment.ExpiresAt)
+		assert.True(t, strings.HasSuffix(attachment.ObjectName, ".pdf"))
+		assert.False(t, attachment.IsCommitted())
+		assert.False(t, attachment.IsExpired())
+	})
+
+	t.Run("is expired once expires at has passed", func(t *testing.T) {
+		attachment := NewPendingAttachment("report.pdf", 128, "application/pdf", "checksum", time.Now().Add(-time.Minute))
+
+		assert.True(t, attachment.IsExpired())
+	})
+}
+
+func newFileHeader(t *testing.T, fileName string, content []byte) *multipart.FileHeader {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = part.Write(content)
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/attachments", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	require.NoError(t, req.ParseMultipartForm(1<<20))
+
+	return req.MultipartForm.File["file"][0]
+}
+
+func testPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	buf := &bytes.Buffer{}
+	require.NoError(t, png.Encode(buf, image.NewGray(image.Rect(0, 0, width, height))))
+	return buf.Bytes()
+}
+
+func testChecksum(content []byte) string {
+	sum := sha256.Sum256(content)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
@@ -0,0 +1,26 @@
+package entity
+
+import "github.com/google/uuid"
+
+// AuditEvent records a security relevant action, who performed it and from
+// where. ActorId and TargetId are kept without foreign keys so that events
+// outlive the users and sessions they mention.
+type AuditEvent struct {
+	Base
+
+	Action         string
+	ActorId        uuid.UUID `bun:",nullzero"`
+	ImpersonatorId uuid.UUID `bun:",nullzero"`
+	TargetType     string
+	TargetId       uuid.UUID `bun:",nullzero"`
+	IpAddress      string
+	UserAgent      string
+	RequestId      string
+	Changes        map[string]AuditEventChange `bun:",type:jsonb,nullzero"`
+	Metadata       map[string]any              `bun:",type:jsonb,nullzero"`
+}
+
+type AuditEventChange struct {
+	From any `json:"from"`
+	To   any `json:"to"`
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+)
+
+type EmailVerificationToken struct {
+	Base
+
+	UserId      uuid.UUID
+	User        *User  `bun:"rel:belongs-to,join:user_id=id"`
+	Token       string `bun:"-"`
+	TokenDigest string
+	ExpiresAt   time.Time
+	UsedAt      time.Time `bun:",nullzero"`
+}
+
+func (evt *EmailVerificationToken) GenerateToken(lifetime time.Duration) {
+	evt.Token = util.GenerateToken()
+	evt.TokenDigest = util.DigestToken(evt.Token)
+	evt.ExpiresAt = time.Now().Add(lifetime)
+}
+
+func (evt *EmailVerificationToken) IsUsable() bool {
+	return evt.UsedAt.IsZero() && time.Now().Before(evt.ExpiresAt)
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEmailVerificationToken_GenerateToken(t *testing.T) {
+	t.Run("stores a random token, its digest and the expiry", func(t *testing.T) {
+		emailVerificationToken := &EmailVerificationToken{}
+
+		startedAt := time.Now()
+		emailVerificationToken.GenerateToken(time.Hour)
+
+		assert.NotEmpty(t, emailVerificationToken.Token)
+		assert.Equal(t, util.DigestToken(emailVerificationToken.Token), emailVerificationToken.TokenDigest)
+		assert.False(t, emailVerificationToken.ExpiresAt.Before(startedAt.Add(time.Hour)))
+	})
+}
+
+func TestEmailVerificationToken_IsUsable(t *testing.T) {
+	t.Run("returns true for an unused token before its expiry", func(t *testing.T) {
+		emailVerificationToken := &EmailVerificationToken{ExpiresAt: time.Now().Add(time.Hour)}
+
+		assert.True(t, emailVerificationToken.IsUsable())
+	})
+
+	t.Run("returns false once the token has been used", func(t *testing.T) {
+		emailVerificationToken := &EmailVerificationToken{
+			ExpiresAt: time.Now().Add(time.Hour),
+			UsedAt:    time.Now(),
+		}
+
+		assert.False(t, emailVerificationToken.IsUsable())
+	})
+
+	t.Run("returns false once the token has expired", func(t *testing.T) {
+		emailVerificationToken := &EmailVerificationToken{ExpiresAt: time.Now().Add(-time.Second)}
+
+		assert.False(t, emailVerificationToken.IsUsable())
+	})
+}
@@ -0,0 +1,8 @@
+package entity
+
+type FailedSignInAttempt struct {
+	Base
+
+	EmailAddress string
+	IpAddress    string
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "github.com/google/uuid"
+
+type Identity struct {
+	Base
+
+	UserId       uuid.UUID
+	User         *User `bun:"rel:belongs-to,join:user_id=id"`
+	Provider     string
+	Subject      string
+	EmailAddress string
+}
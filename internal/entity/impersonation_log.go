@@ -0,0 +1,16 @@
+package entity
+
+import "github.com/google/uuid"
+
+// ImpersonationLog records a request made through an impersonated session.
+type ImpersonationLog struct {
+	Base
+
+	UserSessionId  uuid.UUID
+	ImpersonatorId uuid.UUID
+	UserId         uuid.UUID
+	Method         string
+	Path           string
+	IpAddress      string
+	UserAgent      string
+}
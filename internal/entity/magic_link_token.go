@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+)
+
+type MagicLinkToken struct {
+	Base
+
+	UserId      uuid.UUID
+	User        *User  `bun:"rel:belongs-to,join:user_id=id"`
+	Token       string `bun:"-"`
+	TokenDigest string
+	ExpiresAt   time.Time
+	UsedAt      time.Time `bun:",nullzero"`
+}
+
+func (mlt *MagicLinkToken) GenerateToken(lifetime time.Duration) {
+	mlt.Token = util.GenerateToken()
+	mlt.TokenDigest = util.DigestToken(mlt.Token)
+	mlt.ExpiresAt = time.Now().Add(lifetime)
+}
+
+func (mlt *MagicLinkToken) IsUsable() bool {
+	return mlt.UsedAt.IsZero() && time.Now().Before(mlt.ExpiresAt)
+}
@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMagicLinkToken_GenerateToken(t *testing.T) {
+	t.Run("stores a random token, its digest and the expiry", func(t *testing.T) {
+		magicLinkToken := &MagicLinkToken{}
+
+		startedAt := time.Now()
+		magicLinkToken.GenerateToken(time.Hour)
+
+		assert.NotEmpty(t, magicLinkToken.Token)
+		assert.Equal(t, util.DigestToken(magicLinkToken.Token), magicLinkToken.TokenDigest)
+		assert.False(t, magicLinkToken.ExpiresAt.Before(startedAt.Add(time.Hour)))
+	})
+}
+
+func TestMagicLinkToken_IsUsable(t *testing.T) {
+	t.Run("returns true for an unused token before its expiry", func(t *testing.T) {
+		magicLinkToken := &MagicLinkToken{ExpiresAt: time.Now().Add(time.Hour)}
+
+		assert.True(t, magicLinkToken.IsUsable())
+	})
+
+	t.Run("returns false once the token has been used", func(t *testing.T) {
+		magicLinkToken := &MagicLinkToken{
+			ExpiresAt: time.Now().Add(time.Hour),
+			UsedAt:    time.Now(),
+		}
+
+		assert.False(t, magicLinkToken.IsUsable())
+	})
+
+	t.Run("returns false once the token has expired", func(t *testing.T) {
+		magicLinkToken := &MagicLinkToken{ExpiresAt: time.Now().Add(-time.Second)}
+
+		assert.False(t, magicLinkToken.IsUsable())
+	})
+}
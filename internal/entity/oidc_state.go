@@ -0,0 +1,37 @@
+package entity
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+)
+
+type OidcState struct {
+	Base
+
+	Provider     string
+	State        string `bun:"-"`
+	StateDigest  string
+	Nonce        string
+	CodeVerifier string
+	ExpiresAt    time.Time
+}
+
+func (o *OidcState) Generate(lifetime time.Duration) {
+	o.State = util.GenerateToken()
+	o.StateDigest = util.DigestToken(o.State)
+	o.Nonce = util.GenerateToken()
+	o.CodeVerifier = util.GenerateToken()
+	o.ExpiresAt = time.Now().Add(lifetime)
+}
+
+func (o *OidcState) CodeChallenge() string {
+	digest := sha256.Sum256([]byte(o.CodeVerifier))
+	return base64.RawURLEncoding.EncodeToString(digest[:])
+}
+
+func (o *OidcState) IsExpired() bool {
+	return !time.Now().Before(o.ExpiresAt)
+}
@@ -0,0 +1,41 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOidcState_Generate(t *testing.T) {
+	t.Run("stores a random state, nonce and code verifier with an expiry", func(t *testing.T) {
+		oidcState := &OidcState{}
+
+		startedAt := time.Now()
+		oidcState.Generate(time.Minute)
+
+		assert.NotEmpty(t, oidcState.State)
+		assert.Equal(t, util.DigestToken(oidcState.State), oidcState.StateDigest)
+		assert.NotEmpty(t, oidcState.Nonce)
+		assert.NotEqual(t, oidcState.State, oidcState.Nonce)
+		assert.Len(t, oidcState.CodeVerifier, 43)
+		assert.False(t, oidcState.ExpiresAt.Before(startedAt.Add(time.Minute)))
+	})
+}
+
+func TestOidcState_CodeChallenge(t *testing.T) {
+	t.Run("derives the S256 challenge from the code verifier", func(t *testing.T) {
+		oidcState := &OidcState{CodeVerifier: "dBjftJeZ4CVP-mB92K27uhbUJU1p1r_wW1gFWFOEjXk"}
+
+		assert.Equal(t, "E9Melhoa2OwvFrEMTJguCHaoeK1t8URWbuGJSstw-cM", oidcState.CodeChallenge())
+	})
+}
+
+func TestOidcState_IsExpired(t *testing.T) {
+	t.Run("returns true once the expiry has passed", func(t *testing.T) {
+		oidcState := &OidcState{ExpiresAt: time.Now().Add(-time.Second)}
+
+		assert.True(t, oidcState.IsExpired())
+	})
+}
@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+)
+
+type PasswordResetToken struct {
+	Base
+
+	UserId      uuid.UUID
+	User        *User  `bun:"rel:belongs-to,join:user_id=id"`
+	Token       string `bun:"-"`
+	TokenDigest string
+	ExpiresAt   time.Time
+	UsedAt      time.Time `bun:",nullzero"`
+}
+
+func (prt *PasswordResetToken) GenerateToken(lifetime time.Duration) {
+	prt.Token = util.GenerateToken()
+	prt.TokenDigest = util.DigestToken(prt.Token)
+	prt.ExpiresAt = time.Now().Add(lifetime)
+}
+
+func (prt *PasswordResetToken) IsUsable() bool {
+	return prt.UsedAt.IsZero() && time.Now().Before(prt.ExpiresAt)
+}
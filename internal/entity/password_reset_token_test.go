@@ -0,0 +1,45 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPasswordResetToken_GenerateToken(t *testing.T) {
+	t.Run("stores a random token, its digest and the expiry", func(t *testing.T) {
+		passwordResetToken := &PasswordResetToken{}
+
+		startedAt := time.Now()
+		passwordResetToken.GenerateToken(time.Hour)
+
+		assert.NotEmpty(t, passwordResetToken.Token)
+		assert.Equal(t, util.DigestToken(passwordResetToken.Token), passwordResetToken.TokenDigest)
+		assert.False(t, passwordResetToken.ExpiresAt.Before(startedAt.Add(time.Hour)))
+	})
+}
+
+func TestPasswordResetToken_IsUsable(t *testing.T) {
+	t.Run("returns true for an unused token before its expiry", func(t *testing.T) {
+		passwordResetToken := &PasswordResetToken{ExpiresAt: time.Now().Add(time.Hour)}
+
+		assert.True(t, passwordResetToken.IsUsable())
+	})
+
+	t.Run("returns false once the token has been used", func(t *testing.T) {
+		passwordResetToken := &PasswordResetToken{
+			ExpiresAt: time.Now().Add(time.Hour),
+			UsedAt:    time.Now(),
+		}
+
+		assert.False(t, passwordResetToken.IsUsable())
+	})
+
+	t.Run("returns false once the token has expired", func(t *testing.T) {
+		passwordResetToken := &PasswordResetToken{ExpiresAt: time.Now().Add(-time.Second)}
+
+		assert.False(t, passwordResetToken.IsUsable())
+	})
+}
@@ -0,0 +1,7 @@
+package entity
+
+type Permission struct {
+	Base
+
+	Name string
+}
@@ -0,0 +1,8 @@
+package entity
+
+type Role struct {
+	Base
+
+	Name        string
+	Description string
+}
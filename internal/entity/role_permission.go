@@ -0,0 +1,13 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type RolePermission struct {
+	RoleId       uuid.UUID `bun:",pk,type:uuid"`
+	PermissionId uuid.UUID `bun:",pk,type:uuid"`
+	CreatedAt    time.Time `bun:",nullzero,notnull,default:now()"`
+}
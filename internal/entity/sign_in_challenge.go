@@ -0,0 +1,29 @@
+package entity
+
+import (
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+)
+
+type SignInChallenge struct {
+	Base
+
+	UserId      uuid.UUID
+	User        *User  `bun:"rel:belongs-to,join:user_id=id"`
+	Token       string `bun:"-"`
+	TokenDigest string
+	ExpiresAt   time.Time
+	Attempts    int
+}
+
+func (sic *SignInChallenge) GenerateToken(lifetime time.Duration) {
+	sic.Token = util.GenerateToken()
+	sic.TokenDigest = util.DigestToken(sic.Token)
+	sic.ExpiresAt = time.Now().Add(lifetime)
+}
+
+func (sic *SignInChallenge) IsExpired() bool {
+	return !time.Now().Before(sic.ExpiresAt)
+}
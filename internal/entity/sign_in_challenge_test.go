@@ -0,0 +1,36 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignInChallenge_GenerateToken(t *testing.T) {
+	t.Run("stores a random token, its digest and the expiry", func(t *testing.T) {
+		signInChallenge := &SignInChallenge{}
+
+		startedAt := time.Now()
+		signInChallenge.GenerateToken(time.Minute)
+
+		assert.NotEmpty(t, signInChallenge.Token)
+		assert.Equal(t, util.DigestToken(signInChallenge.Token), signInChallenge.TokenDigest)
+		assert.False(t, signInChallenge.ExpiresAt.Before(startedAt.Add(time.Minute)))
+	})
+}
+
+func TestSignInChallenge_IsExpired(t *testing.T) {
+	t.Run("returns false before the expiry", func(t *testing.T) {
+		signInChallenge := &SignInChallenge{ExpiresAt: time.Now().Add(time.Minute)}
+
+		assert.False(t, signInChallenge.IsExpired())
+	})
+
+	t.Run("returns true once the expiry has passed", func(t *testing.T) {
+		signInChallenge := &SignInChallenge{ExpiresAt: time.Now().Add(-time.Second)}
+
+		assert.True(t, signInChallenge.IsExpired())
+	})
+}
@@ -0,0 +1,21 @@
+package entity
+
+import "time"
+
+const (
+	SignInLockoutScopeAccount = "account"
+	SignInLockoutScopeIp      = "ip"
+)
+
+type SignInLockout struct {
+	Base
+
+	Scope          string
+	Subject        string
+	FailedAttempts int
+	LockedUntil    time.Time
+}
+
+func (sil *SignInLockout) IsActive() bool {
+	return time.Now().Before(sil.LockedUntil)
+}
@@ -0,0 +1,22 @@
+package entity
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSignInLockout_IsActive(t *testing.T) {
+	t.Run("returns true until the lockout ends", func(t *testing.T) {
+		signInLockout := &SignInLockout{LockedUntil: time.Now().Add(time.Minute)}
+
+		assert.True(t, signInLockout.IsActive())
+	})
+
+	t.Run("returns false once the lockout has ended", func(t *testing.T) {
+		signInLockout := &SignInLockout{LockedUntil: time.Now().Add(-time.Second)}
+
+		assert.False(t, signInLockout.IsActive())
+	})
+}
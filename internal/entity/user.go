@@ -1,25 +1,63 @@
 package entity
 
-import "golang.org/x/crypto/bcrypt"
+import (
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+)
+
+const (
+	AttachmentRecordTypeUser = "user"
+	UserAttachmentAvatar     = "avatar"
+)
 
 type User struct {
 	Base
 
-	Name           string
-	EmailAddress   string
-	PasswordDigest string
+	Name             string
+	EmailAddress     string
+	PasswordDigest   string
+	EmailVerifiedAt  time.Time `bun:",nullzero"`
+	TotpSecret       string    `bun:",nullzero"`
+	TotpEnabledAt    time.Time `bun:",nullzero"`
+	TotpLastUsedStep int64     `bun:",nullzero"`
+	DeletedAt        time.Time `bun:",soft_delete,nullzero"`
+
+	AttachmentLinks AttachmentLinks `bun:"rel:has-many,join:id=record_id,join:type=record_type,polymorphic:user"`
 }
 
-func (u *User) HashPassword(password string) error {
-	hash, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+// Avatar is only available when AttachmentLinks has been eager loaded.
+func (u *User) Avatar() *Attachment {
+	return u.AttachmentLinks.One(UserAttachmentAvatar)
+}
+
+func (u *User) HashPassword(password string, params util.Argon2idParams) error {
+	digest, err := util.HashPassword(password, params)
 	if err != nil {
 		return err
 	}
-	u.PasswordDigest = string(hash)
+	u.PasswordDigest = digest
 
 	return nil
 }
 
 func (u *User) ComparePassword(password string) error {
-	return bcrypt.CompareHashAndPassword([]byte(u.PasswordDigest), []byte(password))
+	return util.ComparePassword(u.PasswordDigest, password)
+}
+
+func (u *User) PasswordNeedsRehash(params util.Argon2idParams) bool {
+	return util.PasswordNeedsRehash(u.PasswordDigest, params)
+}
+
+// HasPassword is false for accounts created through an identity provider.
+func (u *User) HasPassword() bool {
+	return u.PasswordDigest != ""
+}
+
+func (u *User) IsEmailVerified() bool {
+	return !u.EmailVerifiedAt.IsZero()
+}
+
+func (u *User) IsTotpEnabled() bool {
+	return !u.TotpEnabledAt.IsZero()
 }
@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"crypto/rand"
+	"encoding/base32"
+	"strings"
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+)
+
+type UserRecoveryCode struct {
+	Base
+
+	UserId     uuid.UUID
+	User       *User  `bun:"rel:belongs-to,join:user_id=id"`
+	Code       string `bun:"-"`
+	CodeDigest string
+	UsedAt     time.Time `bun:",nullzero"`
+}
+
+func (urc *UserRecoveryCode) GenerateCode() {
+	b := make([]byte, 10)
+	_, _ = rand.Read(b)
+	code := strings.ToLower(base32.StdEncoding.EncodeToString(b))
+
+	urc.Code = code[:8] + "-" + code[8:]
+	urc.CodeDigest = util.DigestToken(urc.Code)
+}
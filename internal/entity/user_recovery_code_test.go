@@ -0,0 +1,19 @@
+package entity
+
+import (
+	"testing"
+
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUserRecoveryCode_GenerateCode(t *testing.T) {
+	t.Run("stores a readable random code and its digest", func(t *testing.T) {
+		userRecoveryCode := &UserRecoveryCode{}
+
+		userRecoveryCode.GenerateCode()
+
+		assert.Regexp(t, `^[a-z2-7]{8}-[a-z2-7]{8}$`, userRecoveryCode.Code)
+		assert.Equal(t, util.DigestToken(userRecoveryCode.Code), userRecoveryCode.CodeDigest)
+	})
+}
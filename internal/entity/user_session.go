@@ -1,20 +1,46 @@
 package entity
 
 import (
+	"time"
+
+	"github.com/anonychun/bibit/internal/util"
 	"github.com/google/uuid"
-	"github.com/oklog/ulid/v2"
 )
 
 type UserSession struct {
 	Base
 
-	UserId    uuid.UUID
-	User      *User `bun:"rel:belongs-to,join:user_id=id"`
-	Token     string
-	IpAddress string
-	UserAgent string
+	UserId         uuid.UUID
+	User           *User  `bun:"rel:belongs-to,join:user_id=id"`
+	Token          string `bun:"-"`
+	TokenDigest    string
+	IpAddress      string
+	UserAgent      string
+	LastSeenAt     time.Time
+	ExpiresAt      time.Time
+	ImpersonatorId uuid.UUID `bun:",nullzero"`
 }
 
 func (as *UserSession) GenerateToken() {
-	as.Token = ulid.Make().String()
+	as.Token = util.GenerateToken()
+	as.TokenDigest = util.DigestToken(as.Token)
+}
+
+func (as *UserSession) SetExpiration(maxLifetime time.Duration) {
+	now := time.Now()
+	as.LastSeenAt = now
+	as.ExpiresAt = now.Add(maxLifetime)
+}
+
+func (as *UserSession) IsImpersonated() bool {
+	return as.ImpersonatorId != uuid.Nil
+}
+
+func (as *UserSession) IsExpired(idleTimeout time.Duration) bool {
+	now := time.Now()
+	if !now.Before(as.ExpiresAt) {
+		return true
+	}
+
+	return !now.Before(as.LastSeenAt.Add(idleTimeout))
 }
@@ -2,21 +2,89 @@ package entity
 
 import (
 	"testing"
+	"time"
 
-	"github.com/oklog/ulid/v2"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
-	"github.com/stretchr/testify/require"
 )
 
 func TestUserSession_GenerateToken(t *testing.T) {
-	t.Run("stores a valid ULID token", func(t *testing.T) {
+	t.Run("stores a random token and its digest", func(t *testing.T) {
 		userSession := &UserSession{}
 
 		userSession.GenerateToken()
 
 		assert.NotEmpty(t, userSession.Token)
+		assert.Equal(t, util.DigestToken(userSession.Token), userSession.TokenDigest)
+	})
+
+	t.Run("generates a different token every time", func(t *testing.T) {
+		first := &UserSession{}
+		second := &UserSession{}
+
+		first.GenerateToken()
+		second.GenerateToken()
+
+		assert.NotEqual(t, first.Token, second.Token)
+		assert.NotEqual(t, first.TokenDigest, second.TokenDigest)
+	})
+}
+
+func TestUserSession_SetExpiration(t *testing.T) {
+	t.Run("marks the session as seen now and expires it after the max lifetime", func(t *testing.T) {
+		userSession := &UserSession{}
+		maxLifetime := 24 * time.Hour
+
+		startedAt := time.Now()
+		userSession.SetExpiration(maxLifetime)
+		finishedAt := time.Now()
+
+		assert.False(t, userSession.LastSeenAt.Before(startedAt))
+		assert.False(t, userSession.LastSeenAt.After(finishedAt))
+		assert.Equal(t, userSession.LastSeenAt.Add(maxLifetime), userSession.ExpiresAt)
+	})
+}
+
+func TestUserSession_IsImpersonated(t *testing.T) {
+	t.Run("returns true when the session was started by an impersonator", func(t *testing.T) {
+		userSession := &UserSession{ImpersonatorId: uuid.New()}
+
+		assert.True(t, userSession.IsImpersonated())
+	})
+
+	t.Run("returns false for sessions started by the user", func(t *testing.T) {
+		userSession := &UserSession{}
+
+		assert.False(t, userSession.IsImpersonated())
+	})
+}
+
+func TestUserSession_IsExpired(t *testing.T) {
+	t.Run("returns false for a recently seen session before its expiry", func(t *testing.T) {
+		userSession := &UserSession{
+			LastSeenAt: time.Now().Add(-time.Minute),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+
+		assert.False(t, userSession.IsExpired(time.Hour))
+	})
+
+	t.Run("returns true once the absolute lifetime has passed", func(t *testing.T) {
+		userSession := &UserSession{
+			LastSeenAt: time.Now(),
+			ExpiresAt:  time.Now().Add(-time.Second),
+		}
+
+		assert.True(t, userSession.IsExpired(time.Hour))
+	})
+
+	t.Run("returns true once the session has been idle for too long", func(t *testing.T) {
+		userSession := &UserSession{
+			LastSeenAt: time.Now().Add(-2 * time.Hour),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
 
-		_, err := ulid.ParseStrict(userSession.Token)
-		require.NoError(t, err)
+		assert.True(t, userSession.IsExpired(time.Hour))
 	})
 }
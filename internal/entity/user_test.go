@@ -1,22 +1,27 @@
 package entity
 
 import (
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/anonychun/bibit/internal/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
 )
 
+var testArgon2idParams = util.Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
 func TestUser_HashAndComparePassword(t *testing.T) {
-	t.Run("stores a bcrypt digest and accepts the original password", func(t *testing.T) {
+	t.Run("stores an argon2id digest and accepts the original password", func(t *testing.T) {
 		user := &User{}
 		password := "correct horse battery staple"
 
-		err := user.HashPassword(password)
+		err := user.HashPassword(password, testArgon2idParams)
 
 		require.NoError(t, err)
-		assert.NotEmpty(t, user.PasswordDigest)
-		assert.NotEqual(t, password, user.PasswordDigest)
+		assert.True(t, strings.HasPrefix(user.PasswordDigest, "$argon2id$"))
 		assert.NoError(t, user.ComparePassword(password))
 	})
 
@@ -24,8 +29,85 @@ func TestUser_HashAndComparePassword(t *testing.T) {
 		user := &User{}
 		password := "correct horse battery staple"
 
-		require.NoError(t, user.HashPassword(password))
+		require.NoError(t, user.HashPassword(password, testArgon2idParams))
+
+		assert.Error(t, user.ComparePassword("this is not the password"))
+	})
+
+	t.Run("accepts the original password for legacy bcrypt digests", func(t *testing.T) {
+		digest, err := bcrypt.GenerateFromPassword([]byte("correct horse battery staple"), bcrypt.MinCost)
+		require.NoError(t, err)
+		user := &User{PasswordDigest: string(digest)}
 
+		assert.NoError(t, user.ComparePassword("correct horse battery staple"))
 		assert.Error(t, user.ComparePassword("this is not the password"))
 	})
 }
+
+func TestUser_PasswordNeedsRehash(t *testing.T) {
+	t.Run("returns false for digests created with the current parameters", func(t *testing.T) {
+		user := &User{}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testArgon2idParams))
+
+		assert.False(t, user.PasswordNeedsRehash(testArgon2idParams))
+	})
+
+	t.Run("returns true for digests created with outdated parameters", func(t *testing.T) {
+		user := &User{}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testArgon2idParams))
+
+		params := testArgon2idParams
+		params.Iterations = 2
+		assert.True(t, user.PasswordNeedsRehash(params))
+	})
+
+	t.Run("returns true for legacy bcrypt digests", func(t *testing.T) {
+		digest, err := bcrypt.GenerateFromPassword([]byte("correct horse battery staple"), bcrypt.MinCost)
+		require.NoError(t, err)
+		user := &User{PasswordDigest: string(digest)}
+
+		assert.True(t, user.PasswordNeedsRehash(testArgon2idParams))
+	})
+}
+
+func TestUser_HasPassword(t *testing.T) {
+	t.Run("returns false for accounts created through an identity provider", func(t *testing.T) {
+		user := &User{}
+
+		assert.False(t, user.HasPassword())
+	})
+
+	t.Run("returns true once a password is set", func(t *testing.T) {
+		user := &User{PasswordDigest: "password-digest"}
+
+		assert.True(t, user.HasPassword())
+	})
+}
+
+func TestUser_IsEmailVerified(t *testing.T) {
+	t.Run("returns false until the email address is verified", func(t *testing.T) {
+		user := &User{}
+
+		assert.False(t, user.IsEmailVerified())
+	})
+
+	t.Run("returns true once the email address is verified", func(t *testing.T) {
+		user := &User{EmailVerifiedAt: time.Now()}
+
+		assert.True(t, user.IsEmailVerified())
+	})
+}
+
+func TestUser_IsTotpEnabled(t *testing.T) {
+	t.Run("returns false while enrollment is unconfirmed", func(t *testing.T) {
+		user := &User{TotpSecret: "JBSWY3DPEHPK3PXP"}
+
+		assert.False(t, user.IsTotpEnabled())
+	})
+
+	t.Run("returns true once enrollment is confirmed", func(t *testing.T) {
+		user := &User{TotpSecret: "JBSWY3DPEHPK3PXP", TotpEnabledAt: time.Now()}
+
+		assert.True(t, user.IsTotpEnabled())
+	})
+}
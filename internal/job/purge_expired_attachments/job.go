@@ -0,0 +1,71 @@
+package purge_expired_attachments
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	repositoryAttachment "github.com/anonychun/bibit/internal/repository/attachment"
+	"github.com/anonychun/bibit/internal/storage"
+	"github.com/riverqueue/river"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewJob)
+}
+
+const batchSize = 100
+
+type Args struct{}
+
+func (Args) Kind() string {
+	return "purge_expired_attachments"
+}
+
+// Job deletes pending attachments whose upload was never confirmed, together
+// with any object the client may have uploaded for them. The row is deleted
+// first and only while still pending, so an upload confirmed in the meantime
+// keeps its object.
+type Job struct {
+	river.WorkerDefaults[Args]
+
+	storage              storage.IStorage
+	attachmentRepository repositoryAttachment.IRepository
+}
+
+func NewJob(i do.Injector) (*Job, error) {
+	return &Job{
+		storage:              do.MustInvoke[*storage.Storage](i),
+		attachmentRepository: do.MustInvoke[*repositoryAttachment.Repository](i),
+	}, nil
+}
+
+func (j *Job) Work(ctx context.Context, job *river.Job[Args]) error {
+	for {
+		attachments, err := j.attachmentRepository.FindAllExpiredPending(ctx, time.Now(), batchSize)
+		if err != nil {
+			return err
+		}
+
+		for _, attachment := range attachments {
+			isDeleted, err := j.attachmentRepository.DeletePendingById(ctx, attachment.Id)
+			if err != nil {
+				return err
+			}
+
+			if !isDeleted {
+				continue
+			}
+
+			err = j.storage.Delete(ctx, attachment.ObjectName)
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(attachments) < batchSize {
+			return nil
+		}
+	}
+}
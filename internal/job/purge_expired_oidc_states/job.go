@@ -0,0 +1,39 @@
+package purge_expired_oidc_states
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	repositoryOidcState "github.com/anonychun/bibit/internal/repository/oidc_state"
+	"github.com/riverqueue/river"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewJob)
+}
+
+type Args struct{}
+
+func (Args) Kind() string {
+	return "purge_expired_oidc_states"
+}
+
+// Job deletes the states of OIDC sign ins that were started but never
+// completed.
+type Job struct {
+	river.WorkerDefaults[Args]
+
+	oidcStateRepository repositoryOidcState.IRepository
+}
+
+func NewJob(i do.Injector) (*Job, error) {
+	return &Job{
+		oidcStateRepository: do.MustInvoke[*repositoryOidcState.Repository](i),
+	}, nil
+}
+
+func (j *Job) Work(ctx context.Context, job *river.Job[Args]) error {
+	return j.oidcStateRepository.DeleteAllExpired(ctx, time.Now())
+}
@@ -0,0 +1,83 @@
+package send_email
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/mailer"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/riverqueue/river"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewJob)
+}
+
+var ErrMissingSecretKey = errors.New("APP_SECRET_KEY is required to send emails")
+
+// Args keeps the email sealed with APP_SECRET_KEY. River stores job args in
+// plaintext and emails carry single-use links that only exist as digests in
+// the database.
+type Args struct {
+	SealedEmail []byte
+}
+
+func (Args) Kind() string {
+	return "send_email"
+}
+
+func NewArgs(cfg *config.Config, email mailer.Email) (Args, error) {
+	if cfg.App.SecretKey == "" {
+		return Args{}, ErrMissingSecretKey
+	}
+
+	data, err := json.Marshal(email)
+	if err != nil {
+		return Args{}, err
+	}
+
+	sealedEmail, err := util.Seal(cfg.App.SecretKey, data)
+	if err != nil {
+		return Args{}, err
+	}
+
+	return Args{SealedEmail: sealedEmail}, nil
+}
+
+type Job struct {
+	river.WorkerDefaults[Args]
+
+	config *config.Config
+	mailer mailer.IMailer
+}
+
+func NewJob(i do.Injector) (*Job, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	if cfg.App.SecretKey == "" {
+		return nil, ErrMissingSecretKey
+	}
+
+	return &Job{
+		config: cfg,
+		mailer: do.MustInvoke[*mailer.Mailer](i),
+	}, nil
+}
+
+func (j *Job) Work(ctx context.Context, job *river.Job[Args]) error {
+	data, err := util.Open(j.config.App.SecretKey, job.Args.SealedEmail)
+	if err != nil {
+		return err
+	}
+
+	email := mailer.Email{}
+	err = json.Unmarshal(data, &email)
+	if err != nil {
+		return err
+	}
+
+	return j.mailer.Send(ctx, email)
+}
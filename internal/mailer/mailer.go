@@ -0,0 +1,98 @@
+package mailer
+
+import (
+	"bytes"
+	"context"
+	"embed"
+	"fmt"
+	htmlTemplate "html/template"
+	textTemplate "text/template"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/samber/do/v2"
+)
+
+//go:embed templates
+var templatesFs embed.FS
+
+func init() {
+	do.Provide(bootstrap.Injector, NewMailer)
+}
+
+type Email struct {
+	To       string
+	Subject  string
+	Template string
+	Data     map[string]any
+}
+
+type IMailer interface {
+	Send(ctx context.Context, email Email) error
+}
+
+type Mailer struct {
+	config        *config.Config
+	transport     ITransport
+	htmlTemplates *htmlTemplate.Template
+	textTemplates *textTemplate.Template
+}
+
+var _ IMailer = (*Mailer)(nil)
+
+func NewMailer(i do.Injector) (*Mailer, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+
+	var transport ITransport
+	switch cfg.Mailer.Transport {
+	case "smtp":
+		transport = NewSmtpTransport(cfg.Mailer.Smtp.Host, cfg.Mailer.Smtp.Port, cfg.Mailer.Smtp.Username, cfg.Mailer.Smtp.Password)
+	case "file":
+		transport = NewFileTransport(cfg.Mailer.File.Dir)
+	default:
+		return nil, fmt.Errorf("unknown mailer transport %q", cfg.Mailer.Transport)
+	}
+
+	return newMailer(cfg, transport)
+}
+
+func newMailer(cfg *config.Config, transport ITransport) (*Mailer, error) {
+	htmlTemplates, err := htmlTemplate.ParseFS(templatesFs, "templates/*.html.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	textTemplates, err := textTemplate.ParseFS(templatesFs, "templates/*.txt.tmpl")
+	if err != nil {
+		return nil, err
+	}
+
+	return &Mailer{
+		config:        cfg,
+		transport:     transport,
+		htmlTemplates: htmlTemplates,
+		textTemplates: textTemplates,
+	}, nil
+}
+
+func (m *Mailer) Send(ctx context.Context, email Email) error {
+	html := &bytes.Buffer{}
+	err := m.htmlTemplates.ExecuteTemplate(html, email.Template+".html.tmpl", email.Data)
+	if err != nil {
+		return err
+	}
+
+	text := &bytes.Buffer{}
+	err = m.textTemplates.ExecuteTemplate(text, email.Template+".txt.tmpl", email.Data)
+	if err != nil {
+		return err
+	}
+
+	return m.transport.Send(ctx, &Message{
+		From:    m.config.Mailer.From,
+		To:      email.To,
+		Subject: email.Subject,
+		Html:    html.String(),
+		Text:    text.String(),
+	})
+}
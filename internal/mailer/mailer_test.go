@@ -0,0 +1,108 @@
+package mailer
+
+import (
+	"context"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMailer_Send(t *testing.T) {
+	t.Run("renders the html and text templates and hands the message to the transport", func(t *testing.T) {
+		ctx := context.Background()
+		cfg := &config.Config{}
+		cfg.Mailer.From = "Bibit <no-reply@example.com>"
+		transport := NewMockITransport(t)
+		mailer, err := newMailer(cfg, transport)
+		require.NoError(t, err)
+
+		transport.EXPECT().Send(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, message *Message) error {
+			assert.Equal(t, "Bibit <no-reply@example.com>", message.From)
+			assert.Equal(t, "ada@example.com", message.To)
+			assert.Equal(t, "Reset your password", message.Subject)
+			assert.Contains(t, message.Html, `<a href="https://example.com/reset-password?token=abc">`)
+			assert.Contains(t, message.Text, "Hi Ada,")
+			assert.Contains(t, message.Text, "https://example.com/reset-password?token=abc")
+			return nil
+		}).Once()
+
+		err = mailer.Send(ctx, Email{
+			To:       "ada@example.com",
+			Subject:  "Reset your password",
+			Template: "password_reset",
+			Data:     map[string]any{"Name": "Ada", "Url": "https://example.com/reset-password?token=abc"},
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns an error for unknown templates", func(t *testing.T) {
+		mailer, err := newMailer(&config.Config{}, NewMockITransport(t))
+		require.NoError(t, err)
+
+		err = mailer.Send(context.Background(), Email{To: "ada@example.com", Template: "missing"})
+
+		require.Error(t, err)
+	})
+}
+
+func TestFileTransport_Send(t *testing.T) {
+	t.Run("writes the message as a multipart eml file", func(t *testing.T) {
+		dir := t.TempDir()
+		transport := NewFileTransport(dir)
+
+		err := transport.Send(context.Background(), &Message{
+			From:    "Bibit <no-reply@example.com>",
+			To:      "ada@example.com",
+			Subject: "Héllo",
+			Html:    "<p>Hello</p>",
+			Text:    "Hello",
+		})
+		require.NoError(t, err)
+
+		files, err := filepath.Glob(filepath.Join(dir, "*.eml"))
+		require.NoError(t, err)
+		require.Len(t, files, 1)
+
+		file, err := os.Open(files[0])
+		require.NoError(t, err)
+		defer file.Close()
+
+		message, err := mail.ReadMessage(file)
+		require.NoError(t, err)
+
+		subject, err := new(mime.WordDecoder).DecodeHeader(message.Header.Get("Subject"))
+		require.NoError(t, err)
+		assert.Equal(t, "Héllo", subject)
+		assert.Equal(t, "ada@example.com", message.Header.Get("To"))
+
+		mediaType, params, err := mime.ParseMediaType(message.Header.Get("Content-Type"))
+		require.NoError(t, err)
+		assert.Equal(t, "multipart/alternative", mediaType)
+
+		reader := multipart.NewReader(message.Body, params["boundary"])
+		var bodies []string
+		for {
+			part, err := reader.NextPart()
+			if err == io.EOF {
+				break
+			}
+			require.NoError(t, err)
+
+			body, err := io.ReadAll(part)
+			require.NoError(t, err)
+			bodies = append(bodies, string(body))
+		}
+
+		assert.Equal(t, []string{"Hello", "<p>Hello</p>"}, bodies)
+	})
+}
@@ -0,0 +1,75 @@
+package mailer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/textproto"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type Message struct {
+	From    string
+	To      string
+	Subject string
+	Html    string
+	Text    string
+}
+
+func (m *Message) Bytes() ([]byte, error) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+
+	for _, part := range []struct {
+		contentType string
+		content     string
+	}{
+		{contentType: "text/plain; charset=utf-8", content: m.Text},
+		{contentType: "text/html; charset=utf-8", content: m.Html},
+	} {
+		partWriter, err := writer.CreatePart(textproto.MIMEHeader{
+			"Content-Type":              {part.contentType},
+			"Content-Transfer-Encoding": {"quoted-printable"},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		err = writeQuotedPrintable(partWriter, part.content)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	err := writer.Close()
+	if err != nil {
+		return nil, err
+	}
+
+	message := &bytes.Buffer{}
+	fmt.Fprintf(message, "From: %s\r\n", m.From)
+	fmt.Fprintf(message, "To: %s\r\n", m.To)
+	fmt.Fprintf(message, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", m.Subject))
+	fmt.Fprintf(message, "Date: %s\r\n", time.Now().Format(time.RFC1123Z))
+	fmt.Fprintf(message, "Message-ID: <%s@bibit>\r\n", uuid.NewString())
+	fmt.Fprintf(message, "MIME-Version: 1.0\r\n")
+	fmt.Fprintf(message, "Content-Type: multipart/alternative; boundary=%q\r\n\r\n", writer.Boundary())
+	message.Write(body.Bytes())
+
+	return message.Bytes(), nil
+}
+
+func writeQuotedPrintable(w io.Writer, content string) error {
+	writer := quotedprintable.NewWriter(w)
+	_, err := writer.Write([]byte(content))
+	if err != nil {
+		return err
+	}
+
+	return writer.Close()
+}
@@ -0,0 +1,82 @@
+package mailer
+
+import (
+	"context"
+	"fmt"
+	"net/mail"
+	"net/smtp"
+	"os"
+	"time"
+)
+
+type ITransport interface {
+	Send(ctx context.Context, message *Message) error
+}
+
+type SmtpTransport struct {
+	addr string
+	auth smtp.Auth
+}
+
+var _ ITransport = (*SmtpTransport)(nil)
+
+func NewSmtpTransport(host string, port int, username, password string) *SmtpTransport {
+	transport := &SmtpTransport{addr: fmt.Sprintf("%s:%d", host, port)}
+	if username != "" {
+		transport.auth = smtp.PlainAuth("", username, password, host)
+	}
+
+	return transport
+}
+
+func (t *SmtpTransport) Send(ctx context.Context, message *Message) error {
+	from, err := mail.ParseAddress(message.From)
+	if err != nil {
+		return err
+	}
+
+	to, err := mail.ParseAddress(message.To)
+	if err != nil {
+		return err
+	}
+
+	body, err := message.Bytes()
+	if err != nil {
+		return err
+	}
+
+	return smtp.SendMail(t.addr, t.auth, from.Address, []string{to.Address}, body)
+}
+
+// FileTransport writes every message to dir as an .eml file instead of
+// delivering it, so mail can be inspected during development and in tests.
+type FileTransport struct {
+	dir string
+}
+
+var _ ITransport = (*FileTransport)(nil)
+
+func NewFileTransport(dir string) *FileTransport {
+	return &FileTransport{dir: dir}
+}
+
+func (t *FileTransport) Send(ctx context.Context, message *Message) error {
+	body, err := message.Bytes()
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(t.dir, 0o755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(t.dir, time.Now().Format("20060102150405")+"-*.eml")
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(body)
+	return err
+}
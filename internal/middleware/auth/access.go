@@ -0,0 +1,30 @@
+package auth
+
+// Access declares who may reach a route or gRPC method. The zero value
+// requires an authenticated user.
+type Access struct {
+	Public   bool
+	Verified bool
+	// Scope is the scope an API key must carry to reach the route. API keys
+	// are rejected on routes without a scope.
+	Scope string
+	// Permission is the permission the user must be granted through one of
+	// their roles.
+	Permission string
+}
+
+var (
+	AccessPublic        = Access{Public: true}
+	AccessAuthenticated = Access{}
+	AccessVerified      = Access{Verified: true}
+)
+
+func (a Access) WithScope(scope string) Access {
+	a.Scope = scope
+	return a
+}
+
+func (a Access) WithPermission(permission string) Access {
+	a.Permission = permission
+	return a
+}
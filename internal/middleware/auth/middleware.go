@@ -1,15 +1,26 @@
 package auth
 
 import (
+	"context"
 	"slices"
+	"strings"
+	"time"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
 	"github.com/anonychun/bibit/internal/consts"
 	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryApiKey "github.com/anonychun/bibit/internal/repository/api_key"
+	repositoryImpersonationLog "github.com/anonychun/bibit/internal/repository/impersonation_log"
+	repositoryPermission "github.com/anonychun/bibit/internal/repository/permission"
 	repositoryUser "github.com/anonychun/bibit/internal/repository/user"
 	repositoryUserSession "github.com/anonychun/bibit/internal/repository/user_session"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 	"github.com/samber/do/v2"
+	"google.golang.org/grpc"
 )
 
 func init() {
@@ -17,52 +28,280 @@ func init() {
 }
 
 type IMiddleware interface {
-	AuthenticateUser(next echo.HandlerFunc) echo.HandlerFunc
+	Authorize(access Access) echo.MiddlewareFunc
+	UnaryAuthorize(methods map[string]Access) grpc.UnaryServerInterceptor
+	StreamAuthorize(methods map[string]Access) grpc.StreamServerInterceptor
 }
 
+// grpcMethod is recorded as the method of impersonated gRPC calls, whose path
+// is the full method name.
+const grpcMethod = "GRPC"
+
+// lastSeenAtResolution throttles last_seen_at and last_used_at writes for
+// active sessions and API keys.
+const lastSeenAtResolution = time.Minute
+
 type Middleware struct {
-	userRepository        repositoryUser.IRepository
-	userSessionRepository repositoryUserSession.IRepository
+	config                     *config.Config
+	userRepository             repositoryUser.IRepository
+	userSessionRepository      repositoryUserSession.IRepository
+	apiKeyRepository           repositoryApiKey.IRepository
+	permissionRepository       repositoryPermission.IRepository
+	impersonationLogRepository repositoryImpersonationLog.IRepository
 }
 
 var _ IMiddleware = (*Middleware)(nil)
 
 func NewMiddleware(i do.Injector) (*Middleware, error) {
 	return &Middleware{
-		userRepository:        do.MustInvoke[*repositoryUser.Repository](i),
-		userSessionRepository: do.MustInvoke[*repositoryUserSession.Repository](i),
+		config:                     do.MustInvoke[*config.Config](i),
+		userRepository:             do.MustInvoke[*repositoryUser.Repository](i),
+		userSessionRepository:      do.MustInvoke[*repositoryUserSession.Repository](i),
+		apiKeyRepository:           do.MustInvoke[*repositoryApiKey.Repository](i),
+		permissionRepository:       do.MustInvoke[*repositoryPermission.Repository](i),
+		impersonationLogRepository: do.MustInvoke[*repositoryImpersonationLog.Repository](i),
 	}, nil
 }
 
-func (m *Middleware) AuthenticateUser(next echo.HandlerFunc) echo.HandlerFunc {
-	return func(c *echo.Context) error {
-		bypassedPaths := []string{
-			"/api/v1/app/auth/signup",
-			"/api/v1/app/auth/signin",
-		}
+func (m *Middleware) Authorize(access Access) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c *echo.Context) error {
+			ctx := withRequest(c.Request().Context(), c.Response().Header().Get(echo.HeaderXRequestID), c.RealIP(), c.Request().UserAgent())
+			c.SetRequest(c.Request().WithContext(ctx))
+
+			if access.Public {
+				return next(c)
+			}
+
+			token := util.HttpBearerToken(c)
+			if token == "" {
+				cookie, err := c.Cookie(consts.CookieUserSession)
+				if err != nil {
+					return consts.ErrUnauthorized
+				}
+
+				token = cookie.Value
+			}
+
+			ctx, err := m.authenticate(ctx, token, access)
+			if err != nil {
+				return err
+			}
+
+			err = m.recordImpersonatedRequest(ctx, c.Request().Method, c.Request().URL.Path)
+			if err != nil {
+				return err
+			}
+
+			c.SetRequest(c.Request().WithContext(ctx))
 
-		if slices.Contains(bypassedPaths, c.Request().URL.Path) {
 			return next(c)
 		}
+	}
+}
+
+func (m *Middleware) UnaryAuthorize(methods map[string]Access) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ctx = withGrpcRequest(ctx)
+
+		access := methods[info.FullMethod]
+		if access.Public {
+			return handler(ctx, req)
+		}
+
+		ctx, err := m.authenticate(ctx, util.GrpcSessionToken(ctx), access)
+		if err != nil {
+			return nil, err
+		}
+
+		err = m.recordImpersonatedRequest(ctx, grpcMethod, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+func (m *Middleware) StreamAuthorize(methods map[string]Access) grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := withGrpcRequest(ss.Context())
+
+		access := methods[info.FullMethod]
+		if access.Public {
+			return handler(srv, &serverStream{
+				ServerStream: ss,
+				ctx:          ctx,
+			})
+		}
+
+		ctx, err := m.authenticate(ctx, util.GrpcSessionToken(ctx), access)
+		if err != nil {
+			return err
+		}
+
+		err = m.recordImpersonatedRequest(ctx, grpcMethod, info.FullMethod)
+		if err != nil {
+			return err
+		}
+
+		return handler(srv, &serverStream{
+			ServerStream: ss,
+			ctx:          ctx,
+		})
+	}
+}
+
+func (m *Middleware) authenticate(ctx context.Context, token string, access Access) (context.Context, error) {
+	if token == "" {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if strings.HasPrefix(token, entity.ApiKeyPrefix) {
+		return m.authenticateApiKey(ctx, token, access)
+	}
+
+	return m.authenticateUserSession(ctx, token, access)
+}
+
+func (m *Middleware) authenticateUserSession(ctx context.Context, token string, access Access) (context.Context, error) {
+	userSession, err := m.userSessionRepository.FindByToken(ctx, token)
+	if err != nil {
+		return nil, consts.ErrUnauthorized
+	}
 
-		cookie, err := c.Cookie(consts.CookieUserSession)
+	if userSession.IsExpired(m.config.Auth.Session.IdleTimeout) {
+		return nil, consts.ErrSessionExpired
+	}
+
+	now := time.Now()
+	if now.Sub(userSession.LastSeenAt) >= lastSeenAtResolution {
+		err = m.userSessionRepository.UpdateLastSeenAtById(ctx, userSession.Id, now)
 		if err != nil {
-			return consts.ErrUnauthorized
+			return nil, err
 		}
+	}
 
-		userSession, err := m.userSessionRepository.FindByToken(c.Request().Context(), cookie.Value)
+	ctx, err = m.authorizeUser(ctx, userSession.UserId, access)
+	if err != nil {
+		return nil, err
+	}
+
+	if userSession.IsImpersonated() {
+		ctx, err = m.authorizeImpersonator(ctx, userSession.ImpersonatorId)
 		if err != nil {
-			return consts.ErrUnauthorized
+			return nil, err
 		}
+	}
+
+	return current.SetUserSession(ctx, userSession), nil
+}
+
+func (m *Middleware) authenticateApiKey(ctx context.Context, key string, access Access) (context.Context, error) {
+	apiKey, err := m.apiKeyRepository.FindByKey(ctx, key)
+	if err != nil || !apiKey.IsActive() {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if access.Scope == "" || !apiKey.HasScope(access.Scope) {
+		return nil, consts.ErrInsufficientScope
+	}
 
-		user, err := m.userRepository.FindById(c.Request().Context(), userSession.UserId)
+	now := time.Now()
+	if now.Sub(apiKey.LastUsedAt) >= lastSeenAtResolution {
+		err = m.apiKeyRepository.UpdateLastUsedAtById(ctx, apiKey.Id, now)
 		if err != nil {
-			return consts.ErrUnauthorized
+			return nil, err
 		}
+	}
+
+	ctx, err = m.authorizeUser(ctx, apiKey.UserId, access)
+	if err != nil {
+		return nil, err
+	}
+
+	return current.SetApiKey(ctx, apiKey), nil
+}
 
-		ctx := current.SetUser(c.Request().Context(), user)
-		c.SetRequest(c.Request().WithContext(ctx))
+// authorizeUser loads the user behind a session or API key together with the
+// permissions granted by their roles and checks them against access.
+func (m *Middleware) authorizeUser(ctx context.Context, id uuid.UUID, access Access) (context.Context, error) {
+	user, err := m.userRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if access.Verified && !user.IsEmailVerified() {
+		return nil, consts.ErrEmailAddressNotVerified
+	}
+
+	permissions, err := m.permissionRepository.FindAllNamesByUserId(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
 
-		return next(c)
+	if access.Permission != "" && !slices.Contains(permissions, access.Permission) {
+		return nil, consts.ErrForbidden
 	}
+
+	ctx = current.SetUser(ctx, user)
+	return current.SetPermissions(ctx, permissions), nil
+}
+
+// authorizeImpersonator ends impersonated sessions as soon as the impersonator
+// loses the permission to impersonate.
+func (m *Middleware) authorizeImpersonator(ctx context.Context, id uuid.UUID) (context.Context, error) {
+	impersonator, err := m.userRepository.FindById(ctx, id)
+	if err != nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	permissions, err := m.permissionRepository.FindAllNamesByUserId(ctx, impersonator.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(permissions, consts.PermissionUsersImpersonate) {
+		return nil, consts.ErrUnauthorized
+	}
+
+	return current.SetImpersonator(ctx, impersonator), nil
+}
+
+func (m *Middleware) recordImpersonatedRequest(ctx context.Context, method, path string) error {
+	impersonator := current.Impersonator(ctx)
+	if impersonator == nil {
+		return nil
+	}
+
+	return m.impersonationLogRepository.Create(ctx, &entity.ImpersonationLog{
+		UserSessionId:  current.UserSession(ctx).Id,
+		ImpersonatorId: impersonator.Id,
+		UserId:         current.User(ctx).Id,
+		Method:         method,
+		Path:           path,
+		IpAddress:      current.IpAddress(ctx),
+		UserAgent:      current.UserAgent(ctx),
+	})
+}
+
+// withRequest stores where the request came from so that usecases can record
+// it, for example in audit events, without threading it through every DTO.
+func withRequest(ctx context.Context, requestId, ipAddress, userAgent string) context.Context {
+	ctx = current.SetRequestId(ctx, requestId)
+	ctx = current.SetIpAddress(ctx, ipAddress)
+	return current.SetUserAgent(ctx, userAgent)
+}
+
+func withGrpcRequest(ctx context.Context) context.Context {
+	return withRequest(ctx, util.GrpcMetadataValue(ctx, "x-request-id"), util.GrpcPeerAddress(ctx), util.GrpcMetadataValue(ctx, "user-agent"))
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
 }
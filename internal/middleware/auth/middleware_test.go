@@ -0,0 +1,473 @@
+package auth
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryApiKey "github.com/anonychun/bibit/internal/repository/api_key"
+	repositoryImpersonationLog "github.com/anonychun/bibit/internal/repository/impersonation_log"
+	repositoryPermission "github.com/anonychun/bibit/internal/repository/permission"
+	repositoryUser "github.com/anonychun/bibit/internal/repository/user"
+	repositoryUserSession "github.com/anonychun/bibit/internal/repository/user_session"
+	pbApiV1AppAuth "github.com/anonychun/bibit/pkg/pb/api/v1/app/auth"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestMiddleware_Authorize(t *testing.T) {
+	t.Run("skips authentication for public routes", func(t *testing.T) {
+		middleware := &Middleware{}
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+		err := middleware.Authorize(AccessPublic)(func(c *echo.Context) error {
+			assert.Nil(t, current.User(c.Request().Context()))
+			return nil
+		})(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("stores the request id, ip address and user agent for usecases", func(t *testing.T) {
+		middleware := &Middleware{}
+		req := httptest.NewRequest(http.MethodPost, "/", nil)
+		req.Header.Set("User-Agent", "Go test")
+		req.Header.Set("X-Real-IP", "192.0.2.1")
+		rec := httptest.NewRecorder()
+		rec.Header().Set(echo.HeaderXRequestID, "request-id")
+		ctx := echo.New().NewContext(req, rec)
+
+		err := middleware.Authorize(AccessPublic)(func(c *echo.Context) error {
+			assert.Equal(t, "request-id", current.RequestId(c.Request().Context()))
+			assert.Equal(t, "192.0.2.1", current.IpAddress(c.Request().Context()))
+			assert.Equal(t, "Go test", current.UserAgent(c.Request().Context()))
+			return nil
+		})(ctx)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns unauthorized on authenticated routes without a session", func(t *testing.T) {
+		middleware := &Middleware{}
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+		err := middleware.Authorize(AccessAuthenticated)(func(c *echo.Context) error {
+			t.Fatal("handler must not be called")
+			return nil
+		})(ctx)
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+	})
+
+	t.Run("sets the impersonator and records requests made through impersonated sessions", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		impersonator := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userSession := &entity.UserSession{
+			Base:           entity.Base{Id: uuid.New()},
+			UserId:         user.Id,
+			ImpersonatorId: impersonator.Id,
+			LastSeenAt:     time.Now(),
+			ExpiresAt:      time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		impersonationLogRepository := repositoryImpersonationLog.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                     cfg,
+			userRepository:             userRepository,
+			permissionRepository:       permissionRepository,
+			userSessionRepository:      userSessionRepository,
+			impersonationLogRepository: impersonationLogRepository,
+		}
+		req := httptest.NewRequest(http.MethodGet, "/api/v1/app/auth/me", nil)
+		req.Header.Set("Authorization", "Bearer session-token")
+		req.Header.Set("User-Agent", "Go test")
+		req.Header.Set("X-Real-IP", "192.0.2.1")
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, impersonator.Id).Return(impersonator, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, user.Id).Return([]string{}, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, impersonator.Id).Return([]string{consts.PermissionUsersImpersonate}, nil).Once()
+		impersonationLogRepository.EXPECT().Create(mock.Anything, &entity.ImpersonationLog{
+			UserSessionId:  userSession.Id,
+			ImpersonatorId: impersonator.Id,
+			UserId:         user.Id,
+			Method:         http.MethodGet,
+			Path:           "/api/v1/app/auth/me",
+			IpAddress:      "192.0.2.1",
+			UserAgent:      "Go test",
+		}).Return(nil).Once()
+
+		err := middleware.Authorize(AccessAuthenticated)(func(c *echo.Context) error {
+			assert.Same(t, user, current.User(c.Request().Context()))
+			assert.Same(t, impersonator, current.Impersonator(c.Request().Context()))
+			assert.Same(t, impersonator, current.RealUser(c.Request().Context()))
+			return nil
+		})(ctx)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestMiddleware_UnaryAuthorize(t *testing.T) {
+	methods := map[string]Access{
+		pbApiV1AppAuth.Service_SignIn_FullMethodName: AccessPublic,
+	}
+
+	t.Run("skips authentication for public methods", func(t *testing.T) {
+		middleware := &Middleware{}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_SignIn_FullMethodName}
+
+		res, err := middleware.UnaryAuthorize(methods)(context.Background(), "request", info, func(ctx context.Context, req any) (any, error) {
+			assert.Nil(t, current.User(ctx))
+			return "response", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "response", res)
+	})
+
+	t.Run("requires authentication for undeclared methods", func(t *testing.T) {
+		middleware := &Middleware{}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+
+		res, err := middleware.UnaryAuthorize(methods)(context.Background(), "request", info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+
+	t.Run("sets the current user and session resolved from the bearer token", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer session-token"))
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			LastSeenAt: time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                cfg,
+			userRepository:        userRepository,
+			permissionRepository:  permissionRepository,
+			userSessionRepository: userSessionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, user.Id).Return([]string{}, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			assert.Same(t, user, current.User(ctx))
+			assert.Same(t, userSession, current.UserSession(ctx))
+			return "response", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "response", res)
+	})
+
+	t.Run("slides last_seen_at forward for sessions that have been idle", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "session-token"))
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			LastSeenAt: time.Now().Add(-10 * time.Minute),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                cfg,
+			userRepository:        userRepository,
+			permissionRepository:  permissionRepository,
+			userSessionRepository: userSessionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+		userSessionRepository.EXPECT().UpdateLastSeenAtById(mock.Anything, userSession.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, user.Id).Return([]string{}, nil).Once()
+
+		_, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			return "response", nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects impersonated sessions once the impersonator lost the permission to impersonate", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer session-token"))
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		impersonator := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userSession := &entity.UserSession{
+			Base:           entity.Base{Id: uuid.New()},
+			UserId:         user.Id,
+			ImpersonatorId: impersonator.Id,
+			LastSeenAt:     time.Now(),
+			ExpiresAt:      time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                cfg,
+			userRepository:        userRepository,
+			permissionRepository:  permissionRepository,
+			userSessionRepository: userSessionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, impersonator.Id).Return(impersonator, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, user.Id).Return([]string{}, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, impersonator.Id).Return([]string{consts.PermissionUsersRead}, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rejects unverified users on methods that require a verified email address", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer session-token"))
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			LastSeenAt: time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                cfg,
+			userRepository:        userRepository,
+			userSessionRepository: userSessionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+		methods := map[string]Access{info.FullMethod: AccessVerified}
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+
+		require.ErrorIs(t, err, consts.ErrEmailAddressNotVerified)
+		assert.Nil(t, res)
+	})
+
+	t.Run("sets the current user and api key for methods within the key's scopes", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer bibit_api-key"))
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		apiKey := &entity.ApiKey{
+			Base:   entity.Base{Id: uuid.New()},
+			UserId: user.Id,
+			Scopes: []string{consts.ScopeProfileRead},
+		}
+		userRepository := repositoryUser.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		middleware := &Middleware{
+			userRepository:       userRepository,
+			apiKeyRepository:     apiKeyRepository,
+			permissionRepository: permissionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+		methods := map[string]Access{info.FullMethod: AccessAuthenticated.WithScope(consts.ScopeProfileRead)}
+
+		apiKeyRepository.EXPECT().FindByKey(mock.Anything, "bibit_api-key").Return(apiKey, nil).Once()
+		apiKeyRepository.EXPECT().UpdateLastUsedAtById(mock.Anything, apiKey.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, user.Id).Return([]string{}, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			assert.Same(t, user, current.User(ctx))
+			assert.Same(t, apiKey, current.ApiKey(ctx))
+			assert.Nil(t, current.UserSession(ctx))
+			assert.True(t, current.HasScope(ctx, consts.ScopeProfileRead))
+			return "response", nil
+		})
+
+		require.NoError(t, err)
+		assert.Equal(t, "response", res)
+	})
+
+	t.Run("rejects api keys on methods outside the key's scopes", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer bibit_api-key"))
+		apiKey := &entity.ApiKey{Scopes: []string{consts.ScopeProfileRead}}
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		middleware := &Middleware{apiKeyRepository: apiKeyRepository}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_ListSessions_FullMethodName}
+
+		apiKeyRepository.EXPECT().FindByKey(mock.Anything, "bibit_api-key").Return(apiKey, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+
+		require.ErrorIs(t, err, consts.ErrInsufficientScope)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rejects revoked api keys", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer bibit_api-key"))
+		apiKey := &entity.ApiKey{Scopes: []string{consts.ScopeProfileRead}, RevokedAt: time.Now()}
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		middleware := &Middleware{apiKeyRepository: apiKeyRepository}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+		methods := map[string]Access{info.FullMethod: AccessAuthenticated.WithScope(consts.ScopeProfileRead)}
+
+		apiKeyRepository.EXPECT().FindByKey(mock.Anything, "bibit_api-key").Return(apiKey, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+
+	t.Run("sets the permissions granted to the current user", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer session-token"))
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			LastSeenAt: time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                cfg,
+			userRepository:        userRepository,
+			userSessionRepository: userSessionRepository,
+			permissionRepository:  permissionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+		methods := map[string]Access{info.FullMethod: AccessAuthenticated.WithPermission(consts.PermissionUsersRead)}
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, user.Id).Return([]string{consts.PermissionUsersRead}, nil).Once()
+
+		_, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			assert.True(t, current.Can(ctx, consts.PermissionUsersRead))
+			assert.False(t, current.Can(ctx, consts.PermissionUsersManage))
+			return "response", nil
+		})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("rejects users without the permission the method requires", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer session-token"))
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			LastSeenAt: time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                cfg,
+			userRepository:        userRepository,
+			userSessionRepository: userSessionRepository,
+			permissionRepository:  permissionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+		methods := map[string]Access{info.FullMethod: AccessAuthenticated.WithPermission(consts.PermissionUsersManage)}
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+		userRepository.EXPECT().FindById(mock.Anything, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(mock.Anything, user.Id).Return([]string{consts.PermissionUsersRead}, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+
+		require.ErrorIs(t, err, consts.ErrForbidden)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rejects expired sessions", func(t *testing.T) {
+		ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer session-token"))
+		userSession := &entity.UserSession{
+			LastSeenAt: time.Now().Add(-2 * time.Hour),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		middleware := &Middleware{
+			config:                cfg,
+			userSessionRepository: userSessionRepository,
+		}
+		info := &grpc.UnaryServerInfo{FullMethod: pbApiV1AppAuth.Service_Me_FullMethodName}
+
+		userSessionRepository.EXPECT().FindByToken(mock.Anything, "session-token").Return(userSession, nil).Once()
+
+		res, err := middleware.UnaryAuthorize(methods)(ctx, "request", info, func(ctx context.Context, req any) (any, error) {
+			t.Fatal("handler must not be called")
+			return nil, nil
+		})
+
+		require.ErrorIs(t, err, consts.ErrSessionExpired)
+		assert.Nil(t, res)
+	})
+}
@@ -7,6 +7,7 @@ package auth
 import (
 	"github.com/labstack/echo/v5"
 	mock "github.com/stretchr/testify/mock"
+	"google.golang.org/grpc"
 )
 
 // NewMockIMiddleware creates a new instance of MockIMiddleware. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -36,41 +37,41 @@ func (_m *MockIMiddleware) EXPECT() *MockIMiddleware_Expecter {
 	return &MockIMiddleware_Expecter{mock: &_m.Mock}
 }
 
-// AuthenticateUser provides a mock function for the type MockIMiddleware
-func (_mock *MockIMiddleware) AuthenticateUser(next echo.HandlerFunc) echo.HandlerFunc {
-	ret := _mock.Called(next)
+// Authorize provides a mock function for the type MockIMiddleware
+func (_mock *MockIMiddleware) Authorize(access Access) echo.MiddlewareFunc {
+	ret := _mock.Called(access)
 
 	if len(ret) == 0 {
-		panic("no return value specified for AuthenticateUser")
+		panic("no return value specified for Authorize")
 	}
 
-	var r0 echo.HandlerFunc
-	if returnFunc, ok := ret.Get(0).(func(echo.HandlerFunc) echo.HandlerFunc); ok {
-		r0 = returnFunc(next)
+	var r0 echo.MiddlewareFunc
+	if returnFunc, ok := ret.Get(0).(func(Access) echo.MiddlewareFunc); ok {
+		r0 = returnFunc(access)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(echo.HandlerFunc)
+			r0 = ret.Get(0).(echo.MiddlewareFunc)
 		}
 	}
 	return r0
 }
 
-// MockIMiddleware_AuthenticateUser_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AuthenticateUser'
-type MockIMiddleware_AuthenticateUser_Call struct {
+// MockIMiddleware_Authorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Authorize'
+type MockIMiddleware_Authorize_Call struct {
 	*mock.Call
 }
 
-// AuthenticateUser is a helper method to define mock.On call
-//   - next echo.HandlerFunc
-func (_e *MockIMiddleware_Expecter) AuthenticateUser(next interface{}) *MockIMiddleware_AuthenticateUser_Call {
-	return &MockIMiddleware_AuthenticateUser_Call{Call: _e.mock.On("AuthenticateUser", next)}
+// Authorize is a helper method to define mock.On call
+//   - access Access
+func (_e *MockIMiddleware_Expecter) Authorize(access interface{}) *MockIMiddleware_Authorize_Call {
+	return &MockIMiddleware_Authorize_Call{Call: _e.mock.On("Authorize", access)}
 }
 
-func (_c *MockIMiddleware_AuthenticateUser_Call) Run(run func(next echo.HandlerFunc)) *MockIMiddleware_AuthenticateUser_Call {
+func (_c *MockIMiddleware_Authorize_Call) Run(run func(access Access)) *MockIMiddleware_Authorize_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 echo.HandlerFunc
+		var arg0 Access
 		if args[0] != nil {
-			arg0 = args[0].(echo.HandlerFunc)
+			arg0 = args[0].(Access)
 		}
 		run(
 			arg0,
@@ -79,12 +80,118 @@ func (_c *MockIMiddleware_AuthenticateUser_Call) Run(run func(next echo.HandlerF
 	return _c
 }
 
-func (_c *MockIMiddleware_AuthenticateUser_Call) Return(handlerFunc echo.HandlerFunc) *MockIMiddleware_AuthenticateUser_Call {
-	_c.Call.Return(handlerFunc)
+func (_c *MockIMiddleware_Authorize_Call) Return(middlewareFunc echo.MiddlewareFunc) *MockIMiddleware_Authorize_Call {
+	_c.Call.Return(middlewareFunc)
 	return _c
 }
 
-func (_c *MockIMiddleware_AuthenticateUser_Call) RunAndReturn(run func(next echo.HandlerFunc) echo.HandlerFunc) *MockIMiddleware_AuthenticateUser_Call {
+func (_c *MockIMiddleware_Authorize_Call) RunAndReturn(run func(access Access) echo.MiddlewareFunc) *MockIMiddleware_Authorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StreamAuthorize provides a mock function for the type MockIMiddleware
+func (_mock *MockIMiddleware) StreamAuthorize(methods map[string]Access) grpc.StreamServerInterceptor {
+	ret := _mock.Called(methods)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StreamAuthorize")
+	}
+
+	var r0 grpc.StreamServerInterceptor
+	if returnFunc, ok := ret.Get(0).(func(map[string]Access) grpc.StreamServerInterceptor); ok {
+		r0 = returnFunc(methods)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.StreamServerInterceptor)
+		}
+	}
+	return r0
+}
+
+// MockIMiddleware_StreamAuthorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StreamAuthorize'
+type MockIMiddleware_StreamAuthorize_Call struct {
+	*mock.Call
+}
+
+// StreamAuthorize is a helper method to define mock.On call
+//   - methods map[string]Access
+func (_e *MockIMiddleware_Expecter) StreamAuthorize(methods interface{}) *MockIMiddleware_StreamAuthorize_Call {
+	return &MockIMiddleware_StreamAuthorize_Call{Call: _e.mock.On("StreamAuthorize", methods)}
+}
+
+func (_c *MockIMiddleware_StreamAuthorize_Call) Run(run func(methods map[string]Access)) *MockIMiddleware_StreamAuthorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 map[string]Access
+		if args[0] != nil {
+			arg0 = args[0].(map[string]Access)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMiddleware_StreamAuthorize_Call) Return(streamServerInterceptor grpc.StreamServerInterceptor) *MockIMiddleware_StreamAuthorize_Call {
+	_c.Call.Return(streamServerInterceptor)
+	return _c
+}
+
+func (_c *MockIMiddleware_StreamAuthorize_Call) RunAndReturn(run func(methods map[string]Access) grpc.StreamServerInterceptor) *MockIMiddleware_StreamAuthorize_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnaryAuthorize provides a mock function for the type MockIMiddleware
+func (_mock *MockIMiddleware) UnaryAuthorize(methods map[string]Access) grpc.UnaryServerInterceptor {
+	ret := _mock.Called(methods)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnaryAuthorize")
+	}
+
+	var r0 grpc.UnaryServerInterceptor
+	if returnFunc, ok := ret.Get(0).(func(map[string]Access) grpc.UnaryServerInterceptor); ok {
+		r0 = returnFunc(methods)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(grpc.UnaryServerInterceptor)
+		}
+	}
+	return r0
+}
+
+// MockIMiddleware_UnaryAuthorize_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnaryAuthorize'
+type MockIMiddleware_UnaryAuthorize_Call struct {
+	*mock.Call
+}
+
+// UnaryAuthorize is a helper method to define mock.On call
+//   - methods map[string]Access
+func (_e *MockIMiddleware_Expecter) UnaryAuthorize(methods interface{}) *MockIMiddleware_UnaryAuthorize_Call {
+	return &MockIMiddleware_UnaryAuthorize_Call{Call: _e.mock.On("UnaryAuthorize", methods)}
+}
+
+func (_c *MockIMiddleware_UnaryAuthorize_Call) Run(run func(methods map[string]Access)) *MockIMiddleware_UnaryAuthorize_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 map[string]Access
+		if args[0] != nil {
+			arg0 = args[0].(map[string]Access)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIMiddleware_UnaryAuthorize_Call) Return(unaryServerInterceptor grpc.UnaryServerInterceptor) *MockIMiddleware_UnaryAuthorize_Call {
+	_c.Call.Return(unaryServerInterceptor)
+	return _c
+}
+
+func (_c *MockIMiddleware_UnaryAuthorize_Call) RunAndReturn(run func(methods map[string]Access) grpc.UnaryServerInterceptor) *MockIMiddleware_UnaryAuthorize_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -0,0 +1,128 @@
+package csrf
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMiddleware(t *testing.T) {
+	t.Run("trusts the app url and the configured origins", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.App.Url = "https://App.example.com/dashboard"
+		cfg.Http.Csrf.TrustedOrigins = []string{"https://admin.example.com"}
+
+		middleware, err := NewMiddleware(newTestInjector(cfg))
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"https://app.example.com", "https://admin.example.com"}, middleware.trustedOrigins)
+	})
+
+	t.Run("rejects trusted origins that are not absolute urls", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.App.Url = "https://app.example.com"
+		cfg.Http.Csrf.TrustedOrigins = []string{"admin.example.com"}
+
+		_, err := NewMiddleware(newTestInjector(cfg))
+
+		require.Error(t, err)
+	})
+}
+
+func TestMiddleware_VerifyOrigin(t *testing.T) {
+	t.Run("allows safe methods from any origin", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodGet, map[string]string{"Origin": "https://evil.example"}, true)
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("allows requests from a trusted origin", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodPost, map[string]string{"Origin": "https://app.example.com"}, true)
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("falls back to the referer when origin is missing", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodPost, map[string]string{"Referer": "https://app.example.com/settings"}, true)
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("skips bearer token requests", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodPost, map[string]string{"Origin": "https://evil.example", "Authorization": "Bearer session-token"}, true)
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("allows requests without origin, referer or session cookie", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodPost, map[string]string{}, false)
+
+		require.NoError(t, err)
+		assert.True(t, called)
+	})
+
+	t.Run("rejects requests from an untrusted origin", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodPost, map[string]string{"Origin": "https://evil.example"}, false)
+
+		require.ErrorIs(t, err, consts.ErrInvalidRequestOrigin)
+		assert.False(t, called)
+	})
+
+	t.Run("rejects opaque origins", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodDelete, map[string]string{"Origin": "null"}, true)
+
+		require.ErrorIs(t, err, consts.ErrInvalidRequestOrigin)
+		assert.False(t, called)
+	})
+
+	t.Run("rejects untrusted referers", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodPatch, map[string]string{"Referer": "https://evil.example/app.example.com"}, true)
+
+		require.ErrorIs(t, err, consts.ErrInvalidRequestOrigin)
+		assert.False(t, called)
+	})
+
+	t.Run("rejects session cookie requests without origin or referer", func(t *testing.T) {
+		called, err := verifyOrigin(http.MethodPost, map[string]string{}, true)
+
+		require.ErrorIs(t, err, consts.ErrInvalidRequestOrigin)
+		assert.False(t, called)
+	})
+}
+
+func verifyOrigin(method string, headers map[string]string, withSessionCookie bool) (bool, error) {
+	middleware := &Middleware{trustedOrigins: []string{"https://app.example.com"}}
+	req := httptest.NewRequest(method, "/api/v1/app/auth/signout", nil)
+	for key, value := range headers {
+		req.Header.Set(key, value)
+	}
+	if withSessionCookie {
+		req.AddCookie(&http.Cookie{Name: consts.CookieUserSession, Value: "session-token"})
+	}
+	ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+	called := false
+	err := middleware.VerifyOrigin(func(c *echo.Context) error {
+		called = true
+		return nil
+	})(ctx)
+
+	return called, err
+}
+
+func newTestInjector(cfg *config.Config) do.Injector {
+	i := do.New()
+	do.ProvideValue(i, cfg)
+	return i
+}
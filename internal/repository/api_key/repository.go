@@ -0,0 +1,93 @@
+package api_key
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindByKey(ctx context.Context, key string) (*entity.ApiKey, error)
+	FindAllByUserId(ctx context.Context, userId uuid.UUID) ([]*entity.ApiKey, error)
+	Create(ctx context.Context, apiKey *entity.ApiKey) error
+	UpdateLastUsedAtById(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error
+	RevokeByIdAndUserId(ctx context.Context, id, userId uuid.UUID, revokedAt time.Time) (bool, error)
+	RevokeAllByUserId(ctx context.Context, userId uuid.UUID, revokedAt time.Time) error
+	DeleteByUserId(ctx context.Context, userId uuid.UUID) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindByKey(ctx context.Context, key string) (*entity.ApiKey, error) {
+	apiKey := &entity.ApiKey{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(apiKey).Where("key_digest = ?", util.DigestToken(key)).Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKey, nil
+}
+
+func (r *Repository) FindAllByUserId(ctx context.Context, userId uuid.UUID) ([]*entity.ApiKey, error) {
+	apiKeys := make([]*entity.ApiKey, 0)
+	err := r.sqlDB.DB(ctx).NewSelect().Model(&apiKeys).Where("user_id = ?", userId).Order("created_at DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+func (r *Repository) Create(ctx context.Context, apiKey *entity.ApiKey) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(apiKey).Exec(ctx)
+	return err
+}
+
+func (r *Repository) UpdateLastUsedAtById(ctx context.Context, id uuid.UUID, lastUsedAt time.Time) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.ApiKey{}).Set("last_used_at = ?", lastUsedAt).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+func (r *Repository) RevokeByIdAndUserId(ctx context.Context, id, userId uuid.UUID, revokedAt time.Time) (bool, error) {
+	result, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.ApiKey{}).Set("revoked_at = ?", revokedAt).Where("id = ?", id).Where("user_id = ?", userId).Where("revoked_at IS NULL").Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) RevokeAllByUserId(ctx context.Context, userId uuid.UUID, revokedAt time.Time) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.ApiKey{}).Set("revoked_at = ?", revokedAt).Where("user_id = ?", userId).Where("revoked_at IS NULL").Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteByUserId(ctx context.Context, userId uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.ApiKey{}).Where("user_id = ?", userId).Exec(ctx)
+	return err
+}
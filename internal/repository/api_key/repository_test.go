@@ -0,0 +1,318 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindByKey(t *testing.T) {
+	t.Run("returns the api key selected by key", func(t *testing.T) {
+		ctx := context.Background()
+		key := "bibit_api-key"
+		apiKeyID := uuid.New()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "api_keys" AS "api_key" WHERE \(key_digest = '%s'\) LIMIT 1`, util.DigestToken(key))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name", "prefix", "key_digest", "scopes"}).
+				AddRow(apiKeyID.String(), userID.String(), "CI", "bibit_api-key", util.DigestToken(key), "{profile:read}"))
+
+		actualApiKey, err := repository.FindByKey(ctx, key)
+
+		require.NoError(t, err)
+		require.NotNil(t, actualApiKey)
+		assert.Equal(t, apiKeyID, actualApiKey.Id)
+		assert.Equal(t, userID, actualApiKey.UserId)
+		assert.Equal(t, "CI", actualApiKey.Name)
+		assert.Empty(t, actualApiKey.Key)
+		assert.Equal(t, util.DigestToken(key), actualApiKey.KeyDigest)
+		assert.Equal(t, []string{"profile:read"}, actualApiKey.Scopes)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		key := "bibit_api-key"
+		expectedErr := errors.New("select api key")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "api_keys" AS "api_key" WHERE \(key_digest = '%s'\) LIMIT 1`, util.DigestToken(key))).
+			WillReturnError(expectedErr)
+
+		actualApiKey, err := repository.FindByKey(ctx, key)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualApiKey)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_FindAllByUserId(t *testing.T) {
+	t.Run("returns the api keys of the user ordered by creation", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		firstApiKeyID := uuid.New()
+		secondApiKeyID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "api_keys" AS "api_key" WHERE \(user_id = '%s'\) ORDER BY "created_at" DESC`, regexp.QuoteMeta(userID.String()))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "name"}).
+				AddRow(firstApiKeyID.String(), userID.String(), "CI").
+				AddRow(secondApiKeyID.String(), userID.String(), "Backup script"))
+
+		actualApiKeys, err := repository.FindAllByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.Len(t, actualApiKeys, 2)
+		assert.Equal(t, firstApiKeyID, actualApiKeys[0].Id)
+		assert.Equal(t, secondApiKeyID, actualApiKeys[1].Id)
+		assert.Equal(t, "Backup script", actualApiKeys[1].Name)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select api keys")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "api_keys"`).
+			WillReturnError(expectedErr)
+
+		actualApiKeys, err := repository.FindAllByUserId(ctx, uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualApiKeys)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the api key", func(t *testing.T) {
+		ctx := context.Background()
+		newApiKey := &entity.ApiKey{
+			UserId: uuid.New(),
+			Name:   "CI",
+			Scopes: []string{"profile:read"},
+		}
+		newApiKey.GenerateKey()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "api_keys" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', '%s', '\{"profile:read"\}', DEFAULT, DEFAULT, DEFAULT\) RETURNING`,
+			regexp.QuoteMeta(newApiKey.UserId.String()),
+			regexp.QuoteMeta(newApiKey.Name),
+			regexp.QuoteMeta(newApiKey.Prefix),
+			regexp.QuoteMeta(newApiKey.KeyDigest),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newApiKey)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		newApiKey := &entity.ApiKey{UserId: uuid.New(), Name: "CI"}
+		newApiKey.GenerateKey()
+		expectedErr := errors.New("insert api key")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "api_keys"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, newApiKey)
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateLastUsedAtById(t *testing.T) {
+	t.Run("updates last_used_at of the api key selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		apiKeyID := uuid.New()
+		lastUsedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "api_keys" AS "api_key" SET last_used_at = '2026-10-18 09:00:00\+00:00' WHERE \(id = '%s'\)`, regexp.QuoteMeta(apiKeyID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateLastUsedAtById(ctx, apiKeyID, lastUsedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("update api key")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "api_keys"`).
+			WillReturnError(expectedErr)
+
+		err := repository.UpdateLastUsedAtById(ctx, uuid.New(), time.Now())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_RevokeByIdAndUserId(t *testing.T) {
+	t.Run("returns true when an active api key of the user is revoked", func(t *testing.T) {
+		ctx := context.Background()
+		apiKeyID := uuid.New()
+		userID := uuid.New()
+		revokedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(
+			`UPDATE "api_keys" AS "api_key" SET revoked_at = '2026-10-18 09:00:00\+00:00' WHERE \(id = '%s'\) AND \(user_id = '%s'\) AND \(revoked_at IS NULL\)`,
+			regexp.QuoteMeta(apiKeyID.String()),
+			regexp.QuoteMeta(userID.String()),
+		)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		revoked, err := repository.RevokeByIdAndUserId(ctx, apiKeyID, userID, revokedAt)
+
+		require.NoError(t, err)
+		assert.True(t, revoked)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns false when the api key does not belong to the user or is already revoked", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "api_keys"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		revoked, err := repository.RevokeByIdAndUserId(ctx, uuid.New(), uuid.New(), time.Now())
+
+		require.NoError(t, err)
+		assert.False(t, revoked)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("revoke api key")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "api_keys"`).
+			WillReturnError(expectedErr)
+
+		revoked, err := repository.RevokeByIdAndUserId(ctx, uuid.New(), uuid.New(), time.Now())
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.False(t, revoked)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_RevokeAllByUserId(t *testing.T) {
+	t.Run("revokes the active api keys of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		revokedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(
+			`UPDATE "api_keys" AS "api_key" SET revoked_at = '2026-10-18 09:00:00\+00:00' WHERE \(user_id = '%s'\) AND \(revoked_at IS NULL\)`,
+			regexp.QuoteMeta(userID.String()),
+		)).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		err := repository.RevokeAllByUserId(ctx, userID, revokedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByUserId(t *testing.T) {
+	t.Run("deletes every api key of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "api_keys" AS "api_key" WHERE \(user_id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.DeleteByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
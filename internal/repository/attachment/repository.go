@@ -0,0 +1,100 @@
+package attachment
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindById(ctx context.Context, id uuid.UUID) (*entity.Attachment, error)
+	FindByIdAndUserId(ctx context.Context, id, userId uuid.UUID) (*entity.Attachment, error)
+	FindAllExpiredPending(ctx context.Context, now time.Time, limit int) ([]*entity.Attachment, error)
+	Create(ctx context.Context, attachment *entity.Attachment) error
+	CommitById(ctx context.Context, id uuid.UUID, contentType string) (bool, error)
+	DeletePendingById(ctx context.Context, id uuid.UUID) (bool, error)
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindById(ctx context.Context, id uuid.UUID) (*entity.Attachment, error) {
+	attachment := &entity.Attachment{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(attachment).Where("id = ?", id).Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (r *Repository) FindByIdAndUserId(ctx context.Context, id, userId uuid.UUID) (*entity.Attachment, error) {
+	attachment := &entity.Attachment{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(attachment).Where("id = ?", id).Where("user_id = ?", userId).Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachment, nil
+}
+
+func (r *Repository) FindAllExpiredPending(ctx context.Context, now time.Time, limit int) ([]*entity.Attachment, error) {
+	attachments := make([]*entity.Attachment, 0)
+	err := r.sqlDB.DB(ctx).NewSelect().Model(&attachments).Where("status = ?", entity.AttachmentStatusPending).Where("expires_at <= ?", now).Order("expires_at ASC").Limit(limit).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachments, nil
+}
+
+func (r *Repository) Create(ctx context.Context, attachment *entity.Attachment) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(attachment).Exec(ctx)
+	return err
+}
+
+func (r *Repository) CommitById(ctx context.Context, id uuid.UUID, contentType string) (bool, error) {
+	result, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.Attachment{}).Set("status = ?", entity.AttachmentStatusCommitted).Set("content_type = ?", contentType).Set("expires_at = NULL").Where("id = ?", id).Where("status = ?", entity.AttachmentStatusPending).Where("expires_at > now()").Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) DeletePendingById(ctx context.Context, id uuid.UUID) (bool, error) {
+	result, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.Attachment{}).Where("id = ?", id).Where("status = ?", entity.AttachmentStatusPending).Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
@@ -0,0 +1,256 @@
+package attachment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindById(t *testing.T) {
+	t.Run("returns the attachment selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		attachmentID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "attachments" AS "attachment" WHERE \(id = '%s'\) LIMIT 1`, attachmentID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "object_name", "file_name", "byte_size"}).
+				AddRow(attachmentID.String(), "01JZ.png", "avatar.png", 128))
+
+		actualAttachment, err := repository.FindById(ctx, attachmentID)
+
+		require.NoError(t, err)
+		require.NotNil(t, actualAttachment)
+		assert.Equal(t, attachmentID, actualAttachment.Id)
+		assert.Equal(t, "01JZ.png", actualAttachment.ObjectName)
+		assert.Equal(t, "avatar.png", actualAttachment.FileName)
+		assert.Equal(t, int64(128), actualAttachment.ByteSize)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		attachmentID := uuid.New()
+		expectedErr := errors.New("select attachment")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "attachments"`).WillReturnError(expectedErr)
+
+		actualAttachment, err := repository.FindById(ctx, attachmentID)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualAttachment)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_FindByIdAndUserId(t *testing.T) {
+	t.Run("returns the attachment selected by id and owner", func(t *testing.T) {
+		ctx := context.Background()
+		attachmentID := uuid.New()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "attachments" AS "attachment" WHERE \(id = '%s'\) AND \(user_id = '%s'\) LIMIT 1`, attachmentID, userID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "object_name"}).
+				AddRow(attachmentID.String(), userID.String(), "01JZ.png"))
+
+		actualAttachment, err := repository.FindByIdAndUserId(ctx, attachmentID, userID)
+
+		require.NoError(t, err)
+		require.NotNil(t, actualAttachment)
+		assert.Equal(t, attachmentID, actualAttachment.Id)
+		assert.Equal(t, userID, actualAttachment.UserId)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select attachment")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "attachments"`).WillReturnError(expectedErr)
+
+		actualAttachment, err := repository.FindByIdAndUserId(ctx, uuid.New(), uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualAttachment)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the attachment", func(t *testing.T) {
+		ctx := context.Background()
+		newAttachment := &entity.Attachment{ObjectName: "01JZ.png", FileName: "avatar.png", ByteSize: 128, Status: entity.AttachmentStatusCommitted}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "attachments" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, DEFAULT, '%s', '%s', 128, DEFAULT, DEFAULT, DEFAULT, 'committed', DEFAULT\) RETURNING`,
+			regexp.QuoteMeta(newAttachment.ObjectName),
+			regexp.QuoteMeta(newAttachment.FileName),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newAttachment)
+
+		require.NoError(t, err)
+		assert.NotEqual(t, uuid.Nil, newAttachment.Id)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		newAttachment := &entity.Attachment{ObjectName: "01JZ.png", FileName: "avatar.png", ByteSize: 128}
+		expectedErr := errors.New("insert attachment")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "attachments"`).WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, newAttachment)
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_FindAllExpiredPending(t *testing.T) {
+	t.Run("returns pending attachments that have expired", func(t *testing.T) {
+		ctx := context.Background()
+		now := time.Date(2026, time.October, 18, 12, 0, 0, 0, time.UTC)
+		attachmentID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "attachments" AS "attachment" WHERE \(status = 'pending'\) AND \(expires_at <= '2026-10-18 12:00:00\+00:00'\) ORDER BY "expires_at" ASC LIMIT 100`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "object_name", "status"}).
+				AddRow(attachmentID.String(), "01JZ.png", entity.AttachmentStatusPending))
+
+		attachments, err := repository.FindAllExpiredPending(ctx, now, 100)
+
+		require.NoError(t, err)
+		require.Len(t, attachments, 1)
+		assert.Equal(t, attachmentID, attachments[0].Id)
+		assert.Equal(t, "01JZ.png", attachments[0].ObjectName)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CommitById(t *testing.T) {
+	t.Run("commits a pending attachment", func(t *testing.T) {
+		ctx := context.Background()
+		attachmentID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "attachments" AS "attachment" SET status = 'committed', content_type = 'application/pdf', expires_at = NULL WHERE \(id = '%s'\) AND \(status = 'pending'\) AND \(expires_at > now\(\)\)`, attachmentID)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		isCommitted, err := repository.CommitById(ctx, attachmentID, "application/pdf")
+
+		require.NoError(t, err)
+		assert.True(t, isCommitted)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns false when no unexpired pending attachment matches", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "attachments"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		isCommitted, err := repository.CommitById(ctx, uuid.New(), "application/pdf")
+
+		require.NoError(t, err)
+		assert.False(t, isCommitted)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeletePendingById(t *testing.T) {
+	t.Run("deletes the attachment while it is pending", func(t *testing.T) {
+		ctx := context.Background()
+		attachmentID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "attachments" AS "attachment" WHERE \(id = '%s'\) AND \(status = 'pending'\)`, attachmentID)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		isDeleted, err := repository.DeletePendingById(ctx, attachmentID)
+
+		require.NoError(t, err)
+		assert.True(t, isDeleted)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns false when the attachment was committed", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`DELETE FROM "attachments"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		isDeleted, err := repository.DeletePendingById(ctx, uuid.New())
+
+		require.NoError(t, err)
+		assert.False(t, isDeleted)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
@@ -0,0 +1,128 @@
+package attachment_link
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindAllByRecordAndName(ctx context.Context, recordType string, recordId uuid.UUID, name string) ([]*entity.AttachmentLink, error)
+	Create(ctx context.Context, attachmentLink *entity.AttachmentLink) error
+	Attach(ctx context.Context, userId uuid.UUID, attachmentLink *entity.AttachmentLink) (bool, error)
+	Replace(ctx context.Context, userId uuid.UUID, attachmentLink *entity.AttachmentLink) (bool, error)
+	DeleteByRecordAndName(ctx context.Context, recordType string, recordId uuid.UUID, name string) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindAllByRecordAndName(ctx context.Context, recordType string, recordId uuid.UUID, name string) ([]*entity.AttachmentLink, error) {
+	attachmentLinks := make([]*entity.AttachmentLink, 0)
+	err := r.sqlDB.DB(ctx).NewSelect().Model(&attachmentLinks).Relation("Attachment").
+		Where("attachment_link.record_type = ?", recordType).
+		Where("attachment_link.record_id = ?", recordId).
+		Where("attachment_link.name = ?", name).
+		Order("attachment_link.position ASC").
+		Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return attachmentLinks, nil
+}
+
+func (r *Repository) Create(ctx context.Context, attachmentLink *entity.AttachmentLink) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(attachmentLink).Exec(ctx)
+	return err
+}
+
+// Attach links the attachment after the ones already linked under the same
+// name, for has-many attachments. It links nothing and returns false unless
+// the attachment is committed and was uploaded by userId, so clients cannot
+// link unconfirmed uploads or the uploads of other users.
+func (r *Repository) Attach(ctx context.Context, userId uuid.UUID, attachmentLink *entity.AttachmentLink) (bool, error) {
+	db := r.sqlDB.DB(ctx)
+	isLinkable, err := isLinkable(ctx, db, userId, attachmentLink.AttachmentId)
+	if err != nil || !isLinkable {
+		return false, err
+	}
+
+	nextPosition := db.NewSelect().Model((*entity.AttachmentLink)(nil)).
+		ColumnExpr("COALESCE(MAX(attachment_link.position) + 1, 0)").
+		Where("attachment_link.record_type = ?", attachmentLink.RecordType).
+		Where("attachment_link.record_id = ?", attachmentLink.RecordId).
+		Where("attachment_link.name = ?", attachmentLink.Name)
+	_, err = db.NewInsert().Model(attachmentLink).Value("position", "(?)", nextPosition).Returning("*").Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// Replace links the attachment in place of every attachment linked under the
+// same name, for has-one attachments, with the same checks as Attach. Run it
+// inside repository.Transaction so the previous attachment stays linked when
+// the insert fails.
+func (r *Repository) Replace(ctx context.Context, userId uuid.UUID, attachmentLink *entity.AttachmentLink) (bool, error) {
+	db := r.sqlDB.DB(ctx)
+	isLinkable, err := isLinkable(ctx, db, userId, attachmentLink.AttachmentId)
+	if err != nil || !isLinkable {
+		return false, err
+	}
+
+	_, err = db.NewDelete().Model(&entity.AttachmentLink{}).
+		Where("record_type = ?", attachmentLink.RecordType).
+		Where("record_id = ?", attachmentLink.RecordId).
+		Where("name = ?", attachmentLink.Name).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	attachmentLink.Position = 0
+	_, err = db.NewInsert().Model(attachmentLink).Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+// DeleteByRecordAndName detaches every attachment linked under name, which is
+// how a has-one attachment is replaced before linking the new one.
+func (r *Repository) DeleteByRecordAndName(ctx context.Context, recordType string, recordId uuid.UUID, name string) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.AttachmentLink{}).
+		Where("record_type = ?", recordType).
+		Where("record_id = ?", recordId).
+		Where("name = ?", name).
+		Exec(ctx)
+	return err
+}
+
+func isLinkable(ctx context.Context, db bun.IDB, userId uuid.UUID, attachmentId uuid.UUID) (bool, error) {
+	return db.NewSelect().Model((*entity.Attachment)(nil)).
+		Where("id = ?", attachmentId).
+		Where("user_id = ?", userId).
+		Where("status = ?", entity.AttachmentStatusCommitted).
+		Exists(ctx)
+}
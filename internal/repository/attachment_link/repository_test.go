@@ -0,0 +1,244 @@
+package attachment_link
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindAllByRecordAndName(t *testing.T) {
+	t.Run("returns the links of the record with their attachments ordered by position", func(t *testing.T) {
+		ctx := context.Background()
+		recordID := uuid.New()
+		attachmentID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`SELECT .* FROM "attachment_links" AS "attachment_link" LEFT JOIN "attachments" AS "attachment" ON \("attachment"."id" = "attachment_link"."attachment_id"\) WHERE \(attachment_link.record_type = 'user'\) AND \(attachment_link.record_id = '%s'\) AND \(attachment_link.name = 'avatar'\) ORDER BY "attachment_link"."position" ASC`,
+			recordID,
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "attachment_id", "record_type", "record_id", "name", "position", "attachment__id", "attachment__object_name"}).
+				AddRow(uuid.New().String(), attachmentID.String(), "user", recordID.String(), "avatar", 0, attachmentID.String(), "01JZ.png"))
+
+		attachmentLinks, err := repository.FindAllByRecordAndName(ctx, entity.AttachmentRecordTypeUser, recordID, entity.UserAttachmentAvatar)
+
+		require.NoError(t, err)
+		require.Len(t, attachmentLinks, 1)
+		assert.Equal(t, attachmentID, attachmentLinks[0].AttachmentId)
+		require.NotNil(t, attachmentLinks[0].Attachment)
+		assert.Equal(t, "01JZ.png", attachmentLinks[0].Attachment.ObjectName)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select attachment links")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "attachment_links"`).WillReturnError(expectedErr)
+
+		attachmentLinks, err := repository.FindAllByRecordAndName(ctx, entity.AttachmentRecordTypeUser, uuid.New(), entity.UserAttachmentAvatar)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, attachmentLinks)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the attachment link", func(t *testing.T) {
+		ctx := context.Background()
+		newAttachmentLink := &entity.AttachmentLink{
+			AttachmentId: uuid.New(),
+			RecordType:   entity.AttachmentRecordTypeUser,
+			RecordId:     uuid.New(),
+			Name:         entity.UserAttachmentAvatar,
+			Position:     2,
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "attachment_links" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', 'user', '%s', 'avatar', 2\) RETURNING`,
+			regexp.QuoteMeta(newAttachmentLink.AttachmentId.String()),
+			regexp.QuoteMeta(newAttachmentLink.RecordId.String()),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newAttachmentLink)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Attach(t *testing.T) {
+	t.Run("links a committed attachment of the user after the linked ones", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		newAttachmentLink := &entity.AttachmentLink{
+			AttachmentId: uuid.New(),
+			RecordType:   entity.AttachmentRecordTypeUser,
+			RecordId:     uuid.New(),
+			Name:         "photos",
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`SELECT EXISTS \(SELECT .* FROM "attachments" AS "attachment" WHERE \(id = '%s'\) AND \(user_id = '%s'\) AND \(status = 'committed'\)\)`,
+			newAttachmentLink.AttachmentId,
+			userID,
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "attachment_links" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', 'user', '%s', 'photos', \(SELECT COALESCE\(MAX\(attachment_link.position\) \+ 1, 0\) FROM "attachment_links" AS "attachment_link" WHERE \(attachment_link.record_type = 'user'\) AND \(attachment_link.record_id = '%s'\) AND \(attachment_link.name = 'photos'\)\)\) RETURNING`,
+			newAttachmentLink.AttachmentId,
+			newAttachmentLink.RecordId,
+			newAttachmentLink.RecordId,
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "position"}).AddRow(uuid.New().String(), 3))
+
+		isAttached, err := repository.Attach(ctx, userID, newAttachmentLink)
+
+		require.NoError(t, err)
+		assert.True(t, isAttached)
+		assert.Equal(t, 3, newAttachmentLink.Position)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("does not link attachments of other users or pending attachments", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT EXISTS`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		isAttached, err := repository.Attach(ctx, uuid.New(), &entity.AttachmentLink{AttachmentId: uuid.New()})
+
+		require.NoError(t, err)
+		assert.False(t, isAttached)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Replace(t *testing.T) {
+	t.Run("links a committed attachment of the user in place of the linked ones", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		newAttachmentLink := &entity.AttachmentLink{
+			AttachmentId: uuid.New(),
+			RecordType:   entity.AttachmentRecordTypeUser,
+			RecordId:     uuid.New(),
+			Name:         entity.UserAttachmentAvatar,
+			Position:     2,
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`SELECT EXISTS \(SELECT .* FROM "attachments" AS "attachment" WHERE \(id = '%s'\) AND \(user_id = '%s'\) AND \(status = 'committed'\)\)`,
+			newAttachmentLink.AttachmentId,
+			userID,
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
+		sqlMock.ExpectExec(fmt.Sprintf(
+			`DELETE FROM "attachment_links" AS "attachment_link" WHERE \(record_type = 'user'\) AND \(record_id = '%s'\) AND \(name = 'avatar'\)`,
+			newAttachmentLink.RecordId,
+		)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "attachment_links" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', 'user', '%s', 'avatar', 0\) RETURNING`,
+			newAttachmentLink.AttachmentId,
+			newAttachmentLink.RecordId,
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		isReplaced, err := repository.Replace(ctx, userID, newAttachmentLink)
+
+		require.NoError(t, err)
+		assert.True(t, isReplaced)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("keeps the linked attachments when the attachment cannot be linked", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT EXISTS`).WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(false))
+
+		isReplaced, err := repository.Replace(ctx, uuid.New(), &entity.AttachmentLink{AttachmentId: uuid.New()})
+
+		require.NoError(t, err)
+		assert.False(t, isReplaced)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByRecordAndName(t *testing.T) {
+	t.Run("deletes the links of the record with the name", func(t *testing.T) {
+		ctx := context.Background()
+		recordID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(
+			`DELETE FROM "attachment_links" AS "attachment_link" WHERE \(record_type = 'user'\) AND \(record_id = '%s'\) AND \(name = 'avatar'\)`,
+			recordID,
+		)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.DeleteByRecordAndName(ctx, entity.AttachmentRecordTypeUser, recordID, entity.UserAttachmentAvatar)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
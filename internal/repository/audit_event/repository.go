@@ -0,0 +1,81 @@
+package audit_event
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/uptrace/bun"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+// Filter narrows down audit events. Zero fields are ignored.
+type Filter struct {
+	Action     string
+	ActorId    uuid.UUID
+	TargetType string
+	TargetId   uuid.UUID
+}
+
+type IRepository interface {
+	FindAllByFilter(ctx context.Context, filter Filter, limit, offset int) ([]*entity.AuditEvent, error)
+	CountByFilter(ctx context.Context, filter Filter) (int, error)
+	Create(ctx context.Context, auditEvent *entity.AuditEvent) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindAllByFilter(ctx context.Context, filter Filter, limit, offset int) ([]*entity.AuditEvent, error) {
+	auditEvents := make([]*entity.AuditEvent, 0)
+	err := r.sqlDB.DB(ctx).NewSelect().Model(&auditEvents).Apply(filter.apply).Order("created_at DESC", "id DESC").Limit(limit).Offset(offset).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return auditEvents, nil
+}
+
+func (r *Repository) CountByFilter(ctx context.Context, filter Filter) (int, error) {
+	return r.sqlDB.DB(ctx).NewSelect().Model(&entity.AuditEvent{}).Apply(filter.apply).Count(ctx)
+}
+
+func (r *Repository) Create(ctx context.Context, auditEvent *entity.AuditEvent) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(auditEvent).Exec(ctx)
+	return err
+}
+
+func (f Filter) apply(query *bun.SelectQuery) *bun.SelectQuery {
+	if f.Action != "" {
+		query = query.Where("action = ?", f.Action)
+	}
+
+	if f.ActorId != uuid.Nil {
+		query = query.Where("actor_id = ?", f.ActorId)
+	}
+
+	if f.TargetType != "" {
+		query = query.Where("target_type = ?", f.TargetType)
+	}
+
+	if f.TargetId != uuid.Nil {
+		query = query.Where("target_id = ?", f.TargetId)
+	}
+
+	return query
+}
@@ -0,0 +1,155 @@
+package audit_event
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindAllByFilter(t *testing.T) {
+	t.Run("returns the filtered page of audit events newest first", func(t *testing.T) {
+		ctx := context.Background()
+		actorId := uuid.New()
+		eventId := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`SELECT .* FROM "audit_events" AS "audit_event" WHERE \(action = 'auth.sign_in.failed'\) AND \(actor_id = '%s'\) ORDER BY "created_at" DESC, "id" DESC LIMIT 20 OFFSET 40`,
+			regexp.QuoteMeta(actorId.String()),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "action", "actor_id", "metadata"}).
+				AddRow(eventId.String(), "auth.sign_in.failed", actorId.String(), `{"emailAddress":"ada@example.com"}`))
+
+		auditEvents, err := repository.FindAllByFilter(ctx, Filter{Action: "auth.sign_in.failed", ActorId: actorId}, 20, 40)
+
+		require.NoError(t, err)
+		require.Len(t, auditEvents, 1)
+		assert.Equal(t, eventId, auditEvents[0].Id)
+		assert.Equal(t, actorId, auditEvents[0].ActorId)
+		assert.Equal(t, map[string]any{"emailAddress": "ada@example.com"}, auditEvents[0].Metadata)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select audit events")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "audit_events"`).
+			WillReturnError(expectedErr)
+
+		auditEvents, err := repository.FindAllByFilter(ctx, Filter{}, 20, 0)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, auditEvents)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CountByFilter(t *testing.T) {
+	t.Run("counts the audit events matching the filter", func(t *testing.T) {
+		ctx := context.Background()
+		targetId := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`SELECT count\(\*\) FROM "audit_events" AS "audit_event" WHERE \(target_type = 'user_session'\) AND \(target_id = '%s'\)`,
+			regexp.QuoteMeta(targetId.String()),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repository.CountByFilter(ctx, Filter{TargetType: "user_session", TargetId: targetId})
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the audit event with its changes as json", func(t *testing.T) {
+		ctx := context.Background()
+		actorId := uuid.New()
+		newEvent := &entity.AuditEvent{
+			Action:     "auth.sign_up",
+			ActorId:    actorId,
+			TargetType: "user",
+			TargetId:   actorId,
+			IpAddress:  "127.0.0.1",
+			UserAgent:  "Go test",
+			RequestId:  "request-id",
+			Changes: map[string]entity.AuditEventChange{
+				"name": {To: "Ada Lovelace"},
+			},
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "audit_events" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, 'auth.sign_up', '%[1]s', DEFAULT, 'user', '%[1]s', '127.0.0.1', 'Go test', 'request-id', '{"name":{"from":null,"to":"Ada Lovelace"}}', DEFAULT\) RETURNING`,
+			regexp.QuoteMeta(actorId.String()),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newEvent)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("insert audit event")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "audit_events"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, &entity.AuditEvent{})
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
@@ -0,0 +1,52 @@
+package failed_sign_in_attempt
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	CountByEmailAddressSince(ctx context.Context, emailAddress string, since time.Time) (int, error)
+	CountByIpAddressSince(ctx context.Context, ipAddress string, since time.Time) (int, error)
+	Create(ctx context.Context, failedSignInAttempt *entity.FailedSignInAttempt) error
+	DeleteByEmailAddress(ctx context.Context, emailAddress string) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) CountByEmailAddressSince(ctx context.Context, emailAddress string, since time.Time) (int, error) {
+	return r.sqlDB.DB(ctx).NewSelect().Model(&entity.FailedSignInAttempt{}).Where("email_address = ?", emailAddress).Where("created_at > ?", since).Count(ctx)
+}
+
+func (r *Repository) CountByIpAddressSince(ctx context.Context, ipAddress string, since time.Time) (int, error) {
+	return r.sqlDB.DB(ctx).NewSelect().Model(&entity.FailedSignInAttempt{}).Where("ip_address = ?", ipAddress).Where("created_at > ?", since).Count(ctx)
+}
+
+func (r *Repository) Create(ctx context.Context, failedSignInAttempt *entity.FailedSignInAttempt) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(failedSignInAttempt).Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteByEmailAddress(ctx context.Context, emailAddress string) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.FailedSignInAttempt{}).Where("email_address = ?", emailAddress).Exec(ctx)
+	return err
+}
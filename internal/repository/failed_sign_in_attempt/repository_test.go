@@ -0,0 +1,150 @@
+package failed_sign_in_attempt
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_CountByEmailAddressSince(t *testing.T) {
+	t.Run("counts the failed attempts of the email address since the given time", func(t *testing.T) {
+		ctx := context.Background()
+		since := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT count\(\*\) FROM "failed_sign_in_attempts" AS "failed_sign_in_attempt" WHERE \(email_address = 'ada@example.com'\) AND \(created_at > '2026-10-18 09:00:00\+00:00'\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(3))
+
+		count, err := repository.CountByEmailAddressSince(ctx, "ada@example.com", since)
+
+		require.NoError(t, err)
+		assert.Equal(t, 3, count)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the count fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("count failed sign in attempts")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT count\(\*\) FROM "failed_sign_in_attempts"`).
+			WillReturnError(expectedErr)
+
+		_, err := repository.CountByEmailAddressSince(ctx, "ada@example.com", time.Now())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CountByIpAddressSince(t *testing.T) {
+	t.Run("counts the failed attempts of the ip address since the given time", func(t *testing.T) {
+		ctx := context.Background()
+		since := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT count\(\*\) FROM "failed_sign_in_attempts" AS "failed_sign_in_attempt" WHERE \(ip_address = '127.0.0.1'\) AND \(created_at > '2026-10-18 09:00:00\+00:00'\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(12))
+
+		count, err := repository.CountByIpAddressSince(ctx, "127.0.0.1", since)
+
+		require.NoError(t, err)
+		assert.Equal(t, 12, count)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the failed sign in attempt", func(t *testing.T) {
+		ctx := context.Background()
+		newAttempt := &entity.FailedSignInAttempt{EmailAddress: "ada@example.com", IpAddress: "127.0.0.1"}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "failed_sign_in_attempts" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s'\) RETURNING`,
+			regexp.QuoteMeta(newAttempt.EmailAddress),
+			regexp.QuoteMeta(newAttempt.IpAddress),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newAttempt)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("insert failed sign in attempt")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "failed_sign_in_attempts"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, &entity.FailedSignInAttempt{EmailAddress: "ada@example.com", IpAddress: "127.0.0.1"})
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByEmailAddress(t *testing.T) {
+	t.Run("deletes every failed attempt of the email address", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`DELETE FROM "failed_sign_in_attempts" AS "failed_sign_in_attempt" WHERE \(email_address = 'ada@example.com'\)`).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		err := repository.DeleteByEmailAddress(ctx, "ada@example.com")
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
@@ -0,0 +1,53 @@
+package identity
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindByProviderAndSubject(ctx context.Context, provider, subject string) (*entity.Identity, error)
+	Create(ctx context.Context, identity *entity.Identity) error
+	DeleteByUserId(ctx context.Context, userId uuid.UUID) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindByProviderAndSubject(ctx context.Context, provider, subject string) (*entity.Identity, error) {
+	identity := &entity.Identity{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(identity).Where("provider = ?", provider).Where("subject = ?", subject).Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return identity, nil
+}
+
+func (r *Repository) Create(ctx context.Context, identity *entity.Identity) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(identity).Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteByUserId(ctx context.Context, userId uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.Identity{}).Where("user_id = ?", userId).Exec(ctx)
+	return err
+}
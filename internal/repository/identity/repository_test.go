@@ -0,0 +1,158 @@
+package identity
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindByProviderAndSubject(t *testing.T) {
+	t.Run("returns the identity selected by provider and subject", func(t *testing.T) {
+		ctx := context.Background()
+		identityID := uuid.New()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "identities" AS "identity" WHERE \(provider = 'google'\) AND \(subject = 'subject-1'\) LIMIT 1`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "provider", "subject", "email_address"}).
+				AddRow(identityID.String(), userID.String(), "google", "subject-1", "ada@example.com"))
+
+		actualIdentity, err := repository.FindByProviderAndSubject(ctx, "google", "subject-1")
+
+		require.NoError(t, err)
+		require.NotNil(t, actualIdentity)
+		assert.Equal(t, identityID, actualIdentity.Id)
+		assert.Equal(t, userID, actualIdentity.UserId)
+		assert.Equal(t, "ada@example.com", actualIdentity.EmailAddress)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns sql.ErrNoRows for unknown identities", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "identities"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		actualIdentity, err := repository.FindByProviderAndSubject(ctx, "google", "subject-1")
+
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, actualIdentity)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the identity", func(t *testing.T) {
+		ctx := context.Background()
+		newIdentity := &entity.Identity{
+			UserId:       uuid.New(),
+			Provider:     "google",
+			Subject:      "subject-1",
+			EmailAddress: "ada@example.com",
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "identities" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', 'google', 'subject-1', 'ada@example.com'\) RETURNING`,
+			regexp.QuoteMeta(newIdentity.UserId.String()),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newIdentity)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("insert identity")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "identities"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, &entity.Identity{UserId: uuid.New()})
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByUserId(t *testing.T) {
+	t.Run("deletes every identity of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "identities" AS "identity" WHERE \(user_id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		err := repository.DeleteByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the delete fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("delete identities")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`DELETE FROM "identities"`).
+			WillReturnError(expectedErr)
+
+		err := repository.DeleteByUserId(ctx, uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
@@ -0,0 +1,35 @@
+package impersonation_log
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	Create(ctx context.Context, impersonationLog *entity.ImpersonationLog) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) Create(ctx context.Context, impersonationLog *entity.ImpersonationLog) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(impersonationLog).Exec(ctx)
+	return err
+}
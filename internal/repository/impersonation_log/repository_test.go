@@ -0,0 +1,83 @@
+package impersonation_log
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the impersonation log", func(t *testing.T) {
+		ctx := context.Background()
+		newLog := &entity.ImpersonationLog{
+			UserSessionId:  uuid.New(),
+			ImpersonatorId: uuid.New(),
+			UserId:         uuid.New(),
+			Method:         "GET",
+			Path:           "/api/v1/app/auth/me",
+			IpAddress:      "127.0.0.1",
+			UserAgent:      "Go test",
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "impersonation_logs" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', 'GET', '/api/v1/app/auth/me', '127.0.0.1', 'Go test'\) RETURNING`,
+			regexp.QuoteMeta(newLog.UserSessionId.String()),
+			regexp.QuoteMeta(newLog.ImpersonatorId.String()),
+			regexp.QuoteMeta(newLog.UserId.String()),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newLog)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("insert impersonation log")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "impersonation_logs"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, &entity.ImpersonationLog{})
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
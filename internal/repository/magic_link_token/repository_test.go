@@ -0,0 +1,226 @@
+package magic_link_token
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindByTokenForUpdate(t *testing.T) {
+	t.Run("locks and returns the magic link token selected by token", func(t *testing.T) {
+		ctx := context.Background()
+		token := "magic-link-token"
+		magicLinkTokenID := uuid.New()
+		userID := uuid.New()
+		expiresAt := time.Now().Add(time.Hour)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "magic_link_tokens" AS "magic_link_token" WHERE \(token_digest = '%s'\) LIMIT 1 FOR UPDATE`, util.DigestToken(token))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token_digest", "expires_at", "used_at"}).
+				AddRow(magicLinkTokenID.String(), userID.String(), util.DigestToken(token), expiresAt, nil))
+
+		actualToken, err := repository.FindByTokenForUpdate(ctx, token)
+
+		require.NoError(t, err)
+		require.NotNil(t, actualToken)
+		assert.Equal(t, magicLinkTokenID, actualToken.Id)
+		assert.Equal(t, userID, actualToken.UserId)
+		assert.Equal(t, util.DigestToken(token), actualToken.TokenDigest)
+		assert.True(t, actualToken.UsedAt.IsZero())
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		token := "magic-link-token"
+		expectedErr := errors.New("select magic link token")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "magic_link_tokens" AS "magic_link_token" WHERE \(token_digest = '%s'\) LIMIT 1 FOR UPDATE`, util.DigestToken(token))).
+			WillReturnError(expectedErr)
+
+		actualToken, err := repository.FindByTokenForUpdate(ctx, token)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualToken)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_FindLatestByUserId(t *testing.T) {
+	t.Run("returns the most recently created token of the user", func(t *testing.T) {
+		ctx := context.Background()
+		magicLinkTokenID := uuid.New()
+		userID := uuid.New()
+		createdAt := time.Now()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "magic_link_tokens" AS "magic_link_token" WHERE \(user_id = '%s'\) ORDER BY "created_at" DESC LIMIT 1`, regexp.QuoteMeta(userID.String()))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "created_at"}).
+				AddRow(magicLinkTokenID.String(), userID.String(), createdAt))
+
+		actualToken, err := repository.FindLatestByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.NotNil(t, actualToken)
+		assert.Equal(t, magicLinkTokenID, actualToken.Id)
+		assert.Equal(t, userID, actualToken.UserId)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns sql.ErrNoRows when the user has no token", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "magic_link_tokens"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		actualToken, err := repository.FindLatestByUserId(ctx, uuid.New())
+
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, actualToken)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the magic link token", func(t *testing.T) {
+		ctx := context.Background()
+		newToken := &entity.MagicLinkToken{UserId: uuid.New()}
+		newToken.GenerateToken(time.Hour)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "magic_link_tokens" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '[^']+', DEFAULT\) RETURNING`,
+			regexp.QuoteMeta(newToken.UserId.String()),
+			regexp.QuoteMeta(newToken.TokenDigest),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "used_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now(), nil))
+
+		err := repository.Create(ctx, newToken)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		newToken := &entity.MagicLinkToken{UserId: uuid.New()}
+		newToken.GenerateToken(time.Hour)
+		expectedErr := errors.New("insert magic link token")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "magic_link_tokens"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, newToken)
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateUsedAtByUserId(t *testing.T) {
+	t.Run("marks every unused token of the user as used", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		usedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "magic_link_tokens" AS "magic_link_token" SET used_at = '2026-10-18 09:00:00\+00:00' WHERE \(user_id = '%s'\) AND \(used_at IS NULL\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateUsedAtByUserId(ctx, userID, usedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		expectedErr := errors.New("update magic link tokens")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "magic_link_tokens"`).
+			WillReturnError(expectedErr)
+
+		err := repository.UpdateUsedAtByUserId(ctx, userID, time.Now())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByUserId(t *testing.T) {
+	t.Run("deletes every magic link token of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "magic_link_tokens" AS "magic_link_token" WHERE \(user_id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.DeleteByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
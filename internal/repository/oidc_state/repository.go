@@ -0,0 +1,61 @@
+package oidc_state
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindByState(ctx context.Context, state string) (*entity.OidcState, error)
+	Create(ctx context.Context, oidcState *entity.OidcState) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
+	DeleteAllExpired(ctx context.Context, now time.Time) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindByState(ctx context.Context, state string) (*entity.OidcState, error) {
+	oidcState := &entity.OidcState{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(oidcState).Where("state_digest = ?", util.DigestToken(state)).Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return oidcState, nil
+}
+
+func (r *Repository) Create(ctx context.Context, oidcState *entity.OidcState) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(oidcState).Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.OidcState{}).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteAllExpired(ctx context.Context, now time.Time) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.OidcState{}).Where("expires_at <= ?", now).Exec(ctx)
+	return err
+}
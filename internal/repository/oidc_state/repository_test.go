@@ -0,0 +1,143 @@
+package oidc_state
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindByState(t *testing.T) {
+	t.Run("returns the oidc state selected by state", func(t *testing.T) {
+		ctx := context.Background()
+		state := "oidc-state"
+		oidcStateID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "oidc_states" AS "oidc_state" WHERE \(state_digest = '%s'\) LIMIT 1`, util.DigestToken(state))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "provider", "state_digest", "nonce", "code_verifier", "expires_at"}).
+				AddRow(oidcStateID.String(), "google", util.DigestToken(state), "nonce", "code-verifier", time.Now().Add(time.Minute)))
+
+		actualState, err := repository.FindByState(ctx, state)
+
+		require.NoError(t, err)
+		require.NotNil(t, actualState)
+		assert.Equal(t, oidcStateID, actualState.Id)
+		assert.Equal(t, "google", actualState.Provider)
+		assert.Equal(t, "nonce", actualState.Nonce)
+		assert.Equal(t, "code-verifier", actualState.CodeVerifier)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select oidc state")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "oidc_states"`).
+			WillReturnError(expectedErr)
+
+		actualState, err := repository.FindByState(ctx, "oidc-state")
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualState)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the oidc state", func(t *testing.T) {
+		ctx := context.Background()
+		newState := &entity.OidcState{Provider: "google"}
+		newState.Generate(time.Minute)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "oidc_states" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, 'google', '%s', '%s', '%s', '[^']+'\) RETURNING`,
+			regexp.QuoteMeta(newState.StateDigest),
+			regexp.QuoteMeta(newState.Nonce),
+			regexp.QuoteMeta(newState.CodeVerifier),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newState)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteById(t *testing.T) {
+	t.Run("deletes the oidc state by id", func(t *testing.T) {
+		ctx := context.Background()
+		oidcStateID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "oidc_states" AS "oidc_state" WHERE \(id = '%s'\)`, regexp.QuoteMeta(oidcStateID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.DeleteById(ctx, oidcStateID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteAllExpired(t *testing.T) {
+	t.Run("deletes the oidc states that expired by the given time", func(t *testing.T) {
+		ctx := context.Background()
+		now := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`DELETE FROM "oidc_states" AS "oidc_state" WHERE \(expires_at <= '2026-10-18 09:00:00\+00:00'\)`).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		err := repository.DeleteAllExpired(ctx, now)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
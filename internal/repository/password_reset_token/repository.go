@@ -0,0 +1,61 @@
+package password_reset_token
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindByTokenForUpdate(ctx context.Context, token string) (*entity.PasswordResetToken, error)
+	Create(ctx context.Context, passwordResetToken *entity.PasswordResetToken) error
+	UpdateUsedAtByUserId(ctx context.Context, userId uuid.UUID, usedAt time.Time) error
+	DeleteByUserId(ctx context.Context, userId uuid.UUID) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindByTokenForUpdate(ctx context.Context, token string) (*entity.PasswordResetToken, error) {
+	passwordResetToken := &entity.PasswordResetToken{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(passwordResetToken).Where("token_digest = ?", util.DigestToken(token)).Limit(1).For("UPDATE").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return passwordResetToken, nil
+}
+
+func (r *Repository) Create(ctx context.Context, passwordResetToken *entity.PasswordResetToken) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(passwordResetToken).Exec(ctx)
+	return err
+}
+
+func (r *Repository) UpdateUsedAtByUserId(ctx context.Context, userId uuid.UUID, usedAt time.Time) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.PasswordResetToken{}).Set("used_at = ?", usedAt).Where("user_id = ?", userId).Where("used_at IS NULL").Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteByUserId(ctx context.Context, userId uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.PasswordResetToken{}).Where("user_id = ?", userId).Exec(ctx)
+	return err
+}
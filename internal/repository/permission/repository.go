@@ -0,0 +1,48 @@
+package permission
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindAllNamesByUserId(ctx context.Context, userId uuid.UUID) ([]string, error)
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+// FindAllNamesByUserId returns the permissions granted to the user through
+// any of their roles.
+func (r *Repository) FindAllNamesByUserId(ctx context.Context, userId uuid.UUID) ([]string, error) {
+	names := make([]string, 0)
+	err := r.sqlDB.DB(ctx).NewSelect().Model((*entity.Permission)(nil)).
+		ColumnExpr("DISTINCT permission.name").
+		Join("JOIN role_permissions AS role_permission ON role_permission.permission_id = permission.id").
+		Join("JOIN user_roles AS user_role ON user_role.role_id = role_permission.role_id").
+		Where("user_role.user_id = ?", userId).
+		Scan(ctx, &names)
+	if err != nil {
+		return nil, err
+	}
+
+	return names, nil
+}
@@ -0,0 +1,75 @@
+package permission
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindAllNamesByUserId(t *testing.T) {
+	t.Run("returns the permissions granted through the roles of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`SELECT DISTINCT permission.name FROM "permissions" AS "permission" JOIN role_permissions AS role_permission ON role_permission.permission_id = permission.id JOIN user_roles AS user_role ON user_role.role_id = role_permission.role_id WHERE \(user_role.user_id = '%s'\)`,
+			regexp.QuoteMeta(userID.String()),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"name"}).
+				AddRow("users:read").
+				AddRow("users:manage"))
+
+		actualNames, err := repository.FindAllNamesByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		assert.Equal(t, []string{"users:read", "users:manage"}, actualNames)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select permissions")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT DISTINCT permission.name FROM "permissions"`).
+			WillReturnError(expectedErr)
+
+		actualNames, err := repository.FindAllNamesByUserId(ctx, uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualNames)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/anonychun/bibit/internal/bootstrap"
 	"github.com/anonychun/bibit/internal/current"
 	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
 	"github.com/samber/do/v2"
 	"github.com/uptrace/bun"
 )
@@ -22,3 +23,23 @@ func Transaction(ctx context.Context, fn func(ctx context.Context) error) error
 		return fn(ctx)
 	})
 }
+
+// WithAttachments eager loads the AttachmentLinks relation of the selected
+// model together with the linked attachments, limited to names when given.
+// Links to attachments that are not committed yet are skipped.
+//
+//	db.NewSelect().Model(user).Apply(repository.WithAttachments(entity.UserAttachmentAvatar))
+func WithAttachments(names ...string) func(query *bun.SelectQuery) *bun.SelectQuery {
+	return func(query *bun.SelectQuery) *bun.SelectQuery {
+		return query.
+			Relation("AttachmentLinks", func(query *bun.SelectQuery) *bun.SelectQuery {
+				query = query.Where("attachment.status = ?", entity.AttachmentStatusCommitted)
+				if len(names) > 0 {
+					query = query.Where("attachment_link.name IN (?)", bun.In(names))
+				}
+
+				return query.Order("attachment_link.position ASC")
+			}).
+			Relation("AttachmentLinks.Attachment")
+	}
+}
@@ -3,6 +3,7 @@ package repository
 import (
 	"context"
 	"errors"
+	"fmt"
 	"reflect"
 	"testing"
 	"unsafe"
@@ -11,6 +12,8 @@ import (
 	"github.com/anonychun/bibit/internal/bootstrap"
 	"github.com/anonychun/bibit/internal/current"
 	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
 	"github.com/samber/do/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -54,6 +57,39 @@ func TestTransaction(t *testing.T) {
 	})
 }
 
+func TestWithAttachments(t *testing.T) {
+	t.Run("eager loads the named attachment links ordered by position", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		attachmentID := uuid.New()
+		rawDB, sqlMock, err := sqlmock.New()
+		require.NoError(t, err)
+		bunDB := bun.NewDB(rawDB, pgdialect.New())
+		t.Cleanup(func() {
+			sqlMock.ExpectClose()
+			_ = bunDB.Close()
+		})
+		user := &entity.User{}
+
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "users" AS "user" WHERE \(id = '%s'\)`, userID)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "name"}).AddRow(userID.String(), "Ada"))
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`SELECT .* FROM "attachment_links" AS "attachment_link" LEFT JOIN "attachments" AS "attachment" ON \("attachment"."id" = "attachment_link"."attachment_id"\) WHERE \("attachment_link"."record_id" IN \('%s'\)\) AND \("record_type" = 'user'\) AND \(attachment.status = 'committed'\) AND \(attachment_link.name IN \('avatar'\)\) ORDER BY "attachment_link"."position" ASC`,
+			userID,
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "attachment_id", "record_type", "record_id", "name", "position", "attachment__id", "attachment__object_name", "attachment__status"}).
+				AddRow(uuid.New().String(), attachmentID.String(), "user", userID.String(), "avatar", 0, attachmentID.String(), "01JZ.png", entity.AttachmentStatusCommitted))
+
+		err = bunDB.NewSelect().Model(user).Where("id = ?", userID).Apply(WithAttachments(entity.UserAttachmentAvatar)).Scan(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, user.Avatar())
+		assert.Equal(t, attachmentID, user.Avatar().Id)
+		assert.Equal(t, "01JZ.png", user.Avatar().ObjectName)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
 func registerTransactionDB(t *testing.T) sqlmock.Sqlmock {
 	t.Helper()
 
@@ -0,0 +1,76 @@
+package sign_in_challenge
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindByToken(ctx context.Context, token string) (*entity.SignInChallenge, error)
+	Create(ctx context.Context, signInChallenge *entity.SignInChallenge) error
+	IncrementAttemptsById(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error)
+	DeleteById(ctx context.Context, id uuid.UUID) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindByToken(ctx context.Context, token string) (*entity.SignInChallenge, error) {
+	signInChallenge := &entity.SignInChallenge{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(signInChallenge).Where("token_digest = ?", util.DigestToken(token)).Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signInChallenge, nil
+}
+
+func (r *Repository) Create(ctx context.Context, signInChallenge *entity.SignInChallenge) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(signInChallenge).Exec(ctx)
+	return err
+}
+
+// IncrementAttemptsById reserves an attempt before the code is checked and
+// reports false once maxAttempts have been used, even under concurrent
+// requests.
+func (r *Repository) IncrementAttemptsById(ctx context.Context, id uuid.UUID, maxAttempts int) (bool, error) {
+	result, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.SignInChallenge{}).
+		Set("attempts = attempts + 1").
+		Where("id = ?", id).
+		Where("attempts < ?", maxAttempts).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.SignInChallenge{}).Where("id = ?", id).Exec(ctx)
+	return err
+}
@@ -0,0 +1,159 @@
+package sign_in_challenge
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindByToken(t *testing.T) {
+	t.Run("returns the sign in challenge selected by token", func(t *testing.T) {
+		ctx := context.Background()
+		token := "challenge-token"
+		signInChallengeID := uuid.New()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "sign_in_challenges" AS "sign_in_challenge" WHERE \(token_digest = '%s'\) LIMIT 1`, util.DigestToken(token))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token_digest", "expires_at"}).
+				AddRow(signInChallengeID.String(), userID.String(), util.DigestToken(token), time.Now().Add(time.Minute)))
+
+		actualChallenge, err := repository.FindByToken(ctx, token)
+
+		require.NoError(t, err)
+		require.NotNil(t, actualChallenge)
+		assert.Equal(t, signInChallengeID, actualChallenge.Id)
+		assert.Equal(t, userID, actualChallenge.UserId)
+		assert.Equal(t, util.DigestToken(token), actualChallenge.TokenDigest)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select sign in challenge")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "sign_in_challenges"`).
+			WillReturnError(expectedErr)
+
+		actualChallenge, err := repository.FindByToken(ctx, "challenge-token")
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualChallenge)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the sign in challenge", func(t *testing.T) {
+		ctx := context.Background()
+		newChallenge := &entity.SignInChallenge{UserId: uuid.New()}
+		newChallenge.GenerateToken(time.Minute)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "sign_in_challenges" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '[^']+', 0\) RETURNING`,
+			regexp.QuoteMeta(newChallenge.UserId.String()),
+			regexp.QuoteMeta(newChallenge.TokenDigest),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newChallenge)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_IncrementAttemptsById(t *testing.T) {
+	t.Run("counts an attempt while fewer than the maximum were used", func(t *testing.T) {
+		ctx := context.Background()
+		signInChallengeID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "sign_in_challenges" AS "sign_in_challenge" SET attempts = attempts \+ 1 WHERE \(id = '%s'\) AND \(attempts < 5\)`, regexp.QuoteMeta(signInChallengeID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		isAllowed, err := repository.IncrementAttemptsById(ctx, signInChallengeID, 5)
+
+		require.NoError(t, err)
+		assert.True(t, isAllowed)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("reports false once the attempts are used up", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "sign_in_challenges"`).WillReturnResult(sqlmock.NewResult(0, 0))
+
+		isAllowed, err := repository.IncrementAttemptsById(ctx, uuid.New(), 5)
+
+		require.NoError(t, err)
+		assert.False(t, isAllowed)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteById(t *testing.T) {
+	t.Run("deletes the sign in challenge by id", func(t *testing.T) {
+		ctx := context.Background()
+		signInChallengeID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "sign_in_challenges" AS "sign_in_challenge" WHERE \(id = '%s'\)`, regexp.QuoteMeta(signInChallengeID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.DeleteById(ctx, signInChallengeID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
@@ -0,0 +1,60 @@
+package sign_in_lockout
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	FindLatestByScopeAndSubject(ctx context.Context, scope, subject string) (*entity.SignInLockout, error)
+	CountByScopeAndSubjectSince(ctx context.Context, scope, subject string, since time.Time) (int, error)
+	Create(ctx context.Context, signInLockout *entity.SignInLockout) error
+	LockByScopeAndSubject(ctx context.Context, scope, subject string) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) FindLatestByScopeAndSubject(ctx context.Context, scope, subject string) (*entity.SignInLockout, error) {
+	signInLockout := &entity.SignInLockout{}
+	err := r.sqlDB.DB(ctx).NewSelect().Model(signInLockout).Where("scope = ?", scope).Where("subject = ?", subject).Order("created_at DESC").Limit(1).Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return signInLockout, nil
+}
+
+func (r *Repository) CountByScopeAndSubjectSince(ctx context.Context, scope, subject string, since time.Time) (int, error) {
+	return r.sqlDB.DB(ctx).NewSelect().Model(&entity.SignInLockout{}).Where("scope = ?", scope).Where("subject = ?", subject).Where("created_at > ?", since).Count(ctx)
+}
+
+func (r *Repository) Create(ctx context.Context, signInLockout *entity.SignInLockout) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(signInLockout).Exec(ctx)
+	return err
+}
+
+// LockByScopeAndSubject takes a transaction scoped advisory lock on the
+// subject so that concurrent failures are counted one after another.
+func (r *Repository) LockByScopeAndSubject(ctx context.Context, scope, subject string) error {
+	_, err := r.sqlDB.DB(ctx).NewRaw("SELECT pg_advisory_xact_lock(hashtextextended(?, 0))", scope+":"+subject).Exec(ctx)
+	return err
+}
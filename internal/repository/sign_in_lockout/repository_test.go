@@ -0,0 +1,178 @@
+package sign_in_lockout
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_FindLatestByScopeAndSubject(t *testing.T) {
+	t.Run("returns the most recent lockout of the subject", func(t *testing.T) {
+		ctx := context.Background()
+		signInLockoutID := uuid.New()
+		lockedUntil := time.Now().Add(time.Minute)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "sign_in_lockouts" AS "sign_in_lockout" WHERE \(scope = 'account'\) AND \(subject = 'ada@example.com'\) ORDER BY "created_at" DESC LIMIT 1`).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "scope", "subject", "failed_attempts", "locked_until"}).
+				AddRow(signInLockoutID.String(), entity.SignInLockoutScopeAccount, "ada@example.com", 5, lockedUntil))
+
+		actualLockout, err := repository.FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, "ada@example.com")
+
+		require.NoError(t, err)
+		require.NotNil(t, actualLockout)
+		assert.Equal(t, signInLockoutID, actualLockout.Id)
+		assert.Equal(t, 5, actualLockout.FailedAttempts)
+		assert.True(t, actualLockout.IsActive())
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns sql.ErrNoRows when the subject was never locked out", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "sign_in_lockouts"`).
+			WillReturnRows(sqlmock.NewRows([]string{"id"}))
+
+		actualLockout, err := repository.FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeIp, "127.0.0.1")
+
+		require.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, actualLockout)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_CountByScopeAndSubjectSince(t *testing.T) {
+	t.Run("counts the lockouts of the subject since the given time", func(t *testing.T) {
+		ctx := context.Background()
+		since := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT count\(\*\) FROM "sign_in_lockouts" AS "sign_in_lockout" WHERE \(scope = 'ip'\) AND \(subject = '127.0.0.1'\) AND \(created_at > '2026-10-18 09:00:00\+00:00'\)`).
+			WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(2))
+
+		count, err := repository.CountByScopeAndSubjectSince(ctx, entity.SignInLockoutScopeIp, "127.0.0.1", since)
+
+		require.NoError(t, err)
+		assert.Equal(t, 2, count)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Create(t *testing.T) {
+	t.Run("inserts the sign in lockout", func(t *testing.T) {
+		ctx := context.Background()
+		newLockout := &entity.SignInLockout{
+			Scope:          entity.SignInLockoutScopeAccount,
+			Subject:        "ada@example.com",
+			FailedAttempts: 5,
+			LockedUntil:    time.Now().Add(15 * time.Minute),
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "sign_in_lockouts" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', 5, '[^']+'\) RETURNING`,
+			regexp.QuoteMeta(newLockout.Scope),
+			regexp.QuoteMeta(newLockout.Subject),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now()))
+
+		err := repository.Create(ctx, newLockout)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("insert sign in lockout")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "sign_in_lockouts"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Create(ctx, &entity.SignInLockout{Scope: entity.SignInLockoutScopeIp, Subject: "127.0.0.1"})
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_LockByScopeAndSubject(t *testing.T) {
+	t.Run("takes an advisory lock on the scope and subject", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_xact_lock(hashtextextended('account:ada@example.com', 0))`)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.LockByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, "ada@example.com")
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the lock fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("lock sign in lockout")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`SELECT pg_advisory_xact_lock`).
+			WillReturnError(expectedErr)
+
+		err := repository.LockByScopeAndSubject(ctx, entity.SignInLockoutScopeIp, "127.0.0.1")
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
@@ -6,6 +6,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/anonychun/bibit/internal/entity"
 	"github.com/google/uuid"
@@ -96,6 +97,63 @@ func (_c *MockIRepository_Create_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// DeleteById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_DeleteById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteById'
+type MockIRepository_DeleteById_Call struct {
+	*mock.Call
+}
+
+// DeleteById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIRepository_Expecter) DeleteById(ctx interface{}, id interface{}) *MockIRepository_DeleteById_Call {
+	return &MockIRepository_DeleteById_Call{Call: _e.mock.On("DeleteById", ctx, id)}
+}
+
+func (_c *MockIRepository_DeleteById_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIRepository_DeleteById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_DeleteById_Call) Return(err error) *MockIRepository_DeleteById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_DeleteById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockIRepository_DeleteById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // ExistsByEmailAddress provides a mock function for the type MockIRepository
 func (_mock *MockIRepository) ExistsByEmailAddress(ctx context.Context, emailAddress string) (bool, error) {
 	ret := _mock.Called(ctx, emailAddress)
@@ -297,3 +355,567 @@ func (_c *MockIRepository_FindById_Call) RunAndReturn(run func(ctx context.Conte
 	_c.Call.Return(run)
 	return _c
 }
+
+// ResetTotpById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) ResetTotpById(ctx context.Context, id uuid.UUID) error {
+	ret := _mock.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetTotpById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_ResetTotpById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetTotpById'
+type MockIRepository_ResetTotpById_Call struct {
+	*mock.Call
+}
+
+// ResetTotpById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+func (_e *MockIRepository_Expecter) ResetTotpById(ctx interface{}, id interface{}) *MockIRepository_ResetTotpById_Call {
+	return &MockIRepository_ResetTotpById_Call{Call: _e.mock.On("ResetTotpById", ctx, id)}
+}
+
+func (_c *MockIRepository_ResetTotpById_Call) Run(run func(ctx context.Context, id uuid.UUID)) *MockIRepository_ResetTotpById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_ResetTotpById_Call) Return(err error) *MockIRepository_ResetTotpById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_ResetTotpById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID) error) *MockIRepository_ResetTotpById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Update provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) Update(ctx context.Context, user *entity.User) error {
+	ret := _mock.Called(ctx, user)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Update")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *entity.User) error); ok {
+		r0 = returnFunc(ctx, user)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_Update_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Update'
+type MockIRepository_Update_Call struct {
+	*mock.Call
+}
+
+// Update is a helper method to define mock.On call
+//   - ctx context.Context
+//   - user *entity.User
+func (_e *MockIRepository_Expecter) Update(ctx interface{}, user interface{}) *MockIRepository_Update_Call {
+	return &MockIRepository_Update_Call{Call: _e.mock.On("Update", ctx, user)}
+}
+
+func (_c *MockIRepository_Update_Call) Run(run func(ctx context.Context, user *entity.User)) *MockIRepository_Update_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *entity.User
+		if args[1] != nil {
+			arg1 = args[1].(*entity.User)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) Return(err error) *MockIRepository_Update_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_Update_Call) RunAndReturn(run func(ctx context.Context, user *entity.User) error) *MockIRepository_Update_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEmailAddressById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateEmailAddressById(ctx context.Context, id uuid.UUID, emailAddress string) error {
+	ret := _mock.Called(ctx, id, emailAddress)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmailAddressById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, emailAddress)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateEmailAddressById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmailAddressById'
+type MockIRepository_UpdateEmailAddressById_Call struct {
+	*mock.Call
+}
+
+// UpdateEmailAddressById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - emailAddress string
+func (_e *MockIRepository_Expecter) UpdateEmailAddressById(ctx interface{}, id interface{}, emailAddress interface{}) *MockIRepository_UpdateEmailAddressById_Call {
+	return &MockIRepository_UpdateEmailAddressById_Call{Call: _e.mock.On("UpdateEmailAddressById", ctx, id, emailAddress)}
+}
+
+func (_c *MockIRepository_UpdateEmailAddressById_Call) Run(run func(ctx context.Context, id uuid.UUID, emailAddress string)) *MockIRepository_UpdateEmailAddressById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateEmailAddressById_Call) Return(err error) *MockIRepository_UpdateEmailAddressById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateEmailAddressById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, emailAddress string) error) *MockIRepository_UpdateEmailAddressById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateEmailVerifiedAtById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateEmailVerifiedAtById(ctx context.Context, id uuid.UUID, emailVerifiedAt time.Time) error {
+	ret := _mock.Called(ctx, id, emailVerifiedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateEmailVerifiedAtById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, emailVerifiedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateEmailVerifiedAtById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateEmailVerifiedAtById'
+type MockIRepository_UpdateEmailVerifiedAtById_Call struct {
+	*mock.Call
+}
+
+// UpdateEmailVerifiedAtById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - emailVerifiedAt time.Time
+func (_e *MockIRepository_Expecter) UpdateEmailVerifiedAtById(ctx interface{}, id interface{}, emailVerifiedAt interface{}) *MockIRepository_UpdateEmailVerifiedAtById_Call {
+	return &MockIRepository_UpdateEmailVerifiedAtById_Call{Call: _e.mock.On("UpdateEmailVerifiedAtById", ctx, id, emailVerifiedAt)}
+}
+
+func (_c *MockIRepository_UpdateEmailVerifiedAtById_Call) Run(run func(ctx context.Context, id uuid.UUID, emailVerifiedAt time.Time)) *MockIRepository_UpdateEmailVerifiedAtById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateEmailVerifiedAtById_Call) Return(err error) *MockIRepository_UpdateEmailVerifiedAtById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateEmailVerifiedAtById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, emailVerifiedAt time.Time) error) *MockIRepository_UpdateEmailVerifiedAtById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateNameById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateNameById(ctx context.Context, id uuid.UUID, name string) error {
+	ret := _mock.Called(ctx, id, name)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateNameById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, name)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateNameById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateNameById'
+type MockIRepository_UpdateNameById_Call struct {
+	*mock.Call
+}
+
+// UpdateNameById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - name string
+func (_e *MockIRepository_Expecter) UpdateNameById(ctx interface{}, id interface{}, name interface{}) *MockIRepository_UpdateNameById_Call {
+	return &MockIRepository_UpdateNameById_Call{Call: _e.mock.On("UpdateNameById", ctx, id, name)}
+}
+
+func (_c *MockIRepository_UpdateNameById_Call) Run(run func(ctx context.Context, id uuid.UUID, name string)) *MockIRepository_UpdateNameById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateNameById_Call) Return(err error) *MockIRepository_UpdateNameById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateNameById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, name string) error) *MockIRepository_UpdateNameById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdatePasswordDigestById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdatePasswordDigestById(ctx context.Context, id uuid.UUID, passwordDigest string) error {
+	ret := _mock.Called(ctx, id, passwordDigest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdatePasswordDigestById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, passwordDigest)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdatePasswordDigestById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdatePasswordDigestById'
+type MockIRepository_UpdatePasswordDigestById_Call struct {
+	*mock.Call
+}
+
+// UpdatePasswordDigestById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - passwordDigest string
+func (_e *MockIRepository_Expecter) UpdatePasswordDigestById(ctx interface{}, id interface{}, passwordDigest interface{}) *MockIRepository_UpdatePasswordDigestById_Call {
+	return &MockIRepository_UpdatePasswordDigestById_Call{Call: _e.mock.On("UpdatePasswordDigestById", ctx, id, passwordDigest)}
+}
+
+func (_c *MockIRepository_UpdatePasswordDigestById_Call) Run(run func(ctx context.Context, id uuid.UUID, passwordDigest string)) *MockIRepository_UpdatePasswordDigestById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdatePasswordDigestById_Call) Return(err error) *MockIRepository_UpdatePasswordDigestById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdatePasswordDigestById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, passwordDigest string) error) *MockIRepository_UpdatePasswordDigestById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTotpEnabledAtById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateTotpEnabledAtById(ctx context.Context, id uuid.UUID, totpEnabledAt time.Time) error {
+	ret := _mock.Called(ctx, id, totpEnabledAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTotpEnabledAtById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, totpEnabledAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateTotpEnabledAtById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTotpEnabledAtById'
+type MockIRepository_UpdateTotpEnabledAtById_Call struct {
+	*mock.Call
+}
+
+// UpdateTotpEnabledAtById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - totpEnabledAt time.Time
+func (_e *MockIRepository_Expecter) UpdateTotpEnabledAtById(ctx interface{}, id interface{}, totpEnabledAt interface{}) *MockIRepository_UpdateTotpEnabledAtById_Call {
+	return &MockIRepository_UpdateTotpEnabledAtById_Call{Call: _e.mock.On("UpdateTotpEnabledAtById", ctx, id, totpEnabledAt)}
+}
+
+func (_c *MockIRepository_UpdateTotpEnabledAtById_Call) Run(run func(ctx context.Context, id uuid.UUID, totpEnabledAt time.Time)) *MockIRepository_UpdateTotpEnabledAtById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateTotpEnabledAtById_Call) Return(err error) *MockIRepository_UpdateTotpEnabledAtById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateTotpEnabledAtById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, totpEnabledAt time.Time) error) *MockIRepository_UpdateTotpEnabledAtById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTotpLastUsedStepById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateTotpLastUsedStepById(ctx context.Context, id uuid.UUID, totpLastUsedStep int64) (bool, error) {
+	ret := _mock.Called(ctx, id, totpLastUsedStep)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTotpLastUsedStepById")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) (bool, error)); ok {
+		return returnFunc(ctx, id, totpLastUsedStep)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, int64) bool); ok {
+		r0 = returnFunc(ctx, id, totpLastUsedStep)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, int64) error); ok {
+		r1 = returnFunc(ctx, id, totpLastUsedStep)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_UpdateTotpLastUsedStepById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTotpLastUsedStepById'
+type MockIRepository_UpdateTotpLastUsedStepById_Call struct {
+	*mock.Call
+}
+
+// UpdateTotpLastUsedStepById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - totpLastUsedStep int64
+func (_e *MockIRepository_Expecter) UpdateTotpLastUsedStepById(ctx interface{}, id interface{}, totpLastUsedStep interface{}) *MockIRepository_UpdateTotpLastUsedStepById_Call {
+	return &MockIRepository_UpdateTotpLastUsedStepById_Call{Call: _e.mock.On("UpdateTotpLastUsedStepById", ctx, id, totpLastUsedStep)}
+}
+
+func (_c *MockIRepository_UpdateTotpLastUsedStepById_Call) Run(run func(ctx context.Context, id uuid.UUID, totpLastUsedStep int64)) *MockIRepository_UpdateTotpLastUsedStepById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 int64
+		if args[2] != nil {
+			arg2 = args[2].(int64)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateTotpLastUsedStepById_Call) Return(b bool, err error) *MockIRepository_UpdateTotpLastUsedStepById_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateTotpLastUsedStepById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, totpLastUsedStep int64) (bool, error)) *MockIRepository_UpdateTotpLastUsedStepById_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateTotpSecretById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateTotpSecretById(ctx context.Context, id uuid.UUID, totpSecret string) error {
+	ret := _mock.Called(ctx, id, totpSecret)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTotpSecretById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, string) error); ok {
+		r0 = returnFunc(ctx, id, totpSecret)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateTotpSecretById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTotpSecretById'
+type MockIRepository_UpdateTotpSecretById_Call struct {
+	*mock.Call
+}
+
+// UpdateTotpSecretById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - totpSecret string
+func (_e *MockIRepository_Expecter) UpdateTotpSecretById(ctx interface{}, id interface{}, totpSecret interface{}) *MockIRepository_UpdateTotpSecretById_Call {
+	return &MockIRepository_UpdateTotpSecretById_Call{Call: _e.mock.On("UpdateTotpSecretById", ctx, id, totpSecret)}
+}
+
+func (_c *MockIRepository_UpdateTotpSecretById_Call) Run(run func(ctx context.Context, id uuid.UUID, totpSecret string)) *MockIRepository_UpdateTotpSecretById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 string
+		if args[2] != nil {
+			arg2 = args[2].(string)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateTotpSecretById_Call) Return(err error) *MockIRepository_UpdateTotpSecretById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateTotpSecretById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, totpSecret string) error) *MockIRepository_UpdateTotpSecretById_Call {
+	_c.Call.Return(run)
+	return _c
+}
@@ -2,6 +2,7 @@ package user
 
 import (
 	"context"
+	"time"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
 	dbSql "github.com/anonychun/bibit/internal/db/sql"
@@ -19,6 +20,16 @@ type IRepository interface {
 	FindByEmailAddress(ctx context.Context, emailAddress string) (*entity.User, error)
 	Create(ctx context.Context, user *entity.User) error
 	ExistsByEmailAddress(ctx context.Context, emailAddress string) (bool, error)
+	UpdatePasswordDigestById(ctx context.Context, id uuid.UUID, passwordDigest string) error
+	UpdateEmailVerifiedAtById(ctx context.Context, id uuid.UUID, emailVerifiedAt time.Time) error
+	UpdateNameById(ctx context.Context, id uuid.UUID, name string) error
+	UpdateEmailAddressById(ctx context.Context, id uuid.UUID, emailAddress string) error
+	UpdateTotpSecretById(ctx context.Context, id uuid.UUID, totpSecret string) error
+	UpdateTotpEnabledAtById(ctx context.Context, id uuid.UUID, totpEnabledAt time.Time) error
+	UpdateTotpLastUsedStepById(ctx context.Context, id uuid.UUID, totpLastUsedStep int64) (bool, error)
+	ResetTotpById(ctx context.Context, id uuid.UUID) error
+	Update(ctx context.Context, user *entity.User) error
+	DeleteById(ctx context.Context, id uuid.UUID) error
 }
 
 type Repository struct {
@@ -61,3 +72,77 @@ func (r *Repository) Create(ctx context.Context, user *entity.User) error {
 func (r *Repository) ExistsByEmailAddress(ctx context.Context, emailAddress string) (bool, error) {
 	return r.sqlDB.DB(ctx).NewSelect().Model(&entity.User{}).Where("email_address = ?", emailAddress).Exists(ctx)
 }
+
+func (r *Repository) UpdatePasswordDigestById(ctx context.Context, id uuid.UUID, passwordDigest string) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).Set("password_digest = ?", passwordDigest).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+func (r *Repository) UpdateEmailVerifiedAtById(ctx context.Context, id uuid.UUID, emailVerifiedAt time.Time) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).Set("email_verified_at = ?", emailVerifiedAt).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+func (r *Repository) UpdateNameById(ctx context.Context, id uuid.UUID, name string) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).Set("name = ?", name).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// UpdateEmailAddressById also clears email_verified_at, since the new address
+// has yet to be verified.
+func (r *Repository) UpdateEmailAddressById(ctx context.Context, id uuid.UUID, emailAddress string) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).Set("email_address = ?", emailAddress).Set("email_verified_at = NULL").Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+func (r *Repository) UpdateTotpSecretById(ctx context.Context, id uuid.UUID, totpSecret string) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).Set("totp_secret = ?", totpSecret).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+func (r *Repository) UpdateTotpEnabledAtById(ctx context.Context, id uuid.UUID, totpEnabledAt time.Time) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).Set("totp_enabled_at = ?", totpEnabledAt).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
+// UpdateTotpLastUsedStepById only moves the step forward, so it reports false
+// when a code of the same or a later step was already accepted.
+func (r *Repository) UpdateTotpLastUsedStepById(ctx context.Context, id uuid.UUID, totpLastUsedStep int64) (bool, error) {
+	result, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).
+		Set("totp_last_used_step = ?", totpLastUsedStep).
+		Where("id = ?", id).
+		Where("totp_last_used_step IS NULL OR totp_last_used_step < ?", totpLastUsedStep).
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) ResetTotpById(ctx context.Context, id uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.User{}).
+		Set("totp_secret = NULL").
+		Set("totp_enabled_at = NULL").
+		Set("totp_last_used_step = NULL").
+		Where("id = ?", id).
+		Exec(ctx)
+	return err
+}
+
+func (r *Repository) Update(ctx context.Context, user *entity.User) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(user).ExcludeColumn("id", "created_at", "deleted_at").WherePK().Exec(ctx)
+	return err
+}
+
+// DeleteById soft deletes the user. Soft deleted users are excluded from
+// every other query.
+func (r *Repository) DeleteById(ctx context.Context, id uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.User{}).Where("id = ?", id).Exec(ctx)
+	return err
+}
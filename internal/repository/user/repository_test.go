@@ -27,7 +27,7 @@ func TestRepository_FindById(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "users" AS "user" WHERE \(id = '%s'\) LIMIT 1`, regexp.QuoteMeta(userID.String()))).
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "users" AS "user" WHERE \(id = '%s'\) AND "user"."deleted_at" IS NULL LIMIT 1`, regexp.QuoteMeta(userID.String()))).
 			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email_address", "password_digest"}).
 				AddRow(userID.String(), "Ada Lovelace", "ada@example.com", "password-digest"))
 
@@ -51,7 +51,7 @@ func TestRepository_FindById(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "users" AS "user" WHERE \(id = '%s'\) LIMIT 1`, regexp.QuoteMeta(userID.String()))).
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "users" AS "user" WHERE \(id = '%s'\) AND "user"."deleted_at" IS NULL LIMIT 1`, regexp.QuoteMeta(userID.String()))).
 			WillReturnError(expectedErr)
 
 		actualUser, err := repository.FindById(ctx, userID)
@@ -72,7 +72,7 @@ func TestRepository_FindByEmailAddress(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "users" AS "user" WHERE \(email_address = '%s'\) LIMIT 1`, regexp.QuoteMeta(emailAddress))).
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "users" AS "user" WHERE \(email_address = '%s'\) AND "user"."deleted_at" IS NULL LIMIT 1`, regexp.QuoteMeta(emailAddress))).
 			WillReturnRows(sqlmock.NewRows([]string{"id", "name", "email_address", "password_digest"}).
 				AddRow(userID.String(), "Ada Lovelace", emailAddress, "password-digest"))
 
@@ -104,7 +104,7 @@ func TestRepository_Create(t *testing.T) {
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
 		sqlMock.ExpectQuery(fmt.Sprintf(
-			`INSERT INTO "users" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s'\) RETURNING`,
+			`INSERT INTO "users" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', DEFAULT, DEFAULT, DEFAULT, DEFAULT, DEFAULT\) RETURNING`,
 			regexp.QuoteMeta(newUser.Name),
 			regexp.QuoteMeta(newUser.EmailAddress),
 			regexp.QuoteMeta(newUser.PasswordDigest),
@@ -132,7 +132,7 @@ func TestRepository_Create(t *testing.T) {
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
 		sqlMock.ExpectQuery(fmt.Sprintf(
-			`INSERT INTO "users" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s'\) RETURNING`,
+			`INSERT INTO "users" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', DEFAULT, DEFAULT, DEFAULT, DEFAULT, DEFAULT\) RETURNING`,
 			regexp.QuoteMeta(newUser.Name),
 			regexp.QuoteMeta(newUser.EmailAddress),
 			regexp.QuoteMeta(newUser.PasswordDigest),
@@ -155,7 +155,7 @@ func TestRepository_ExistsByEmailAddress(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS \(SELECT .* FROM "users" AS "user" WHERE \(email_address = '%s'\)\)`, regexp.QuoteMeta(emailAddress))).
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS \(SELECT .* FROM "users" AS "user" WHERE \(email_address = '%s'\) AND "user"."deleted_at" IS NULL\)`, regexp.QuoteMeta(emailAddress))).
 			WillReturnRows(sqlmock.NewRows([]string{"exists"}).AddRow(true))
 
 		exists, err := repository.ExistsByEmailAddress(ctx, emailAddress)
@@ -174,7 +174,7 @@ func TestRepository_ExistsByEmailAddress(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS \(SELECT .* FROM "users" AS "user" WHERE \(email_address = '%s'\)\)`, regexp.QuoteMeta(emailAddress))).
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT EXISTS \(SELECT .* FROM "users" AS "user" WHERE \(email_address = '%s'\) AND "user"."deleted_at" IS NULL\)`, regexp.QuoteMeta(emailAddress))).
 			WillReturnError(expectedErr)
 
 		exists, err := repository.ExistsByEmailAddress(ctx, emailAddress)
@@ -185,6 +185,333 @@ func TestRepository_ExistsByEmailAddress(t *testing.T) {
 	})
 }
 
+func TestRepository_UpdatePasswordDigestById(t *testing.T) {
+	t.Run("updates password_digest of the user selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET password_digest = 'password-digest' WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdatePasswordDigestById(ctx, userID, "password-digest")
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		expectedErr := errors.New("update user")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET password_digest = .* WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnError(expectedErr)
+
+		err := repository.UpdatePasswordDigestById(ctx, userID, "password-digest")
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateEmailVerifiedAtById(t *testing.T) {
+	t.Run("updates email_verified_at of the user selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		emailVerifiedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET email_verified_at = '2026-10-18 09:00:00\+00:00' WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateEmailVerifiedAtById(ctx, userID, emailVerifiedAt)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		expectedErr := errors.New("update user")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET email_verified_at = .* WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnError(expectedErr)
+
+		err := repository.UpdateEmailVerifiedAtById(ctx, userID, time.Now())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateTotpSecretById(t *testing.T) {
+	t.Run("updates totp_secret of the user selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET totp_secret = 'JBSWY3DPEHPK3PXP' WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateTotpSecretById(ctx, userID, "JBSWY3DPEHPK3PXP")
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		expectedErr := errors.New("update user")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET totp_secret = .* WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnError(expectedErr)
+
+		err := repository.UpdateTotpSecretById(ctx, userID, "JBSWY3DPEHPK3PXP")
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateTotpEnabledAtById(t *testing.T) {
+	t.Run("updates totp_enabled_at of the user selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET totp_enabled_at = '2026-10-18 09:00:00\+00:00' WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateTotpEnabledAtById(ctx, userID, time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC))
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		expectedErr := errors.New("update user")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET totp_enabled_at = .* WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnError(expectedErr)
+
+		err := repository.UpdateTotpEnabledAtById(ctx, userID, time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC))
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateTotpLastUsedStepById(t *testing.T) {
+	t.Run("moves totp_last_used_step forward", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET totp_last_used_step = 42 WHERE \(id = '%s'\) AND \(totp_last_used_step IS NULL OR totp_last_used_step < 42\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		isUpdated, err := repository.UpdateTotpLastUsedStepById(ctx, userID, 42)
+
+		require.NoError(t, err)
+		assert.True(t, isUpdated)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("reports false when the step was already used", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "users" AS "user" SET totp_last_used_step = 42`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		isUpdated, err := repository.UpdateTotpLastUsedStepById(ctx, userID, 42)
+
+		require.NoError(t, err)
+		assert.False(t, isUpdated)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_Update(t *testing.T) {
+	t.Run("updates the user selected by primary key without touching its creation or deletion time", func(t *testing.T) {
+		ctx := context.Background()
+		user := &entity.User{
+			Base:           entity.Base{Id: uuid.New()},
+			Name:           "Ada Byron",
+			EmailAddress:   "ada@example.com",
+			PasswordDigest: "password-digest",
+		}
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(
+			`UPDATE "users" AS "user" SET "updated_at" = DEFAULT, "name" = 'Ada Byron', "email_address" = 'ada@example\.com', "password_digest" = 'password-digest', "email_verified_at" = DEFAULT, "totp_secret" = DEFAULT, "totp_enabled_at" = DEFAULT, "totp_last_used_step" = DEFAULT WHERE "user"\."deleted_at" IS NULL AND \("user"\."id" = '%s'\)`,
+			regexp.QuoteMeta(user.Id.String()),
+		)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.Update(ctx, user)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("update user")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "users"`).
+			WillReturnError(expectedErr)
+
+		err := repository.Update(ctx, &entity.User{Base: entity.Base{Id: uuid.New()}})
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteById(t *testing.T) {
+	t.Run("soft deletes the user selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET "deleted_at" = '[^']+' WHERE \(id = '%s'\) AND "user"\."deleted_at" IS NULL`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.DeleteById(ctx, userID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the delete fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("delete user")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "users"`).
+			WillReturnError(expectedErr)
+
+		err := repository.DeleteById(ctx, uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateNameById(t *testing.T) {
+	t.Run("updates the name of the user selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET name = 'Ada Lovelace' WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateNameById(ctx, userID, "Ada Lovelace")
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateEmailAddressById(t *testing.T) {
+	t.Run("updates the email address and clears its verification", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "users" AS "user" SET email_address = 'ada@example.org', email_verified_at = NULL WHERE \(id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateEmailAddressById(ctx, userID, "ada@example.org")
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_ResetTotpById(t *testing.T) {
+	t.Run("clears the totp columns of the user selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(
+			`UPDATE "users" AS "user" SET totp_secret = NULL, totp_enabled_at = NULL, totp_last_used_step = NULL WHERE \(id = '%s'\)`,
+			regexp.QuoteMeta(userID.String()),
+		)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.ResetTotpById(ctx, userID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
 func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
 	t.Helper()
 
@@ -0,0 +1,64 @@
+package user_recovery_code
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewRepository)
+}
+
+type IRepository interface {
+	CreateMany(ctx context.Context, userRecoveryCodes []*entity.UserRecoveryCode) error
+	UpdateUsedAtByUserIdAndCode(ctx context.Context, userId uuid.UUID, code string, usedAt time.Time) (bool, error)
+	DeleteByUserId(ctx context.Context, userId uuid.UUID) error
+}
+
+type Repository struct {
+	sqlDB dbSql.IDB
+}
+
+var _ IRepository = (*Repository)(nil)
+
+func NewRepository(i do.Injector) (*Repository, error) {
+	return &Repository{
+		sqlDB: do.MustInvoke[*dbSql.PostgresDB](i),
+	}, nil
+}
+
+func (r *Repository) CreateMany(ctx context.Context, userRecoveryCodes []*entity.UserRecoveryCode) error {
+	_, err := r.sqlDB.DB(ctx).NewInsert().Model(&userRecoveryCodes).Exec(ctx)
+	return err
+}
+
+func (r *Repository) UpdateUsedAtByUserIdAndCode(ctx context.Context, userId uuid.UUID, code string, usedAt time.Time) (bool, error) {
+	result, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.UserRecoveryCode{}).
+		Set("used_at = ?", usedAt).
+		Where("user_id = ?", userId).
+		Where("code_digest = ?", util.DigestToken(code)).
+		Where("used_at IS NULL").
+		Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) DeleteByUserId(ctx context.Context, userId uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.UserRecoveryCode{}).Where("user_id = ?", userId).Exec(ctx)
+	return err
+}
@@ -0,0 +1,165 @@
+package user_recovery_code
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
+)
+
+func TestRepository_CreateMany(t *testing.T) {
+	t.Run("inserts every recovery code in a single statement", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		first := &entity.UserRecoveryCode{UserId: userID}
+		first.GenerateCode()
+		second := &entity.UserRecoveryCode{UserId: userID}
+		second.GenerateCode()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(
+			`INSERT INTO "user_recovery_codes" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', DEFAULT\), \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', DEFAULT\) RETURNING`,
+			regexp.QuoteMeta(userID.String()),
+			regexp.QuoteMeta(first.CodeDigest),
+			regexp.QuoteMeta(userID.String()),
+			regexp.QuoteMeta(second.CodeDigest),
+		)).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "created_at", "updated_at", "used_at"}).
+				AddRow(uuid.New().String(), time.Now(), time.Now(), nil).
+				AddRow(uuid.New().String(), time.Now(), time.Now(), nil))
+
+		err := repository.CreateMany(ctx, []*entity.UserRecoveryCode{first, second})
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the insert fails", func(t *testing.T) {
+		ctx := context.Background()
+		userRecoveryCode := &entity.UserRecoveryCode{UserId: uuid.New()}
+		userRecoveryCode.GenerateCode()
+		expectedErr := errors.New("insert user recovery codes")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`INSERT INTO "user_recovery_codes"`).
+			WillReturnError(expectedErr)
+
+		err := repository.CreateMany(ctx, []*entity.UserRecoveryCode{userRecoveryCode})
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_UpdateUsedAtByUserIdAndCode(t *testing.T) {
+	t.Run("returns true when an unused code was consumed", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		code := "abcdefgh-ijklmnop"
+		usedAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(
+			`UPDATE "user_recovery_codes" AS "user_recovery_code" SET used_at = '2026-10-18 09:00:00\+00:00' WHERE \(user_id = '%s'\) AND \(code_digest = '%s'\) AND \(used_at IS NULL\)`,
+			regexp.QuoteMeta(userID.String()),
+			util.DigestToken(code),
+		)).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		consumed, err := repository.UpdateUsedAtByUserIdAndCode(ctx, userID, code, usedAt)
+
+		require.NoError(t, err)
+		assert.True(t, consumed)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns false when no unused code matched", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "user_recovery_codes"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		consumed, err := repository.UpdateUsedAtByUserIdAndCode(ctx, uuid.New(), "abcdefgh-ijklmnop", time.Now())
+
+		require.NoError(t, err)
+		assert.False(t, consumed)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("update user recovery code")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`UPDATE "user_recovery_codes"`).
+			WillReturnError(expectedErr)
+
+		consumed, err := repository.UpdateUsedAtByUserIdAndCode(ctx, uuid.New(), "abcdefgh-ijklmnop", time.Now())
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.False(t, consumed)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByUserId(t *testing.T) {
+	t.Run("deletes every recovery code of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_recovery_codes" AS "user_recovery_code" WHERE \(user_id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 10))
+
+		err := repository.DeleteByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		_ = bunDB.Close()
+	})
+
+	return bunDB, sqlMock
+}
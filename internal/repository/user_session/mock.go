@@ -6,8 +6,10 @@ package user_session
 
 import (
 	"context"
+	"time"
 
 	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -95,6 +97,78 @@ func (_c *MockIRepository_Create_Call) RunAndReturn(run func(ctx context.Context
 	return _c
 }
 
+// DeleteByIdAndUserId provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) DeleteByIdAndUserId(ctx context.Context, id uuid.UUID, userId uuid.UUID) (bool, error) {
+	ret := _mock.Called(ctx, id, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByIdAndUserId")
+	}
+
+	var r0 bool
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) (bool, error)); ok {
+		return returnFunc(ctx, id, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) bool); ok {
+		r0 = returnFunc(ctx, id, userId)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, id, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_DeleteByIdAndUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByIdAndUserId'
+type MockIRepository_DeleteByIdAndUserId_Call struct {
+	*mock.Call
+}
+
+// DeleteByIdAndUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - userId uuid.UUID
+func (_e *MockIRepository_Expecter) DeleteByIdAndUserId(ctx interface{}, id interface{}, userId interface{}) *MockIRepository_DeleteByIdAndUserId_Call {
+	return &MockIRepository_DeleteByIdAndUserId_Call{Call: _e.mock.On("DeleteByIdAndUserId", ctx, id, userId)}
+}
+
+func (_c *MockIRepository_DeleteByIdAndUserId_Call) Run(run func(ctx context.Context, id uuid.UUID, userId uuid.UUID)) *MockIRepository_DeleteByIdAndUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_DeleteByIdAndUserId_Call) Return(b bool, err error) *MockIRepository_DeleteByIdAndUserId_Call {
+	_c.Call.Return(b, err)
+	return _c
+}
+
+func (_c *MockIRepository_DeleteByIdAndUserId_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, userId uuid.UUID) (bool, error)) *MockIRepository_DeleteByIdAndUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // DeleteByToken provides a mock function for the type MockIRepository
 func (_mock *MockIRepository) DeleteByToken(ctx context.Context, token string) error {
 	ret := _mock.Called(ctx, token)
@@ -152,6 +226,194 @@ func (_c *MockIRepository_DeleteByToken_Call) RunAndReturn(run func(ctx context.
 	return _c
 }
 
+// DeleteByUserId provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) DeleteByUserId(ctx context.Context, userId uuid.UUID) error {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserId")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_DeleteByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserId'
+type MockIRepository_DeleteByUserId_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockIRepository_Expecter) DeleteByUserId(ctx interface{}, userId interface{}) *MockIRepository_DeleteByUserId_Call {
+	return &MockIRepository_DeleteByUserId_Call{Call: _e.mock.On("DeleteByUserId", ctx, userId)}
+}
+
+func (_c *MockIRepository_DeleteByUserId_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockIRepository_DeleteByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_DeleteByUserId_Call) Return(err error) *MockIRepository_DeleteByUserId_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_DeleteByUserId_Call) RunAndReturn(run func(ctx context.Context, userId uuid.UUID) error) *MockIRepository_DeleteByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteByUserIdExceptId provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) DeleteByUserIdExceptId(ctx context.Context, userId uuid.UUID, exceptId uuid.UUID) error {
+	ret := _mock.Called(ctx, userId, exceptId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteByUserIdExceptId")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, uuid.UUID) error); ok {
+		r0 = returnFunc(ctx, userId, exceptId)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_DeleteByUserIdExceptId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteByUserIdExceptId'
+type MockIRepository_DeleteByUserIdExceptId_Call struct {
+	*mock.Call
+}
+
+// DeleteByUserIdExceptId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+//   - exceptId uuid.UUID
+func (_e *MockIRepository_Expecter) DeleteByUserIdExceptId(ctx interface{}, userId interface{}, exceptId interface{}) *MockIRepository_DeleteByUserIdExceptId_Call {
+	return &MockIRepository_DeleteByUserIdExceptId_Call{Call: _e.mock.On("DeleteByUserIdExceptId", ctx, userId, exceptId)}
+}
+
+func (_c *MockIRepository_DeleteByUserIdExceptId_Call) Run(run func(ctx context.Context, userId uuid.UUID, exceptId uuid.UUID)) *MockIRepository_DeleteByUserIdExceptId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 uuid.UUID
+		if args[2] != nil {
+			arg2 = args[2].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_DeleteByUserIdExceptId_Call) Return(err error) *MockIRepository_DeleteByUserIdExceptId_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_DeleteByUserIdExceptId_Call) RunAndReturn(run func(ctx context.Context, userId uuid.UUID, exceptId uuid.UUID) error) *MockIRepository_DeleteByUserIdExceptId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// FindAllByUserId provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) FindAllByUserId(ctx context.Context, userId uuid.UUID) ([]*entity.UserSession, error) {
+	ret := _mock.Called(ctx, userId)
+
+	if len(ret) == 0 {
+		panic("no return value specified for FindAllByUserId")
+	}
+
+	var r0 []*entity.UserSession
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) ([]*entity.UserSession, error)); ok {
+		return returnFunc(ctx, userId)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID) []*entity.UserSession); ok {
+		r0 = returnFunc(ctx, userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*entity.UserSession)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, uuid.UUID) error); ok {
+		r1 = returnFunc(ctx, userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIRepository_FindAllByUserId_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'FindAllByUserId'
+type MockIRepository_FindAllByUserId_Call struct {
+	*mock.Call
+}
+
+// FindAllByUserId is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userId uuid.UUID
+func (_e *MockIRepository_Expecter) FindAllByUserId(ctx interface{}, userId interface{}) *MockIRepository_FindAllByUserId_Call {
+	return &MockIRepository_FindAllByUserId_Call{Call: _e.mock.On("FindAllByUserId", ctx, userId)}
+}
+
+func (_c *MockIRepository_FindAllByUserId_Call) Run(run func(ctx context.Context, userId uuid.UUID)) *MockIRepository_FindAllByUserId_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_FindAllByUserId_Call) Return(userSessions []*entity.UserSession, err error) *MockIRepository_FindAllByUserId_Call {
+	_c.Call.Return(userSessions, err)
+	return _c
+}
+
+func (_c *MockIRepository_FindAllByUserId_Call) RunAndReturn(run func(ctx context.Context, userId uuid.UUID) ([]*entity.UserSession, error)) *MockIRepository_FindAllByUserId_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // FindByToken provides a mock function for the type MockIRepository
 func (_mock *MockIRepository) FindByToken(ctx context.Context, token string) (*entity.UserSession, error) {
 	ret := _mock.Called(ctx, token)
@@ -219,3 +481,66 @@ func (_c *MockIRepository_FindByToken_Call) RunAndReturn(run func(ctx context.Co
 	_c.Call.Return(run)
 	return _c
 }
+
+// UpdateLastSeenAtById provides a mock function for the type MockIRepository
+func (_mock *MockIRepository) UpdateLastSeenAtById(ctx context.Context, id uuid.UUID, lastSeenAt time.Time) error {
+	ret := _mock.Called(ctx, id, lastSeenAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateLastSeenAtById")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, uuid.UUID, time.Time) error); ok {
+		r0 = returnFunc(ctx, id, lastSeenAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIRepository_UpdateLastSeenAtById_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateLastSeenAtById'
+type MockIRepository_UpdateLastSeenAtById_Call struct {
+	*mock.Call
+}
+
+// UpdateLastSeenAtById is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id uuid.UUID
+//   - lastSeenAt time.Time
+func (_e *MockIRepository_Expecter) UpdateLastSeenAtById(ctx interface{}, id interface{}, lastSeenAt interface{}) *MockIRepository_UpdateLastSeenAtById_Call {
+	return &MockIRepository_UpdateLastSeenAtById_Call{Call: _e.mock.On("UpdateLastSeenAtById", ctx, id, lastSeenAt)}
+}
+
+func (_c *MockIRepository_UpdateLastSeenAtById_Call) Run(run func(ctx context.Context, id uuid.UUID, lastSeenAt time.Time)) *MockIRepository_UpdateLastSeenAtById_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 uuid.UUID
+		if args[1] != nil {
+			arg1 = args[1].(uuid.UUID)
+		}
+		var arg2 time.Time
+		if args[2] != nil {
+			arg2 = args[2].(time.Time)
+		}
+		run(
+			arg0,
+			arg1,
+			arg2,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIRepository_UpdateLastSeenAtById_Call) Return(err error) *MockIRepository_UpdateLastSeenAtById_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIRepository_UpdateLastSeenAtById_Call) RunAndReturn(run func(ctx context.Context, id uuid.UUID, lastSeenAt time.Time) error) *MockIRepository_UpdateLastSeenAtById_Call {
+	_c.Call.Return(run)
+	return _c
+}
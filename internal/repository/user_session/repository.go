@@ -2,10 +2,13 @@ package user_session
 
 import (
 	"context"
+	"time"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
 	dbSql "github.com/anonychun/bibit/internal/db/sql"
 	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
 	"github.com/samber/do/v2"
 )
 
@@ -15,8 +18,13 @@ func init() {
 
 type IRepository interface {
 	FindByToken(ctx context.Context, token string) (*entity.UserSession, error)
+	FindAllByUserId(ctx context.Context, userId uuid.UUID) ([]*entity.UserSession, error)
 	Create(ctx context.Context, userSession *entity.UserSession) error
+	UpdateLastSeenAtById(ctx context.Context, id uuid.UUID, lastSeenAt time.Time) error
 	DeleteByToken(ctx context.Context, token string) error
+	DeleteByUserId(ctx context.Context, userId uuid.UUID) error
+	DeleteByIdAndUserId(ctx context.Context, id, userId uuid.UUID) (bool, error)
+	DeleteByUserIdExceptId(ctx context.Context, userId, exceptId uuid.UUID) error
 }
 
 type Repository struct {
@@ -33,7 +41,7 @@ func NewRepository(i do.Injector) (*Repository, error) {
 
 func (r *Repository) FindByToken(ctx context.Context, token string) (*entity.UserSession, error) {
 	userSession := &entity.UserSession{}
-	err := r.sqlDB.DB(ctx).NewSelect().Model(userSession).Where("token = ?", token).Limit(1).Scan(ctx)
+	err := r.sqlDB.DB(ctx).NewSelect().Model(userSession).Where("token_digest = ?", util.DigestToken(token)).Limit(1).Scan(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -41,12 +49,51 @@ func (r *Repository) FindByToken(ctx context.Context, token string) (*entity.Use
 	return userSession, nil
 }
 
+func (r *Repository) FindAllByUserId(ctx context.Context, userId uuid.UUID) ([]*entity.UserSession, error) {
+	userSessions := make([]*entity.UserSession, 0)
+	err := r.sqlDB.DB(ctx).NewSelect().Model(&userSessions).Where("user_id = ?", userId).Order("last_seen_at DESC").Scan(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return userSessions, nil
+}
+
 func (r *Repository) Create(ctx context.Context, userSession *entity.UserSession) error {
 	_, err := r.sqlDB.DB(ctx).NewInsert().Model(userSession).Exec(ctx)
 	return err
 }
 
+func (r *Repository) UpdateLastSeenAtById(ctx context.Context, id uuid.UUID, lastSeenAt time.Time) error {
+	_, err := r.sqlDB.DB(ctx).NewUpdate().Model(&entity.UserSession{}).Set("last_seen_at = ?", lastSeenAt).Where("id = ?", id).Exec(ctx)
+	return err
+}
+
 func (r *Repository) DeleteByToken(ctx context.Context, token string) error {
-	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.UserSession{}).Where("token = ?", token).Exec(ctx)
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.UserSession{}).Where("token_digest = ?", util.DigestToken(token)).Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteByUserId(ctx context.Context, userId uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.UserSession{}).Where("user_id = ?", userId).Exec(ctx)
+	return err
+}
+
+func (r *Repository) DeleteByIdAndUserId(ctx context.Context, id, userId uuid.UUID) (bool, error) {
+	result, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.UserSession{}).Where("id = ?", id).Where("user_id = ?", userId).Exec(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *Repository) DeleteByUserIdExceptId(ctx context.Context, userId, exceptId uuid.UUID) error {
+	_, err := r.sqlDB.DB(ctx).NewDelete().Model(&entity.UserSession{}).Where("user_id = ?", userId).Where("id != ?", exceptId).Exec(ctx)
 	return err
 }
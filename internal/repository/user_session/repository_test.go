@@ -11,6 +11,7 @@ import (
 	"github.com/DATA-DOG/go-sqlmock"
 	dbSql "github.com/anonychun/bibit/internal/db/sql"
 	"github.com/anonychun/bibit/internal/entity"
+	"github.com/anonychun/bibit/internal/util"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -29,9 +30,9 @@ func TestRepository_FindByToken(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "user_sessions" AS "user_session" WHERE \(token = '%s'\) LIMIT 1`, regexp.QuoteMeta(token))).
-			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token", "ip_address", "user_agent"}).
-				AddRow(userSessionID.String(), userID.String(), token, "127.0.0.1", "Go test"))
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "user_sessions" AS "user_session" WHERE \(token_digest = '%s'\) LIMIT 1`, util.DigestToken(token))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "token_digest", "ip_address", "user_agent"}).
+				AddRow(userSessionID.String(), userID.String(), util.DigestToken(token), "127.0.0.1", "Go test"))
 
 		actualSession, err := repository.FindByToken(ctx, token)
 
@@ -39,7 +40,8 @@ func TestRepository_FindByToken(t *testing.T) {
 		require.NotNil(t, actualSession)
 		assert.Equal(t, userSessionID, actualSession.Id)
 		assert.Equal(t, userID, actualSession.UserId)
-		assert.Equal(t, token, actualSession.Token)
+		assert.Empty(t, actualSession.Token)
+		assert.Equal(t, util.DigestToken(token), actualSession.TokenDigest)
 		assert.Equal(t, "127.0.0.1", actualSession.IpAddress)
 		assert.Equal(t, "Go test", actualSession.UserAgent)
 		require.NoError(t, sqlMock.ExpectationsWereMet())
@@ -54,7 +56,7 @@ func TestRepository_FindByToken(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "user_sessions" AS "user_session" WHERE \(token = '%s'\) LIMIT 1`, regexp.QuoteMeta(token))).
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "user_sessions" AS "user_session" WHERE \(token_digest = '%s'\) LIMIT 1`, util.DigestToken(token))).
 			WillReturnError(expectedErr)
 
 		actualSession, err := repository.FindByToken(ctx, token)
@@ -65,15 +67,61 @@ func TestRepository_FindByToken(t *testing.T) {
 	})
 }
 
+func TestRepository_FindAllByUserId(t *testing.T) {
+	t.Run("returns the sessions of the user ordered by last activity", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		firstSessionID := uuid.New()
+		secondSessionID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(fmt.Sprintf(`SELECT .* FROM "user_sessions" AS "user_session" WHERE \(user_id = '%s'\) ORDER BY "last_seen_at" DESC`, regexp.QuoteMeta(userID.String()))).
+			WillReturnRows(sqlmock.NewRows([]string{"id", "user_id", "ip_address", "user_agent"}).
+				AddRow(firstSessionID.String(), userID.String(), "127.0.0.1", "Go test").
+				AddRow(secondSessionID.String(), userID.String(), "192.0.2.10", "curl"))
+
+		actualSessions, err := repository.FindAllByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.Len(t, actualSessions, 2)
+		assert.Equal(t, firstSessionID, actualSessions[0].Id)
+		assert.Equal(t, secondSessionID, actualSessions[1].Id)
+		assert.Equal(t, "192.0.2.10", actualSessions[1].IpAddress)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the select fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("select user sessions")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectQuery(`SELECT .* FROM "user_sessions"`).
+			WillReturnError(expectedErr)
+
+		actualSessions, err := repository.FindAllByUserId(ctx, uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, actualSessions)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
 func TestRepository_Create(t *testing.T) {
 	t.Run("inserts the user session", func(t *testing.T) {
 		ctx := context.Background()
 		newSession := &entity.UserSession{
 			UserId:    uuid.New(),
-			Token:     "session-token",
 			IpAddress: "127.0.0.1",
 			UserAgent: "Go test",
 		}
+		newSession.GenerateToken()
+		newSession.SetExpiration(time.Hour)
 		createdAt := time.Now()
 		updatedAt := createdAt.Add(time.Second)
 		bunDB, sqlMock := newMockedBunDB(t)
@@ -82,9 +130,9 @@ func TestRepository_Create(t *testing.T) {
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
 		sqlMock.ExpectQuery(fmt.Sprintf(
-			`INSERT INTO "user_sessions" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', '%s'\) RETURNING`,
+			`INSERT INTO "user_sessions" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', '%s', '[^']+', '[^']+', DEFAULT\) RETURNING`,
 			regexp.QuoteMeta(newSession.UserId.String()),
-			regexp.QuoteMeta(newSession.Token),
+			regexp.QuoteMeta(newSession.TokenDigest),
 			regexp.QuoteMeta(newSession.IpAddress),
 			regexp.QuoteMeta(newSession.UserAgent),
 		)).
@@ -101,10 +149,11 @@ func TestRepository_Create(t *testing.T) {
 		ctx := context.Background()
 		newSession := &entity.UserSession{
 			UserId:    uuid.New(),
-			Token:     "session-token",
 			IpAddress: "127.0.0.1",
 			UserAgent: "Go test",
 		}
+		newSession.GenerateToken()
+		newSession.SetExpiration(time.Hour)
 		expectedErr := errors.New("insert user session")
 		bunDB, sqlMock := newMockedBunDB(t)
 		sqlDB := dbSql.NewMockIDB(t)
@@ -112,9 +161,9 @@ func TestRepository_Create(t *testing.T) {
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
 		sqlMock.ExpectQuery(fmt.Sprintf(
-			`INSERT INTO "user_sessions" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', '%s'\) RETURNING`,
+			`INSERT INTO "user_sessions" .* VALUES \(DEFAULT, DEFAULT, DEFAULT, '%s', '%s', '%s', '%s', '[^']+', '[^']+', DEFAULT\) RETURNING`,
 			regexp.QuoteMeta(newSession.UserId.String()),
-			regexp.QuoteMeta(newSession.Token),
+			regexp.QuoteMeta(newSession.TokenDigest),
 			regexp.QuoteMeta(newSession.IpAddress),
 			regexp.QuoteMeta(newSession.UserAgent),
 		)).
@@ -127,6 +176,44 @@ func TestRepository_Create(t *testing.T) {
 	})
 }
 
+func TestRepository_UpdateLastSeenAtById(t *testing.T) {
+	t.Run("updates last_seen_at of the user session selected by id", func(t *testing.T) {
+		ctx := context.Background()
+		userSessionID := uuid.New()
+		lastSeenAt := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "user_sessions" AS "user_session" SET last_seen_at = '2026-10-18 09:00:00\+00:00' WHERE \(id = '%s'\)`, regexp.QuoteMeta(userSessionID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		err := repository.UpdateLastSeenAtById(ctx, userSessionID, lastSeenAt)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the update fails", func(t *testing.T) {
+		ctx := context.Background()
+		userSessionID := uuid.New()
+		expectedErr := errors.New("update user session")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`UPDATE "user_sessions" AS "user_session" SET last_seen_at = .* WHERE \(id = '%s'\)`, regexp.QuoteMeta(userSessionID.String()))).
+			WillReturnError(expectedErr)
+
+		err := repository.UpdateLastSeenAtById(ctx, userSessionID, time.Now())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
 func TestRepository_DeleteByToken(t *testing.T) {
 	t.Run("deletes the user session by token", func(t *testing.T) {
 		ctx := context.Background()
@@ -136,7 +223,7 @@ func TestRepository_DeleteByToken(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(token = '%s'\)`, regexp.QuoteMeta(token))).
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(token_digest = '%s'\)`, util.DigestToken(token))).
 			WillReturnResult(sqlmock.NewResult(0, 1))
 
 		err := repository.DeleteByToken(ctx, token)
@@ -154,7 +241,7 @@ func TestRepository_DeleteByToken(t *testing.T) {
 		repository := &Repository{sqlDB: sqlDB}
 
 		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
-		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(token = '%s'\)`, regexp.QuoteMeta(token))).
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(token_digest = '%s'\)`, util.DigestToken(token))).
 			WillReturnError(expectedErr)
 
 		err := repository.DeleteByToken(ctx, token)
@@ -164,6 +251,136 @@ func TestRepository_DeleteByToken(t *testing.T) {
 	})
 }
 
+func TestRepository_DeleteByUserId(t *testing.T) {
+	t.Run("deletes every session of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(user_id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 3))
+
+		err := repository.DeleteByUserId(ctx, userID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the delete fails", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		expectedErr := errors.New("delete user sessions")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(user_id = '%s'\)`, regexp.QuoteMeta(userID.String()))).
+			WillReturnError(expectedErr)
+
+		err := repository.DeleteByUserId(ctx, userID)
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByIdAndUserId(t *testing.T) {
+	t.Run("returns true when the session of the user is deleted", func(t *testing.T) {
+		ctx := context.Background()
+		userSessionID := uuid.New()
+		userID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(id = '%s'\) AND \(user_id = '%s'\)`, regexp.QuoteMeta(userSessionID.String()), regexp.QuoteMeta(userID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 1))
+
+		deleted, err := repository.DeleteByIdAndUserId(ctx, userSessionID, userID)
+
+		require.NoError(t, err)
+		assert.True(t, deleted)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns false when the session does not belong to the user", func(t *testing.T) {
+		ctx := context.Background()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`DELETE FROM "user_sessions"`).
+			WillReturnResult(sqlmock.NewResult(0, 0))
+
+		deleted, err := repository.DeleteByIdAndUserId(ctx, uuid.New(), uuid.New())
+
+		require.NoError(t, err)
+		assert.False(t, deleted)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the delete fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("delete user session")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`DELETE FROM "user_sessions"`).
+			WillReturnError(expectedErr)
+
+		deleted, err := repository.DeleteByIdAndUserId(ctx, uuid.New(), uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.False(t, deleted)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
+func TestRepository_DeleteByUserIdExceptId(t *testing.T) {
+	t.Run("deletes every other session of the user", func(t *testing.T) {
+		ctx := context.Background()
+		userID := uuid.New()
+		exceptID := uuid.New()
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(fmt.Sprintf(`DELETE FROM "user_sessions" AS "user_session" WHERE \(user_id = '%s'\) AND \(id != '%s'\)`, regexp.QuoteMeta(userID.String()), regexp.QuoteMeta(exceptID.String()))).
+			WillReturnResult(sqlmock.NewResult(0, 2))
+
+		err := repository.DeleteByUserIdExceptId(ctx, userID, exceptID)
+
+		require.NoError(t, err)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	t.Run("returns an error when the delete fails", func(t *testing.T) {
+		ctx := context.Background()
+		expectedErr := errors.New("delete user sessions")
+		bunDB, sqlMock := newMockedBunDB(t)
+		sqlDB := dbSql.NewMockIDB(t)
+		repository := &Repository{sqlDB: sqlDB}
+
+		sqlDB.EXPECT().DB(ctx).Return(bunDB).Once()
+		sqlMock.ExpectExec(`DELETE FROM "user_sessions"`).
+			WillReturnError(expectedErr)
+
+		err := repository.DeleteByUserIdExceptId(ctx, uuid.New(), uuid.New())
+
+		require.ErrorIs(t, err, expectedErr)
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+}
+
 func newMockedBunDB(t *testing.T) (*bun.DB, sqlmock.Sqlmock) {
 	t.Helper()
 
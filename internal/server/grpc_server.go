@@ -8,8 +8,15 @@ import (
 
 	"github.com/anonychun/bibit/internal/bootstrap"
 	"github.com/anonychun/bibit/internal/config"
+	middlewareAuth "github.com/anonychun/bibit/internal/middleware/auth"
 	"github.com/anonychun/bibit/internal/observability"
+	usecaseApiV1AdminAuditEvent "github.com/anonychun/bibit/internal/usecase/api/v1/admin/audit_event"
+	usecaseApiV1AdminImpersonation "github.com/anonychun/bibit/internal/usecase/api/v1/admin/impersonation"
+	usecaseApiV1AppApiKey "github.com/anonychun/bibit/internal/usecase/api/v1/app/api_key"
 	usecaseApiV1AppAuth "github.com/anonychun/bibit/internal/usecase/api/v1/app/auth"
+	pbApiV1AdminAuditEvent "github.com/anonychun/bibit/pkg/pb/api/v1/admin/audit_event"
+	pbApiV1AdminImpersonation "github.com/anonychun/bibit/pkg/pb/api/v1/admin/impersonation"
+	pbApiV1AppApiKey "github.com/anonychun/bibit/pkg/pb/api/v1/app/api_key"
 	pbApiV1AppAuth "github.com/anonychun/bibit/pkg/pb/api/v1/app/auth"
 	"github.com/samber/do/v2"
 	"google.golang.org/grpc"
@@ -35,8 +42,13 @@ var _ IGrpcServer = (*GrpcServer)(nil)
 func NewGrpcServer(i do.Injector) (*GrpcServer, error) {
 	cfg := do.MustInvoke[*config.Config](i)
 	o11y := do.MustInvoke[*observability.Observability](i)
+	authMiddleware := do.MustInvoke[*middlewareAuth.Middleware](i)
 
-	srv := grpc.NewServer()
+	methods := grpcMethods()
+	srv := grpc.NewServer(
+		grpc.ChainUnaryInterceptor(authMiddleware.UnaryAuthorize(methods)),
+		grpc.ChainStreamInterceptor(authMiddleware.StreamAuthorize(methods)),
+	)
 	registerGrpcHandlers(i, srv)
 	reflection.Register(srv)
 
@@ -70,4 +82,7 @@ func (s *GrpcServer) Shutdown(ctx context.Context) error {
 
 func registerGrpcHandlers(i do.Injector, srv *grpc.Server) {
 	pbApiV1AppAuth.RegisterServiceServer(srv, do.MustInvoke[*usecaseApiV1AppAuth.GrpcHandler](i))
+	pbApiV1AppApiKey.RegisterServiceServer(srv, do.MustInvoke[*usecaseApiV1AppApiKey.GrpcHandler](i))
+	pbApiV1AdminImpersonation.RegisterServiceServer(srv, do.MustInvoke[*usecaseApiV1AdminImpersonation.GrpcHandler](i))
+	pbApiV1AdminAuditEvent.RegisterServiceServer(srv, do.MustInvoke[*usecaseApiV1AdminAuditEvent.GrpcHandler](i))
 }
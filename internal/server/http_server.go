@@ -10,8 +10,14 @@ import (
 	"github.com/anonychun/bibit/internal/bootstrap"
 	"github.com/anonychun/bibit/internal/config"
 	middlewareAuth "github.com/anonychun/bibit/internal/middleware/auth"
+	middlewareCsrf "github.com/anonychun/bibit/internal/middleware/csrf"
 	middlewareLogger "github.com/anonychun/bibit/internal/middleware/logger"
 	"github.com/anonychun/bibit/internal/observability"
+	"github.com/anonychun/bibit/internal/storage"
+	usecaseApiV1AdminAuditEvent "github.com/anonychun/bibit/internal/usecase/api/v1/admin/audit_event"
+	usecaseApiV1AdminImpersonation "github.com/anonychun/bibit/internal/usecase/api/v1/admin/impersonation"
+	usecaseApiV1AppApiKey "github.com/anonychun/bibit/internal/usecase/api/v1/app/api_key"
+	usecaseApiV1AppAttachment "github.com/anonychun/bibit/internal/usecase/api/v1/app/attachment"
 	usecaseApiV1AppAuth "github.com/anonychun/bibit/internal/usecase/api/v1/app/auth"
 	"github.com/labstack/echo/v5"
 	"github.com/samber/do/v2"
@@ -31,9 +37,17 @@ type HttpServer struct {
 	observability observability.IObservability
 
 	authMiddleware   middlewareAuth.IMiddleware
+	csrfMiddleware   middlewareCsrf.IMiddleware
 	loggerMiddleware middlewareLogger.IMiddleware
 
-	apiV1AppAuthHttpHandler usecaseApiV1AppAuth.IHttpHandler
+	apiV1AppAuthHttpHandler       usecaseApiV1AppAuth.IHttpHandler
+	apiV1AppApiKeyHttpHandler     usecaseApiV1AppApiKey.IHttpHandler
+	apiV1AppAttachmentHttpHandler usecaseApiV1AppAttachment.IHttpHandler
+
+	apiV1AdminImpersonationHttpHandler usecaseApiV1AdminImpersonation.IHttpHandler
+	apiV1AdminAuditEventHttpHandler    usecaseApiV1AdminAuditEvent.IHttpHandler
+
+	storageHttpHandler storage.IHttpHandler
 }
 
 var _ IHttpServer = (*HttpServer)(nil)
@@ -58,9 +72,17 @@ func NewHttpServer(i do.Injector) (*HttpServer, error) {
 		observability: o11y,
 
 		authMiddleware:   do.MustInvoke[*middlewareAuth.Middleware](i),
+		csrfMiddleware:   do.MustInvoke[*middlewareCsrf.Middleware](i),
 		loggerMiddleware: do.MustInvoke[*middlewareLogger.Middleware](i),
 
-		apiV1AppAuthHttpHandler: do.MustInvoke[*usecaseApiV1AppAuth.HttpHandler](i),
+		apiV1AppAuthHttpHandler:       do.MustInvoke[*usecaseApiV1AppAuth.HttpHandler](i),
+		apiV1AppApiKeyHttpHandler:     do.MustInvoke[*usecaseApiV1AppApiKey.HttpHandler](i),
+		apiV1AppAttachmentHttpHandler: do.MustInvoke[*usecaseApiV1AppAttachment.HttpHandler](i),
+
+		apiV1AdminImpersonationHttpHandler: do.MustInvoke[*usecaseApiV1AdminImpersonation.HttpHandler](i),
+		apiV1AdminAuditEventHttpHandler:    do.MustInvoke[*usecaseApiV1AdminAuditEvent.HttpHandler](i),
+
+		storageHttpHandler: do.MustInvoke[*storage.HttpHandler](i),
 	}, nil
 }
 
@@ -1,35 +1,95 @@
 package server
 
 import (
+	"github.com/anonychun/bibit/internal/consts"
+	middlewareAuth "github.com/anonychun/bibit/internal/middleware/auth"
+	pbApiV1AdminAuditEvent "github.com/anonychun/bibit/pkg/pb/api/v1/admin/audit_event"
+	pbApiV1AdminImpersonation "github.com/anonychun/bibit/pkg/pb/api/v1/admin/impersonation"
+	pbApiV1AppApiKey "github.com/anonychun/bibit/pkg/pb/api/v1/app/api_key"
+	pbApiV1AppAuth "github.com/anonychun/bibit/pkg/pb/api/v1/app/auth"
 	"github.com/anonychun/bibit/public"
 	"github.com/labstack/echo/v5"
 	"github.com/labstack/echo/v5/middleware"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1"
+	"google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
 func namespace(e *echo.Group, path string, f func(e *echo.Group)) {
 	f(e.Group(path))
 }
 
+func (s *HttpServer) access(e *echo.Group, access middlewareAuth.Access, f func(e *echo.Group)) {
+	f(e.Group("", s.authMiddleware.Authorize(access)))
+}
+
 func (s *HttpServer) routes() error {
 	s.echo.Use(middleware.Recover())
 	s.echo.Use(middleware.RequestID())
 	s.echo.Use(s.loggerMiddleware.RequestLogger)
+	s.echo.Use(s.csrfMiddleware.VerifyOrigin)
 
 	apiRouter := s.echo.Group("/api")
 	namespace(apiRouter, "/v1", func(e *echo.Group) {
 		namespace(e, "/app", func(e *echo.Group) {
-			e.Use(s.authMiddleware.AuthenticateUser)
+			s.access(e, middlewareAuth.AccessPublic, func(e *echo.Group) {
+				e.POST("/auth/signup", s.apiV1AppAuthHttpHandler.SignUp)
+				e.POST("/auth/signin", s.apiV1AppAuthHttpHandler.SignIn)
+				e.POST("/auth/signin/second-factor", s.apiV1AppAuthHttpHandler.CompleteSignIn)
+				e.POST("/auth/oidc/:provider/authorize", s.apiV1AppAuthHttpHandler.StartOidcSignIn)
+				e.POST("/auth/oidc/:provider/callback", s.apiV1AppAuthHttpHandler.CompleteOidcSignIn)
+				e.POST("/auth/password/forgot", s.apiV1AppAuthHttpHandler.RequestPasswordReset)
+				e.POST("/auth/password/reset", s.apiV1AppAuthHttpHandler.ResetPassword)
+				e.POST("/auth/magic-link", s.apiV1AppAuthHttpHandler.RequestMagicLink)
+				e.POST("/auth/magic-link/consume", s.apiV1AppAuthHttpHandler.ConsumeMagicLink)
+				e.POST("/auth/email/verify", s.apiV1AppAuthHttpHandler.VerifyEmailAddress)
+				e.POST("/auth/email/verification", s.apiV1AppAuthHttpHandler.ResendEmailVerification)
+			})
+
+			s.access(e, middlewareAuth.AccessAuthenticated, func(e *echo.Group) {
+				e.POST("/auth/signout", s.apiV1AppAuthHttpHandler.SignOut)
+				e.POST("/auth/totp/enroll", s.apiV1AppAuthHttpHandler.EnrollTotp)
+				e.POST("/auth/totp/confirm", s.apiV1AppAuthHttpHandler.ConfirmTotp)
+				e.GET("/auth/sessions", s.apiV1AppAuthHttpHandler.ListSessions)
+				e.DELETE("/auth/sessions/others", s.apiV1AppAuthHttpHandler.RevokeOtherSessions)
+				e.DELETE("/auth/sessions/:id", s.apiV1AppAuthHttpHandler.RevokeSession)
+				e.PATCH("/auth/profile", s.apiV1AppAuthHttpHandler.UpdateProfile)
+				e.POST("/auth/password/change", s.apiV1AppAuthHttpHandler.ChangePassword)
+				e.POST("/auth/email/change", s.apiV1AppAuthHttpHandler.ChangeEmailAddress)
+				e.DELETE("/auth/account", s.apiV1AppAuthHttpHandler.DeleteAccount)
+				e.GET("/api-keys", s.apiV1AppApiKeyHttpHandler.ListApiKeys)
+				e.DELETE("/api-keys/:id", s.apiV1AppApiKeyHttpHandler.RevokeApiKey)
+				e.POST("/attachments", s.apiV1AppAttachmentHttpHandler.UploadAttachment)
+				e.GET("/attachments/:id", s.apiV1AppAttachmentHttpHandler.GetAttachment)
+				e.POST("/attachments/uploads", s.apiV1AppAttachmentHttpHandler.CreateUpload)
+				e.POST("/attachments/:id/confirm", s.apiV1AppAttachmentHttpHandler.ConfirmUpload)
+			})
 
-			e.POST("/auth/signup", s.apiV1AppAuthHttpHandler.SignUp)
-			e.POST("/auth/signin", s.apiV1AppAuthHttpHandler.SignIn)
-			e.POST("/auth/signout", s.apiV1AppAuthHttpHandler.SignOut)
-			e.GET("/auth/me", s.apiV1AppAuthHttpHandler.Me)
+			s.access(e, middlewareAuth.AccessVerified, func(e *echo.Group) {
+				e.POST("/api-keys", s.apiV1AppApiKeyHttpHandler.CreateApiKey)
+			})
+
+			s.access(e, middlewareAuth.AccessAuthenticated.WithScope(consts.ScopeProfileRead), func(e *echo.Group) {
+				e.GET("/auth/me", s.apiV1AppAuthHttpHandler.Me)
+			})
+		})
+
+		namespace(e, "/admin", func(e *echo.Group) {
+			s.access(e, middlewareAuth.AccessAuthenticated.WithPermission(consts.PermissionUsersImpersonate), func(e *echo.Group) {
+				e.POST("/users/:id/impersonate", s.apiV1AdminImpersonationHttpHandler.StartImpersonation)
+			})
+
+			s.access(e, middlewareAuth.AccessAuthenticated.WithPermission(consts.PermissionAuditEventsRead), func(e *echo.Group) {
+				e.GET("/audit-events", s.apiV1AdminAuditEventHttpHandler.ListAuditEvents)
+			})
 		})
 
 		namespace(e, "/landing", func(e *echo.Group) {
 		})
 	})
 
+	s.echo.GET("/storage/*", s.storageHttpHandler.GetObject)
+	s.echo.PUT("/storage/*", s.storageHttpHandler.PutObject)
+
 	s.echo.StaticFS("/", public.PublicFs)
 	s.echo.GET("/up", func(c *echo.Context) error {
 		return nil
@@ -37,3 +97,28 @@ func (s *HttpServer) routes() error {
 
 	return nil
 }
+
+// grpcMethods declares access for gRPC methods. Methods that are not listed
+// require an authenticated user and reject API keys.
+func grpcMethods() map[string]middlewareAuth.Access {
+	return map[string]middlewareAuth.Access{
+		pbApiV1AppAuth.Service_SignUp_FullMethodName:                        middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_SignIn_FullMethodName:                        middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_CompleteSignIn_FullMethodName:                middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_StartOidcSignIn_FullMethodName:               middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_CompleteOidcSignIn_FullMethodName:            middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_RequestPasswordReset_FullMethodName:          middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_ResetPassword_FullMethodName:                 middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_RequestMagicLink_FullMethodName:              middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_ConsumeMagicLink_FullMethodName:              middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_VerifyEmailAddress_FullMethodName:            middlewareAuth.AccessPublic,
+		pbApiV1AppAuth.Service_ResendEmailVerification_FullMethodName:       middlewareAuth.AccessPublic,
+		pbApiV1AppApiKey.Service_CreateApiKey_FullMethodName:                middlewareAuth.AccessVerified,
+		pbApiV1AppAuth.Service_Me_FullMethodName:                            middlewareAuth.AccessAuthenticated.WithScope(consts.ScopeProfileRead),
+		pbApiV1AdminImpersonation.Service_StartImpersonation_FullMethodName: middlewareAuth.AccessAuthenticated.WithPermission(consts.PermissionUsersImpersonate),
+		pbApiV1AdminAuditEvent.Service_ListAuditEvents_FullMethodName:       middlewareAuth.AccessAuthenticated.WithPermission(consts.PermissionAuditEventsRead),
+
+		grpc_reflection_v1.ServerReflection_ServerReflectionInfo_FullMethodName:      middlewareAuth.AccessPublic,
+		grpc_reflection_v1alpha.ServerReflection_ServerReflectionInfo_FullMethodName: middlewareAuth.AccessPublic,
+	}
+}
@@ -0,0 +1,108 @@
+package storage
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewHttpHandler)
+}
+
+// IHttpHandler serves the signed URLs issued by LocalDriver. Every request
+// is rejected when another driver is configured.
+type IHttpHandler interface {
+	GetObject(c *echo.Context) error
+	PutObject(c *echo.Context) error
+}
+
+type HttpHandler struct {
+	driver *LocalDriver
+}
+
+var _ IHttpHandler = (*HttpHandler)(nil)
+
+func NewHttpHandler(i do.Injector) (*HttpHandler, error) {
+	storage := do.MustInvoke[*Storage](i)
+	driver, _ := storage.driver.(*LocalDriver)
+
+	return &HttpHandler{
+		driver: driver,
+	}, nil
+}
+
+func (h *HttpHandler) GetObject(c *echo.Context) error {
+	if h.driver == nil {
+		return consts.ErrObjectNotFound
+	}
+
+	key := c.Param("*")
+	err := h.driver.Verify(http.MethodGet, key, PutOptions{}, c.QueryParams())
+	if err != nil {
+		return httpError(err)
+	}
+
+	body, err := h.driver.Get(c.Request().Context(), key)
+	if err != nil {
+		return httpError(err)
+	}
+	defer body.Close()
+
+	// The content type is sniffed from the stored bytes rather than taken
+	// from the key, and objects are only ever offered as downloads, so an
+	// uploaded page cannot run in the origin of the app.
+	head := make([]byte, 512)
+	n, err := io.ReadFull(body, head)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return err
+	}
+
+	c.Response().Header().Set("Cache-Control", "private, max-age=0")
+	c.Response().Header().Set(echo.HeaderXContentTypeOptions, "nosniff")
+	c.Response().Header().Set(echo.HeaderContentDisposition, "attachment")
+	return c.Stream(http.StatusOK, http.DetectContentType(head[:n]), io.MultiReader(bytes.NewReader(head[:n]), body))
+}
+
+func (h *HttpHandler) PutObject(c *echo.Context) error {
+	if h.driver == nil {
+		return consts.ErrObjectNotFound
+	}
+
+	key := c.Param("*")
+	opts := PutOptions{
+		ContentType:   c.Request().Header.Get(echo.HeaderContentType),
+		ContentLength: c.Request().ContentLength,
+		Checksum:      c.Request().Header.Get(LocalChecksumHeader),
+	}
+	err := h.driver.Verify(http.MethodPut, key, opts, c.QueryParams())
+	if err != nil {
+		return httpError(err)
+	}
+
+	err = h.driver.Put(c.Request().Context(), key, c.Request().Body, opts)
+	if err != nil {
+		return httpError(err)
+	}
+
+	return c.NoContent(http.StatusOK)
+}
+
+func httpError(err error) error {
+	switch {
+	case errors.Is(err, ErrInvalidSignature):
+		return consts.ErrInvalidSignedUrl
+	case errors.Is(err, ErrChecksumMismatch):
+		return consts.ErrChecksumMismatch
+	case errors.Is(err, ErrObjectNotFound), errors.Is(err, ErrInvalidKey):
+		return consts.ErrObjectNotFound
+	default:
+		return err
+	}
+}
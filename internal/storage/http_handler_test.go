@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpHandler_GetObject(t *testing.T) {
+	t.Run("streams the object for a valid signed url", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		httpHandler := &HttpHandler{driver: driver}
+		require.NoError(t, driver.Put(context.Background(), "avatar", strings.NewReader("\x89PNG\r\n\x1a\nimage bytes"), PutOptions{}))
+
+		getUrl, err := driver.PresignGet(context.Background(), "avatar", time.Minute)
+		require.NoError(t, err)
+		ctx, rec := newStorageContext(t, http.MethodGet, getUrl, "avatar", nil)
+
+		err = httpHandler.GetObject(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.Equal(t, "image/png", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "nosniff", rec.Header().Get(echo.HeaderXContentTypeOptions))
+		assert.Equal(t, "attachment", rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "\x89PNG\r\n\x1a\nimage bytes", rec.Body.String())
+	})
+
+	t.Run("serves the sniffed content type instead of the one implied by the key", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		httpHandler := &HttpHandler{driver: driver}
+		require.NoError(t, driver.Put(context.Background(), "avatar.png", strings.NewReader("plain text"), PutOptions{}))
+
+		getUrl, err := driver.PresignGet(context.Background(), "avatar.png", time.Minute)
+		require.NoError(t, err)
+		ctx, rec := newStorageContext(t, http.MethodGet, getUrl, "avatar.png", nil)
+
+		err = httpHandler.GetObject(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain; charset=utf-8", rec.Header().Get(echo.HeaderContentType))
+		assert.Equal(t, "attachment", rec.Header().Get(echo.HeaderContentDisposition))
+		assert.Equal(t, "plain text", rec.Body.String())
+	})
+
+	t.Run("rejects urls without a valid signature", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		httpHandler := &HttpHandler{driver: driver}
+		ctx, _ := newStorageContext(t, http.MethodGet, "https://example.com/storage/avatar.png?expires=9999999999&signature=invalid", "avatar.png", nil)
+
+		err := httpHandler.GetObject(ctx)
+
+		require.ErrorIs(t, err, consts.ErrInvalidSignedUrl)
+	})
+
+	t.Run("returns not found for missing objects", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		httpHandler := &HttpHandler{driver: driver}
+
+		getUrl, err := driver.PresignGet(context.Background(), "avatar.png", time.Minute)
+		require.NoError(t, err)
+		ctx, _ := newStorageContext(t, http.MethodGet, getUrl, "avatar.png", nil)
+
+		err = httpHandler.GetObject(ctx)
+
+		require.ErrorIs(t, err, consts.ErrObjectNotFound)
+	})
+
+	t.Run("returns not found when another driver is configured", func(t *testing.T) {
+		httpHandler := &HttpHandler{}
+		ctx, _ := newStorageContext(t, http.MethodGet, "https://example.com/storage/avatar.png", "avatar.png", nil)
+
+		err := httpHandler.GetObject(ctx)
+
+		require.ErrorIs(t, err, consts.ErrObjectNotFound)
+	})
+}
+
+func TestHttpHandler_PutObject(t *testing.T) {
+	t.Run("stores the request body for a valid signed url", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		httpHandler := &HttpHandler{driver: driver}
+		opts := PutOptions{ContentType: "image/png", ContentLength: int64(len("image bytes")), Checksum: testChecksum}
+
+		presignedRequest, err := driver.PresignPut(context.Background(), "avatar.png", opts, time.Minute)
+		require.NoError(t, err)
+		ctx, rec := newStorageContext(t, http.MethodPut, presignedRequest.Url, "avatar.png", strings.NewReader("image bytes"))
+		for name, value := range presignedRequest.Headers {
+			ctx.Request().Header.Set(name, value)
+		}
+
+		err = httpHandler.PutObject(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		body, err := driver.Get(context.Background(), "avatar.png")
+		require.NoError(t, err)
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		require.NoError(t, body.Close())
+		assert.Equal(t, "image bytes", string(data))
+	})
+
+	t.Run("rejects uploads with another content type", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		httpHandler := &HttpHandler{driver: driver}
+		opts := PutOptions{ContentType: "image/png", ContentLength: int64(len("<script></script>"))}
+
+		presignedRequest, err := driver.PresignPut(context.Background(), "avatar.png", opts, time.Minute)
+		require.NoError(t, err)
+		ctx, _ := newStorageContext(t, http.MethodPut, presignedRequest.Url, "avatar.png", strings.NewReader("<script></script>"))
+		ctx.Request().Header.Set(echo.HeaderContentType, "text/html")
+
+		err = httpHandler.PutObject(ctx)
+
+		require.ErrorIs(t, err, consts.ErrInvalidSignedUrl)
+		_, err = driver.Stat(context.Background(), "avatar.png")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("rejects bodies that do not match the signed checksum", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		httpHandler := &HttpHandler{driver: driver}
+		opts := PutOptions{ContentType: "image/png", ContentLength: int64(len("other bytes")), Checksum: testChecksum}
+
+		presignedRequest, err := driver.PresignPut(context.Background(), "avatar.png", opts, time.Minute)
+		require.NoError(t, err)
+		ctx, _ := newStorageContext(t, http.MethodPut, presignedRequest.Url, "avatar.png", strings.NewReader("other bytes"))
+		for name, value := range presignedRequest.Headers {
+			ctx.Request().Header.Set(name, value)
+		}
+
+		err = httpHandler.PutObject(ctx)
+
+		require.ErrorIs(t, err, consts.ErrChecksumMismatch)
+		_, err = driver.Stat(context.Background(), "avatar.png")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+}
+
+func newStorageContext(t *testing.T, method, rawUrl, key string, body io.Reader) (*echo.Context, *httptest.ResponseRecorder) {
+	t.Helper()
+
+	parsedUrl, err := url.Parse(rawUrl)
+	require.NoError(t, err)
+
+	req := httptest.NewRequest(method, parsedUrl.RequestURI(), body)
+	rec := httptest.NewRecorder()
+	ctx := echo.New().NewContext(req, rec)
+	ctx.SetPathValues(echo.PathValues{{Name: "*", Value: key}})
+
+	return ctx, rec
+}
@@ -0,0 +1,234 @@
+package storage
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"mime"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// LocalChecksumHeader carries the base64 encoded SHA-256 digest of uploads
+// made through a URL issued by LocalDriver.PresignPut.
+const LocalChecksumHeader = "X-Checksum-Sha256"
+
+// LocalDriver keeps objects under dir and hands out HMAC signed URLs that are
+// served by HttpHandler, so development and single node deployments do not
+// need an S3 compatible service.
+type LocalDriver struct {
+	dir        string
+	baseUrl    string
+	signingKey []byte
+}
+
+var _ IDriver = (*LocalDriver)(nil)
+
+// NewLocalDriver generates a random signing key when signingKey is empty, in
+// which case previously issued URLs stop working after a restart.
+func NewLocalDriver(dir, baseUrl, signingKey string) (*LocalDriver, error) {
+	key := []byte(signingKey)
+	if len(key) == 0 {
+		key = make([]byte, 32)
+		_, err := rand.Read(key)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &LocalDriver{
+		dir:        dir,
+		baseUrl:    baseUrl,
+		signingKey: key,
+	}, nil
+}
+
+func (d *LocalDriver) Put(ctx context.Context, key string, body io.Reader, opts PutOptions) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.MkdirAll(filepath.Dir(path), 0o755)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(path), ".upload-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(file.Name())
+
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(file, hash), body)
+	if err != nil {
+		file.Close()
+		return err
+	}
+
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	if opts.Checksum != "" && opts.Checksum != base64.StdEncoding.EncodeToString(hash.Sum(nil)) {
+		return ErrChecksumMismatch
+	}
+
+	return os.Rename(file.Name(), path)
+}
+
+func (d *LocalDriver) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+
+	return file, err
+}
+
+func (d *LocalDriver) Delete(ctx context.Context, key string) error {
+	path, err := d.path(key)
+	if err != nil {
+		return err
+	}
+
+	err = os.Remove(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	return err
+}
+
+func (d *LocalDriver) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	path, err := d.path(key)
+	if err != nil {
+		return nil, err
+	}
+
+	file, err := os.Open(path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrObjectNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	fileInfo, err := file.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	hash := sha256.New()
+	_, err = io.Copy(hash, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         fileInfo.Size(),
+		ContentType:  localContentType(key),
+		Checksum:     base64.StdEncoding.EncodeToString(hash.Sum(nil)),
+		LastModified: fileInfo.ModTime(),
+	}, nil
+}
+
+func (d *LocalDriver) PresignGet(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
+	return d.signedUrl("GET", key, PutOptions{}, expiresIn)
+}
+
+func (d *LocalDriver) PresignPut(ctx context.Context, key string, opts PutOptions, expiresIn time.Duration) (*PresignedRequest, error) {
+	signedUrl, err := d.signedUrl("PUT", key, opts, expiresIn)
+	if err != nil {
+		return nil, err
+	}
+
+	headers := map[string]string{}
+	if opts.ContentType != "" {
+		headers["Content-Type"] = opts.ContentType
+	}
+	if opts.Checksum != "" {
+		headers[LocalChecksumHeader] = opts.Checksum
+	}
+
+	return &PresignedRequest{Url: signedUrl, Headers: headers}, nil
+}
+
+// Verify checks the expires and signature query parameters of a URL issued by
+// PresignGet or PresignPut. opts must be empty for GET requests.
+func (d *LocalDriver) Verify(method, key string, opts PutOptions, query url.Values) error {
+	expires, err := strconv.ParseInt(query.Get("expires"), 10, 64)
+	if err != nil || !time.Now().Before(time.Unix(expires, 0)) {
+		return ErrInvalidSignature
+	}
+
+	signature, err := base64.RawURLEncoding.DecodeString(query.Get("signature"))
+	if err != nil || !hmac.Equal(signature, d.sign(method, key, opts, expires)) {
+		return ErrInvalidSignature
+	}
+
+	return nil
+}
+
+func (d *LocalDriver) signedUrl(method, key string, opts PutOptions, expiresIn time.Duration) (string, error) {
+	_, err := d.path(key)
+	if err != nil {
+		return "", err
+	}
+
+	objectUrl, err := url.Parse(d.baseUrl)
+	if err != nil {
+		return "", err
+	}
+
+	expires := time.Now().Add(expiresIn).Unix()
+	objectUrl = objectUrl.JoinPath("storage", key)
+	objectUrl.RawQuery = url.Values{
+		"expires":   {strconv.FormatInt(expires, 10)},
+		"signature": {base64.RawURLEncoding.EncodeToString(d.sign(method, key, opts, expires))},
+	}.Encode()
+
+	return objectUrl.String(), nil
+}
+
+func (d *LocalDriver) sign(method, key string, opts PutOptions, expires int64) []byte {
+	mac := hmac.New(sha256.New, d.signingKey)
+	fmt.Fprintf(mac, "%s\n%s\n%s\n%d\n%s\n%d", method, key, opts.ContentType, opts.ContentLength, opts.Checksum, expires)
+	return mac.Sum(nil)
+}
+
+func (d *LocalDriver) path(key string) (string, error) {
+	path := filepath.FromSlash(key)
+	if !filepath.IsLocal(path) {
+		return "", ErrInvalidKey
+	}
+
+	return filepath.Join(d.dir, path), nil
+}
+
+func localContentType(key string) string {
+	contentType := mime.TypeByExtension(filepath.Ext(key))
+	if contentType == "" {
+		return "application/octet-stream"
+	}
+
+	return contentType
+}
@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalDriver(t *testing.T) {
+	t.Run("puts, stats, gets and deletes objects on disk", func(t *testing.T) {
+		ctx := context.Background()
+		dir := t.TempDir()
+		driver := newTestLocalDriver(t, dir)
+
+		err := driver.Put(ctx, "avatars/avatar.png", strings.NewReader("image bytes"), PutOptions{ContentType: "image/png"})
+		require.NoError(t, err)
+
+		data, err := os.ReadFile(filepath.Join(dir, "avatars", "avatar.png"))
+		require.NoError(t, err)
+		assert.Equal(t, "image bytes", string(data))
+
+		objectInfo, err := driver.Stat(ctx, "avatars/avatar.png")
+		require.NoError(t, err)
+		assert.Equal(t, "avatars/avatar.png", objectInfo.Key)
+		assert.Equal(t, int64(len("image bytes")), objectInfo.Size)
+		assert.Equal(t, "image/png", objectInfo.ContentType)
+		assert.Equal(t, testChecksum, objectInfo.Checksum)
+
+		body, err := driver.Get(ctx, "avatars/avatar.png")
+		require.NoError(t, err)
+		data, err = io.ReadAll(body)
+		require.NoError(t, err)
+		require.NoError(t, body.Close())
+		assert.Equal(t, "image bytes", string(data))
+
+		require.NoError(t, driver.Delete(ctx, "avatars/avatar.png"))
+		require.NoError(t, driver.Delete(ctx, "avatars/avatar.png"))
+
+		_, err = driver.Stat(ctx, "avatars/avatar.png")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		_, err = driver.Get(ctx, "avatars/avatar.png")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("rejects bodies that do not match the checksum", func(t *testing.T) {
+		ctx := context.Background()
+		driver := newTestLocalDriver(t, t.TempDir())
+
+		err := driver.Put(ctx, "avatar.png", strings.NewReader("other bytes"), PutOptions{Checksum: testChecksum})
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+
+		_, err = driver.Stat(ctx, "avatar.png")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("rejects keys outside of the storage directory", func(t *testing.T) {
+		ctx := context.Background()
+		driver := newTestLocalDriver(t, t.TempDir())
+
+		err := driver.Put(ctx, "../escape.txt", strings.NewReader("data"), PutOptions{})
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = driver.Get(ctx, "/etc/passwd")
+		require.ErrorIs(t, err, ErrInvalidKey)
+
+		_, err = driver.PresignGet(ctx, "../escape.txt", time.Minute)
+		require.ErrorIs(t, err, ErrInvalidKey)
+	})
+}
+
+func TestLocalDriver_Verify(t *testing.T) {
+	t.Run("accepts urls signed for the same method, key and content type", func(t *testing.T) {
+		ctx := context.Background()
+		driver := newTestLocalDriver(t, t.TempDir())
+
+		getUrl, err := driver.PresignGet(ctx, "avatar.png", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(getUrl, "https://example.com/storage/avatar.png?"))
+		assert.NoError(t, driver.Verify("GET", "avatar.png", PutOptions{}, signedQuery(t, getUrl)))
+
+		opts := PutOptions{ContentType: "image/png", ContentLength: 11, Checksum: testChecksum}
+		presignedRequest, err := driver.PresignPut(ctx, "avatar.png", opts, time.Minute)
+		require.NoError(t, err)
+		assert.Equal(t, map[string]string{"Content-Type": "image/png", LocalChecksumHeader: testChecksum}, presignedRequest.Headers)
+		assert.NoError(t, driver.Verify("PUT", "avatar.png", opts, signedQuery(t, presignedRequest.Url)))
+	})
+
+	t.Run("rejects urls used for another method, key, content type, length or checksum", func(t *testing.T) {
+		ctx := context.Background()
+		driver := newTestLocalDriver(t, t.TempDir())
+		opts := PutOptions{ContentType: "image/png", ContentLength: 11, Checksum: testChecksum}
+
+		presignedRequest, err := driver.PresignPut(ctx, "avatar.png", opts, time.Minute)
+		require.NoError(t, err)
+		query := signedQuery(t, presignedRequest.Url)
+
+		assert.ErrorIs(t, driver.Verify("GET", "avatar.png", PutOptions{}, query), ErrInvalidSignature)
+		assert.ErrorIs(t, driver.Verify("PUT", "other.png", opts, query), ErrInvalidSignature)
+		assert.ErrorIs(t, driver.Verify("PUT", "avatar.png", PutOptions{ContentType: "text/html", ContentLength: 11, Checksum: testChecksum}, query), ErrInvalidSignature)
+		assert.ErrorIs(t, driver.Verify("PUT", "avatar.png", PutOptions{ContentType: "image/png", ContentLength: 12, Checksum: testChecksum}, query), ErrInvalidSignature)
+		assert.ErrorIs(t, driver.Verify("PUT", "avatar.png", PutOptions{ContentType: "image/png", ContentLength: 11}, query), ErrInvalidSignature)
+	})
+
+	t.Run("rejects expired urls", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+
+		getUrl, err := driver.PresignGet(context.Background(), "avatar.png", -time.Minute)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, driver.Verify("GET", "avatar.png", PutOptions{}, signedQuery(t, getUrl)), ErrInvalidSignature)
+	})
+
+	t.Run("rejects urls signed with another key", func(t *testing.T) {
+		driver := newTestLocalDriver(t, t.TempDir())
+		otherDriver, err := NewLocalDriver(t.TempDir(), "https://example.com", "")
+		require.NoError(t, err)
+
+		getUrl, err := otherDriver.PresignGet(context.Background(), "avatar.png", time.Minute)
+		require.NoError(t, err)
+
+		assert.ErrorIs(t, driver.Verify("GET", "avatar.png", PutOptions{}, signedQuery(t, getUrl)), ErrInvalidSignature)
+	})
+}
+
+// testChecksum is the base64 encoded SHA-256 digest of "image bytes".
+var testChecksum = func() string {
+	digest := sha256.Sum256([]byte("image bytes"))
+	return base64.StdEncoding.EncodeToString(digest[:])
+}()
+
+func newTestLocalDriver(t *testing.T, dir string) *LocalDriver {
+	t.Helper()
+
+	driver, err := NewLocalDriver(dir, "https://example.com", "signing-key")
+	require.NoError(t, err)
+
+	return driver
+}
+
+func signedQuery(t *testing.T, rawUrl string) url.Values {
+	t.Helper()
+
+	parsedUrl, err := url.Parse(rawUrl)
+	require.NoError(t, err)
+
+	return parsedUrl.Query()
+}
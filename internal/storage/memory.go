@@ -0,0 +1,114 @@
+package storage
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"io"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// MemoryDriver keeps objects in memory and is meant for tests. Its presigned
+// URLs use the memory scheme and cannot be fetched.
+type MemoryDriver struct {
+	mu      sync.Mutex
+	objects map[string]memoryObject
+}
+
+type memoryObject struct {
+	data         []byte
+	contentType  string
+	checksum     string
+	lastModified time.Time
+}
+
+var _ IDriver = (*MemoryDriver)(nil)
+
+func NewMemoryDriver() *MemoryDriver {
+	return &MemoryDriver{objects: map[string]memoryObject{}}
+}
+
+func (d *MemoryDriver) Put(ctx context.Context, key string, body io.Reader, opts PutOptions) error {
+	data, err := io.ReadAll(body)
+	if err != nil {
+		return err
+	}
+
+	digest := sha256.Sum256(data)
+	checksum := base64.StdEncoding.EncodeToString(digest[:])
+	if opts.Checksum != "" && opts.Checksum != checksum {
+		return ErrChecksumMismatch
+	}
+
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.objects[key] = memoryObject{
+		data:         data,
+		contentType:  opts.ContentType,
+		checksum:     checksum,
+		lastModified: time.Now(),
+	}
+
+	return nil
+}
+
+func (d *MemoryDriver) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	object, ok := d.objects[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+
+	return io.NopCloser(bytes.NewReader(object.data)), nil
+}
+
+func (d *MemoryDriver) Delete(ctx context.Context, key string) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	delete(d.objects, key)
+	return nil
+}
+
+func (d *MemoryDriver) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	object, ok := d.objects[key]
+	if !ok {
+		return nil, ErrObjectNotFound
+	}
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         int64(len(object.data)),
+		ContentType:  object.contentType,
+		Checksum:     object.checksum,
+		LastModified: object.lastModified,
+	}, nil
+}
+
+func (d *MemoryDriver) PresignGet(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
+	return memoryUrl("GET", key, expiresIn), nil
+}
+
+func (d *MemoryDriver) PresignPut(ctx context.Context, key string, opts PutOptions, expiresIn time.Duration) (*PresignedRequest, error) {
+	return &PresignedRequest{Url: memoryUrl("PUT", key, expiresIn), Headers: map[string]string{}}, nil
+}
+
+func memoryUrl(method, key string, expiresIn time.Duration) string {
+	objectUrl := &url.URL{Scheme: "memory", Path: "/" + key}
+	objectUrl.RawQuery = url.Values{
+		"method":  {method},
+		"expires": {strconv.FormatInt(time.Now().Add(expiresIn).Unix(), 10)},
+	}.Encode()
+
+	return objectUrl.String()
+}
@@ -0,0 +1,63 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMemoryDriver(t *testing.T) {
+	t.Run("puts, stats, gets and deletes objects in memory", func(t *testing.T) {
+		ctx := context.Background()
+		driver := NewMemoryDriver()
+
+		err := driver.Put(ctx, "avatar.png", strings.NewReader("image bytes"), PutOptions{ContentType: "image/png"})
+		require.NoError(t, err)
+
+		objectInfo, err := driver.Stat(ctx, "avatar.png")
+		require.NoError(t, err)
+		assert.Equal(t, int64(len("image bytes")), objectInfo.Size)
+		assert.Equal(t, "image/png", objectInfo.ContentType)
+		assert.Equal(t, testChecksum, objectInfo.Checksum)
+
+		body, err := driver.Get(ctx, "avatar.png")
+		require.NoError(t, err)
+		data, err := io.ReadAll(body)
+		require.NoError(t, err)
+		assert.Equal(t, "image bytes", string(data))
+
+		require.NoError(t, driver.Delete(ctx, "avatar.png"))
+
+		_, err = driver.Stat(ctx, "avatar.png")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+
+		_, err = driver.Get(ctx, "avatar.png")
+		require.ErrorIs(t, err, ErrObjectNotFound)
+	})
+
+	t.Run("returns memory urls for presigned requests", func(t *testing.T) {
+		driver := NewMemoryDriver()
+
+		getUrl, err := driver.PresignGet(context.Background(), "avatar.png", time.Minute)
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(getUrl, "memory:///avatar.png?"))
+		assert.Contains(t, getUrl, "method=GET")
+
+		presignedRequest, err := driver.PresignPut(context.Background(), "avatar.png", PutOptions{}, time.Minute)
+		require.NoError(t, err)
+		assert.Contains(t, presignedRequest.Url, "method=PUT")
+	})
+
+	t.Run("rejects bodies that do not match the checksum", func(t *testing.T) {
+		driver := NewMemoryDriver()
+
+		err := driver.Put(context.Background(), "avatar.png", strings.NewReader("other bytes"), PutOptions{Checksum: testChecksum})
+
+		require.ErrorIs(t, err, ErrChecksumMismatch)
+	})
+}
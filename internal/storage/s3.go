@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsConfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+	"github.com/aws/aws-sdk-go-v2/service/s3/types"
+)
+
+type S3Driver struct {
+	client        *s3.Client
+	presignClient *s3.PresignClient
+	bucket        string
+}
+
+var _ IDriver = (*S3Driver)(nil)
+
+func NewS3Driver(endpoint, bucket, accessKeyId, secretAccessKey string) (*S3Driver, error) {
+	awsCfg, err := awsConfig.LoadDefaultConfig(
+		context.Background(),
+		awsConfig.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(accessKeyId, secretAccessKey, "")),
+		awsConfig.WithRegion("auto"),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	client := s3.NewFromConfig(awsCfg, func(o *s3.Options) {
+		o.BaseEndpoint = aws.String(endpoint)
+	})
+
+	return &S3Driver{
+		client:        client,
+		presignClient: s3.NewPresignClient(client),
+		bucket:        bucket,
+	}, nil
+}
+
+func (d *S3Driver) Put(ctx context.Context, key string, body io.Reader, opts PutOptions) error {
+	_, err := d.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:         aws.String(d.bucket),
+		Key:            aws.String(key),
+		Body:           body,
+		ContentType:    optionalString(opts.ContentType),
+		ChecksumSHA256: optionalString(opts.Checksum),
+	})
+	return err
+}
+
+func (d *S3Driver) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := d.client.GetObject(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, s3Error(err)
+	}
+
+	return output.Body, nil
+}
+
+func (d *S3Driver) Delete(ctx context.Context, key string) error {
+	_, err := d.client.DeleteObject(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(d.bucket),
+		Key:    aws.String(key),
+	})
+	return err
+}
+
+func (d *S3Driver) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	output, err := d.client.HeadObject(ctx, &s3.HeadObjectInput{
+		Bucket:       aws.String(d.bucket),
+		Key:          aws.String(key),
+		ChecksumMode: types.ChecksumModeEnabled,
+	})
+	if err != nil {
+		return nil, s3Error(err)
+	}
+
+	return &ObjectInfo{
+		Key:          key,
+		Size:         aws.ToInt64(output.ContentLength),
+		ContentType:  aws.ToString(output.ContentType),
+		Checksum:     aws.ToString(output.ChecksumSHA256),
+		LastModified: aws.ToTime(output.LastModified),
+	}, nil
+}
+
+// PresignGet overrides the stored content type, which the uploading client
+// chose, and serves the object as a download so browsers never render it,
+// like the local driver does.
+func (d *S3Driver) PresignGet(ctx context.Context, key string, expiresIn time.Duration) (string, error) {
+	request, err := d.presignClient.PresignGetObject(ctx, &s3.GetObjectInput{
+		Bucket:                     aws.String(d.bucket),
+		Key:                        aws.String(key),
+		ResponseContentType:        aws.String("application/octet-stream"),
+		ResponseContentDisposition: aws.String("attachment"),
+	}, s3.WithPresignExpires(expiresIn))
+	if err != nil {
+		return "", err
+	}
+
+	return request.URL, nil
+}
+
+func (d *S3Driver) PresignPut(ctx context.Context, key string, opts PutOptions, expiresIn time.Duration) (*PresignedRequest, error) {
+	input := &s3.PutObjectInput{
+		Bucket:         aws.String(d.bucket),
+		Key:            aws.String(key),
+		ContentType:    optionalString(opts.ContentType),
+		ChecksumSHA256: optionalString(opts.Checksum),
+	}
+	if opts.ContentLength > 0 {
+		input.ContentLength = aws.Int64(opts.ContentLength)
+	}
+
+	request, err := d.presignClient.PresignPutObject(ctx, input, s3.WithPresignExpires(expiresIn))
+	if err != nil {
+		return nil, err
+	}
+
+	// Host and Content-Length are set by the HTTP client from the URL and body.
+	headers := map[string]string{}
+	for name, values := range request.SignedHeader {
+		if len(values) == 0 || strings.EqualFold(name, "Host") || strings.EqualFold(name, "Content-Length") {
+			continue
+		}
+
+		headers[name] = values[0]
+	}
+
+	return &PresignedRequest{Url: request.URL, Headers: headers}, nil
+}
+
+func s3Error(err error) error {
+	var noSuchKey *types.NoSuchKey
+	var notFound *types.NotFound
+	if errors.As(err, &noSuchKey) || errors.As(err, &notFound) {
+		return ErrObjectNotFound
+	}
+
+	return err
+}
+
+func optionalString(s string) *string {
+	if s == "" {
+		return nil
+	}
+
+	return aws.String(s)
+}
@@ -0,0 +1,26 @@
+package storage
+
+import (
+	"context"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestS3Driver_PresignGet(t *testing.T) {
+	t.Run("serves objects as downloads regardless of their stored content type", func(t *testing.T) {
+		driver, err := NewS3Driver("https://s3.example.com", "bibit", "access-key-id", "secret-access-key")
+		require.NoError(t, err)
+
+		getUrl, err := driver.PresignGet(context.Background(), "avatar.svg", time.Minute)
+		require.NoError(t, err)
+
+		parsedUrl, err := url.Parse(getUrl)
+		require.NoError(t, err)
+		assert.Equal(t, "application/octet-stream", parsedUrl.Query().Get("response-content-type"))
+		assert.Equal(t, "attachment", parsedUrl.Query().Get("response-content-disposition"))
+	})
+}
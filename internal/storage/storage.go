@@ -0,0 +1,158 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewStorage)
+}
+
+var (
+	ErrObjectNotFound   = errors.New("storage: object not found")
+	ErrInvalidKey       = errors.New("storage: invalid object key")
+	ErrInvalidSignature = errors.New("storage: invalid or expired signature")
+	ErrChecksumMismatch = errors.New("storage: checksum does not match")
+)
+
+const defaultUrlExpiration = 15 * time.Minute
+
+// PutOptions describes an object being written. Checksum is the base64
+// encoded SHA-256 digest of the body; when it is set, drivers reject bodies
+// with a different digest.
+type PutOptions struct {
+	ContentType   string
+	ContentLength int64
+	Checksum      string
+}
+
+type ObjectInfo struct {
+	Key          string
+	Size         int64
+	ContentType  string
+	Checksum     string
+	LastModified time.Time
+}
+
+// PresignedRequest is a URL that can be used without credentials together
+// with the headers the client has to send along with it.
+type PresignedRequest struct {
+	Url     string
+	Headers map[string]string
+}
+
+type IStorage interface {
+	Put(ctx context.Context, key string, body io.Reader, opts PutOptions) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	PresignGet(ctx context.Context, key string) (string, error)
+	PresignPut(ctx context.Context, key string, opts PutOptions) (*PresignedRequest, error)
+}
+
+type IDriver interface {
+	Put(ctx context.Context, key string, body io.Reader, opts PutOptions) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	Stat(ctx context.Context, key string) (*ObjectInfo, error)
+	PresignGet(ctx context.Context, key string, expiresIn time.Duration) (string, error)
+	PresignPut(ctx context.Context, key string, opts PutOptions, expiresIn time.Duration) (*PresignedRequest, error)
+}
+
+type Storage struct {
+	driver        IDriver
+	urlExpiration time.Duration
+}
+
+var _ IStorage = (*Storage)(nil)
+
+func NewStorage(i do.Injector) (*Storage, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+
+	// Deployments from before the driver setting existed only configured S3,
+	// so they keep using it.
+	driverName := cfg.Storage.Driver
+	if driverName == "" && cfg.Storage.S3.Bucket != "" {
+		driverName = "s3"
+	}
+
+	var driver IDriver
+	switch driverName {
+	case "s3":
+		s3Driver, err := NewS3Driver(cfg.Storage.S3.Endpoint, cfg.Storage.S3.Bucket, cfg.Storage.S3.AccessKeyId, cfg.Storage.S3.SecretAccessKey)
+		if err != nil {
+			return nil, err
+		}
+
+		driver = s3Driver
+	case "local":
+		baseUrl := cfg.Storage.Local.Url
+		if baseUrl == "" {
+			baseUrl = cfg.App.Url
+		}
+
+		localDriver, err := NewLocalDriver(cfg.Storage.Local.Dir, baseUrl, cfg.Storage.Local.SigningKey)
+		if err != nil {
+			return nil, err
+		}
+
+		driver = localDriver
+	case "memory":
+		driver = NewMemoryDriver()
+	case "":
+		return nil, errors.New("storage driver is not configured")
+	default:
+		return nil, fmt.Errorf("unknown storage driver %q", cfg.Storage.Driver)
+	}
+
+	// The expiration used to be an S3 setting, so deployments that still set
+	// it keep their configured lifetime.
+	urlExpiration := cfg.Storage.UrlExpiration
+	if urlExpiration == 0 {
+		urlExpiration = cfg.Storage.S3.UrlExpiration
+	}
+	if urlExpiration == 0 {
+		urlExpiration = defaultUrlExpiration
+	}
+
+	return newStorage(driver, urlExpiration), nil
+}
+
+func newStorage(driver IDriver, urlExpiration time.Duration) *Storage {
+	return &Storage{
+		driver:        driver,
+		urlExpiration: urlExpiration,
+	}
+}
+
+func (s *Storage) Put(ctx context.Context, key string, body io.Reader, opts PutOptions) error {
+	return s.driver.Put(ctx, key, body, opts)
+}
+
+func (s *Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	return s.driver.Get(ctx, key)
+}
+
+func (s *Storage) Delete(ctx context.Context, key string) error {
+	return s.driver.Delete(ctx, key)
+}
+
+func (s *Storage) Stat(ctx context.Context, key string) (*ObjectInfo, error) {
+	return s.driver.Stat(ctx, key)
+}
+
+func (s *Storage) PresignGet(ctx context.Context, key string) (string, error) {
+	return s.driver.PresignGet(ctx, key, s.urlExpiration)
+}
+
+func (s *Storage) PresignPut(ctx context.Context, key string, opts PutOptions) (*PresignedRequest, error) {
+	return s.driver.PresignPut(ctx, key, opts, s.urlExpiration)
+}
@@ -0,0 +1,137 @@
+package storage
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStorage(t *testing.T) {
+	t.Run("selects the configured driver", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Storage.Driver = "memory"
+
+		storage, err := NewStorage(newTestInjector(cfg))
+
+		require.NoError(t, err)
+		assert.IsType(t, &MemoryDriver{}, storage.driver)
+	})
+
+	t.Run("falls back to the app url for local driver urls", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.App.Url = "https://example.com"
+		cfg.Storage.Driver = "local"
+		cfg.Storage.Local.Dir = t.TempDir()
+
+		storage, err := NewStorage(newTestInjector(cfg))
+
+		require.NoError(t, err)
+		require.IsType(t, &LocalDriver{}, storage.driver)
+		assert.Equal(t, "https://example.com", storage.driver.(*LocalDriver).baseUrl)
+	})
+
+	t.Run("selects the s3 driver when only s3 is configured", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Storage.S3.Endpoint = "https://s3.example.com"
+		cfg.Storage.S3.Bucket = "bibit"
+
+		storage, err := NewStorage(newTestInjector(cfg))
+
+		require.NoError(t, err)
+		assert.IsType(t, &S3Driver{}, storage.driver)
+	})
+
+	t.Run("uses the configured url expiration", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Storage.Driver = "memory"
+		cfg.Storage.UrlExpiration = 5 * time.Minute
+		cfg.Storage.S3.UrlExpiration = time.Hour
+
+		storage, err := NewStorage(newTestInjector(cfg))
+
+		require.NoError(t, err)
+		assert.Equal(t, 5*time.Minute, storage.urlExpiration)
+	})
+
+	t.Run("falls back to the deprecated s3 url expiration", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Storage.Driver = "memory"
+		cfg.Storage.S3.UrlExpiration = time.Hour
+
+		storage, err := NewStorage(newTestInjector(cfg))
+
+		require.NoError(t, err)
+		assert.Equal(t, time.Hour, storage.urlExpiration)
+	})
+
+	t.Run("defaults the url expiration", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Storage.Driver = "memory"
+
+		storage, err := NewStorage(newTestInjector(cfg))
+
+		require.NoError(t, err)
+		assert.Equal(t, 15*time.Minute, storage.urlExpiration)
+	})
+
+	t.Run("returns an error when no driver is configured", func(t *testing.T) {
+		cfg := &config.Config{}
+
+		_, err := NewStorage(newTestInjector(cfg))
+
+		require.ErrorContains(t, err, "storage driver is not configured")
+	})
+
+	t.Run("returns an error for unknown drivers", func(t *testing.T) {
+		cfg := &config.Config{}
+		cfg.Storage.Driver = "ftp"
+
+		_, err := NewStorage(newTestInjector(cfg))
+
+		require.ErrorContains(t, err, `unknown storage driver "ftp"`)
+	})
+}
+
+func TestStorage_PresignGet(t *testing.T) {
+	t.Run("signs urls with the configured expiration", func(t *testing.T) {
+		ctx := context.Background()
+		driver := NewMockIDriver(t)
+		storage := newStorage(driver, 10*time.Minute)
+
+		driver.EXPECT().PresignGet(ctx, "avatar.png", 10*time.Minute).Return("https://example.com/avatar.png", nil).Once()
+
+		url, err := storage.PresignGet(ctx, "avatar.png")
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/avatar.png", url)
+	})
+}
+
+func TestStorage_PresignPut(t *testing.T) {
+	t.Run("signs urls with the configured expiration", func(t *testing.T) {
+		ctx := context.Background()
+		driver := NewMockIDriver(t)
+		storage := newStorage(driver, 10*time.Minute)
+		opts := PutOptions{ContentType: "image/png"}
+
+		presignedRequest := &PresignedRequest{Url: "https://example.com/avatar.png", Headers: map[string]string{"Content-Type": "image/png"}}
+
+		driver.EXPECT().PresignPut(ctx, "avatar.png", opts, 10*time.Minute).Return(presignedRequest, nil).Once()
+
+		res, err := storage.PresignPut(ctx, "avatar.png", opts)
+
+		require.NoError(t, err)
+		assert.Equal(t, presignedRequest, res)
+	})
+}
+
+func newTestInjector(cfg *config.Config) do.Injector {
+	i := do.New()
+	do.ProvideValue(i, cfg)
+	return i
+}
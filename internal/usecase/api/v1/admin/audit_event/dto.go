@@ -0,0 +1,38 @@
+package audit_event
+
+import (
+	"time"
+
+	"github.com/anonychun/bibit/internal/dto"
+	"github.com/anonychun/bibit/internal/entity"
+	"github.com/google/uuid"
+)
+
+type ListAuditEventsRequest struct {
+	Page       int       `query:"page"`
+	PerPage    int       `query:"perPage" validate:"max:100" field:"perPage" label:"Per page"`
+	Action     string    `query:"action"`
+	ActorId    uuid.UUID `query:"actorId"`
+	TargetType string    `query:"targetType"`
+	TargetId   uuid.UUID `query:"targetId"`
+}
+
+type AuditEventResponse struct {
+	Id             uuid.UUID                          `json:"id"`
+	Action         string                             `json:"action"`
+	ActorId        *uuid.UUID                         `json:"actorId"`
+	ImpersonatorId *uuid.UUID                         `json:"impersonatorId"`
+	TargetType     string                             `json:"targetType"`
+	TargetId       *uuid.UUID                         `json:"targetId"`
+	IpAddress      string                             `json:"ipAddress"`
+	UserAgent      string                             `json:"userAgent"`
+	RequestId      string                             `json:"requestId"`
+	Changes        map[string]entity.AuditEventChange `json:"changes"`
+	Metadata       map[string]any                     `json:"metadata"`
+	CreatedAt      time.Time                          `json:"createdAt"`
+}
+
+type ListAuditEventsResponse struct {
+	AuditEvents []AuditEventResponse `json:"auditEvents"`
+	Pagination  dto.Pagination       `json:"pagination"`
+}
@@ -0,0 +1,114 @@
+package audit_event
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	pb "github.com/anonychun/bibit/pkg/pb/api/v1/admin/audit_event"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewGrpcHandler)
+}
+
+type IGrpcHandler interface {
+	pb.ServiceServer
+}
+
+type GrpcHandler struct {
+	pb.UnimplementedServiceServer
+	usecase IUsecase
+}
+
+var _ IGrpcHandler = (*GrpcHandler)(nil)
+
+func NewGrpcHandler(i do.Injector) (*GrpcHandler, error) {
+	return &GrpcHandler{
+		usecase: do.MustInvoke[*Usecase](i),
+	}, nil
+}
+
+func (h *GrpcHandler) ListAuditEvents(ctx context.Context, req *pb.ListAuditEventsRequest) (*pb.ListAuditEventsResponse, error) {
+	actorId, err := parseOptionalId(req.GetActorId())
+	if err != nil {
+		return nil, consts.ErrInvalidAuditEventFilter
+	}
+
+	targetId, err := parseOptionalId(req.GetTargetId())
+	if err != nil {
+		return nil, consts.ErrInvalidAuditEventFilter
+	}
+
+	usecaseReq := ListAuditEventsRequest{
+		Page:       int(req.GetPage()),
+		PerPage:    int(req.GetPerPage()),
+		Action:     req.GetAction(),
+		ActorId:    actorId,
+		TargetType: req.GetTargetType(),
+		TargetId:   targetId,
+	}
+
+	res, err := h.usecase.ListAuditEvents(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	auditEvents := make([]*pb.AuditEvent, len(res.AuditEvents))
+	for i, auditEvent := range res.AuditEvents {
+		changes, err := json.Marshal(auditEvent.Changes)
+		if err != nil {
+			return nil, err
+		}
+
+		metadata, err := json.Marshal(auditEvent.Metadata)
+		if err != nil {
+			return nil, err
+		}
+
+		auditEvents[i] = &pb.AuditEvent{
+			Id:             auditEvent.Id.String(),
+			Action:         auditEvent.Action,
+			ActorId:        formatOptionalId(auditEvent.ActorId),
+			ImpersonatorId: formatOptionalId(auditEvent.ImpersonatorId),
+			TargetType:     auditEvent.TargetType,
+			TargetId:       formatOptionalId(auditEvent.TargetId),
+			IpAddress:      auditEvent.IpAddress,
+			UserAgent:      auditEvent.UserAgent,
+			RequestId:      auditEvent.RequestId,
+			Changes:        string(changes),
+			Metadata:       string(metadata),
+			CreatedAt:      timestamppb.New(auditEvent.CreatedAt),
+		}
+	}
+
+	return &pb.ListAuditEventsResponse{
+		AuditEvents: auditEvents,
+		Pagination: &pb.Pagination{
+			Page:       int32(res.Pagination.Page),
+			PerPage:    int32(res.Pagination.PerPage),
+			TotalCount: int32(res.Pagination.TotalCount),
+			TotalPages: int32(res.Pagination.TotalPages),
+		},
+	}, nil
+}
+
+func parseOptionalId(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Parse(id)
+}
+
+func formatOptionalId(id *uuid.UUID) string {
+	if id == nil {
+		return ""
+	}
+
+	return id.String()
+}
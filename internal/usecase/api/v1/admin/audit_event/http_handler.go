@@ -0,0 +1,43 @@
+package audit_event
+
+import (
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewHttpHandler)
+}
+
+type IHttpHandler interface {
+	ListAuditEvents(c *echo.Context) error
+}
+
+type HttpHandler struct {
+	usecase IUsecase
+}
+
+var _ IHttpHandler = (*HttpHandler)(nil)
+
+func NewHttpHandler(i do.Injector) (*HttpHandler, error) {
+	return &HttpHandler{
+		usecase: do.MustInvoke[*Usecase](i),
+	}, nil
+}
+
+func (h *HttpHandler) ListAuditEvents(c *echo.Context) error {
+	req := ListAuditEventsRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.usecase.ListAuditEvents(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetMeta(res.Pagination).SetData(res.AuditEvents).Send()
+}
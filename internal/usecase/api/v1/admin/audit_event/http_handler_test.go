@@ -0,0 +1,72 @@
+package audit_event
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/dto"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpHandler_ListAuditEvents(t *testing.T) {
+	t.Run("binds the query and returns the audit events with pagination as meta", func(t *testing.T) {
+		actorId := uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2")
+		eventId := uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e3")
+		req := httptest.NewRequest(http.MethodGet, "/audit-events?page=2&perPage=10&action=auth.sign_out&actorId="+actorId.String(), nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+		expectedReq := ListAuditEventsRequest{Page: 2, PerPage: 10, Action: "auth.sign_out", ActorId: actorId}
+
+		usecase.EXPECT().ListAuditEvents(mock.Anything, expectedReq).Return(&ListAuditEventsResponse{
+			AuditEvents: []AuditEventResponse{{
+				Id:        eventId,
+				Action:    "auth.sign_out",
+				ActorId:   &actorId,
+				CreatedAt: time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC),
+			}},
+			Pagination: dto.Pagination{Page: 2, PerPage: 10, TotalCount: 11, TotalPages: 2},
+		}, nil).Once()
+
+		err := httpHandler.ListAuditEvents(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{
+			"ok": true,
+			"meta": {"page": 2, "perPage": 10, "totalCount": 11, "totalPages": 2},
+			"data": [{
+				"id": "019e925f-3f42-76a0-8518-cb8e51c0b8e3",
+				"action": "auth.sign_out",
+				"actorId": "019e925f-3f42-76a0-8518-cb8e51c0b8e2",
+				"impersonatorId": null,
+				"targetType": "",
+				"targetId": null,
+				"ipAddress": "",
+				"userAgent": "",
+				"requestId": "",
+				"changes": null,
+				"metadata": null,
+				"createdAt": "2026-10-18T10:00:00Z"
+			}],
+			"errors": null
+		}`, rec.Body.String())
+	})
+
+	t.Run("returns bind errors for malformed ids", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/audit-events?actorId=not-a-uuid", nil)
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+		httpHandler := &HttpHandler{usecase: NewMockIUsecase(t)}
+
+		err := httpHandler.ListAuditEvents(ctx)
+
+		require.Error(t, err)
+	})
+}
@@ -0,0 +1,102 @@
+package audit_event
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/dto"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryAuditEvent "github.com/anonychun/bibit/internal/repository/audit_event"
+	"github.com/anonychun/bibit/internal/validation"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewUsecase)
+}
+
+type IUsecase interface {
+	ListAuditEvents(ctx context.Context, req ListAuditEventsRequest) (*ListAuditEventsResponse, error)
+}
+
+type Usecase struct {
+	validator            validation.IValidator
+	auditEventRepository repositoryAuditEvent.IRepository
+}
+
+var _ IUsecase = (*Usecase)(nil)
+
+func NewUsecase(i do.Injector) (*Usecase, error) {
+	return &Usecase{
+		validator:            do.MustInvoke[*validation.Validator](i),
+		auditEventRepository: do.MustInvoke[*repositoryAuditEvent.Repository](i),
+	}, nil
+}
+
+func (u *Usecase) ListAuditEvents(ctx context.Context, req ListAuditEventsRequest) (*ListAuditEventsResponse, error) {
+	if !current.Can(ctx, consts.PermissionAuditEventsRead) {
+		return nil, consts.ErrForbidden
+	}
+
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	filter := repositoryAuditEvent.Filter{
+		Action:     req.Action,
+		ActorId:    req.ActorId,
+		TargetType: req.TargetType,
+		TargetId:   req.TargetId,
+	}
+	pagination := dto.NewPagination(req.Page, req.PerPage)
+
+	totalCount, err := u.auditEventRepository.CountByFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+	pagination.SetTotalCount(totalCount)
+
+	auditEvents, err := u.auditEventRepository.FindAllByFilter(ctx, filter, pagination.Limit(), pagination.Offset())
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListAuditEventsResponse{
+		AuditEvents: make([]AuditEventResponse, len(auditEvents)),
+		Pagination:  pagination,
+	}
+	for i, auditEvent := range auditEvents {
+		res.AuditEvents[i] = newAuditEventResponse(auditEvent)
+	}
+
+	return res, nil
+}
+
+func newAuditEventResponse(auditEvent *entity.AuditEvent) AuditEventResponse {
+	return AuditEventResponse{
+		Id:             auditEvent.Id,
+		Action:         auditEvent.Action,
+		ActorId:        optionalId(auditEvent.ActorId),
+		ImpersonatorId: optionalId(auditEvent.ImpersonatorId),
+		TargetType:     auditEvent.TargetType,
+		TargetId:       optionalId(auditEvent.TargetId),
+		IpAddress:      auditEvent.IpAddress,
+		UserAgent:      auditEvent.UserAgent,
+		RequestId:      auditEvent.RequestId,
+		Changes:        auditEvent.Changes,
+		Metadata:       auditEvent.Metadata,
+		CreatedAt:      auditEvent.CreatedAt,
+	}
+}
+
+func optionalId(id uuid.UUID) *uuid.UUID {
+	if id == uuid.Nil {
+		return nil
+	}
+
+	return &id
+}
@@ -0,0 +1,115 @@
+package audit_event
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/dto"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryAuditEvent "github.com/anonychun/bibit/internal/repository/audit_event"
+	"github.com/anonychun/bibit/internal/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_ListAuditEvents(t *testing.T) {
+	t.Run("returns the requested page of audit events with pagination", func(t *testing.T) {
+		ctx := current.SetPermissions(context.Background(), []string{consts.PermissionAuditEventsRead})
+		actorId := uuid.New()
+		req := ListAuditEventsRequest{Page: 2, PerPage: 10, Action: "auth.sign_in.succeeded", ActorId: actorId}
+		auditEvent := &entity.AuditEvent{
+			Base:       entity.Base{Id: uuid.New(), CreatedAt: time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)},
+			Action:     "auth.sign_in.succeeded",
+			ActorId:    actorId,
+			TargetType: "user_session",
+			TargetId:   uuid.New(),
+			IpAddress:  "192.0.2.1",
+			UserAgent:  "Go test",
+			RequestId:  "request-id",
+		}
+		filter := repositoryAuditEvent.Filter{Action: req.Action, ActorId: actorId}
+		validator := validation.NewMockIValidator(t)
+		auditEventRepository := repositoryAuditEvent.NewMockIRepository(t)
+		usecase := &Usecase{validator: validator, auditEventRepository: auditEventRepository}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		auditEventRepository.EXPECT().CountByFilter(ctx, filter).Return(11, nil).Once()
+		auditEventRepository.EXPECT().FindAllByFilter(ctx, filter, 10, 10).Return([]*entity.AuditEvent{auditEvent}, nil).Once()
+
+		res, err := usecase.ListAuditEvents(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, dto.Pagination{Page: 2, PerPage: 10, TotalCount: 11, TotalPages: 2}, res.Pagination)
+		require.Len(t, res.AuditEvents, 1)
+		assert.Equal(t, auditEvent.Id, res.AuditEvents[0].Id)
+		assert.Equal(t, &actorId, res.AuditEvents[0].ActorId)
+		assert.Nil(t, res.AuditEvents[0].ImpersonatorId)
+		assert.Equal(t, &auditEvent.TargetId, res.AuditEvents[0].TargetId)
+		assert.Equal(t, "request-id", res.AuditEvents[0].RequestId)
+		assert.Equal(t, auditEvent.CreatedAt, res.AuditEvents[0].CreatedAt)
+	})
+
+	t.Run("defaults to the first page", func(t *testing.T) {
+		ctx := current.SetPermissions(context.Background(), []string{consts.PermissionAuditEventsRead})
+		validator := validation.NewMockIValidator(t)
+		auditEventRepository := repositoryAuditEvent.NewMockIRepository(t)
+		usecase := &Usecase{validator: validator, auditEventRepository: auditEventRepository}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		auditEventRepository.EXPECT().CountByFilter(ctx, repositoryAuditEvent.Filter{}).Return(0, nil).Once()
+		auditEventRepository.EXPECT().FindAllByFilter(ctx, repositoryAuditEvent.Filter{}, dto.DefaultPerPage, 0).Return([]*entity.AuditEvent{}, nil).Once()
+
+		res, err := usecase.ListAuditEvents(ctx, ListAuditEventsRequest{})
+
+		require.NoError(t, err)
+		assert.Equal(t, dto.Pagination{Page: 1, PerPage: dto.DefaultPerPage}, res.Pagination)
+		assert.Empty(t, res.AuditEvents)
+	})
+
+	t.Run("returns validation errors before querying", func(t *testing.T) {
+		ctx := current.SetPermissions(context.Background(), []string{consts.PermissionAuditEventsRead})
+		validationErr := api.ValidationError{"perPage": []string{"Per page must be at most 100"}}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(validationErr).Once()
+
+		res, err := usecase.ListAuditEvents(ctx, ListAuditEventsRequest{PerPage: 1000})
+
+		require.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, validationErr, err)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		ctx := current.SetPermissions(context.Background(), []string{consts.PermissionAuditEventsRead})
+		expectedErr := errors.New("count audit events")
+		validator := validation.NewMockIValidator(t)
+		auditEventRepository := repositoryAuditEvent.NewMockIRepository(t)
+		usecase := &Usecase{validator: validator, auditEventRepository: auditEventRepository}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		auditEventRepository.EXPECT().CountByFilter(ctx, mock.Anything).Return(0, expectedErr).Once()
+
+		res, err := usecase.ListAuditEvents(ctx, ListAuditEventsRequest{})
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, res)
+	})
+	t.Run("returns forbidden without the permission to read audit events", func(t *testing.T) {
+		ctx := current.SetPermissions(context.Background(), []string{consts.PermissionUsersRead})
+		usecase := &Usecase{}
+
+		res, err := usecase.ListAuditEvents(ctx, ListAuditEventsRequest{})
+
+		require.ErrorIs(t, err, consts.ErrForbidden)
+		assert.Nil(t, res)
+	})
+}
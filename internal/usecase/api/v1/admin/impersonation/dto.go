@@ -0,0 +1,18 @@
+package impersonation
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type StartImpersonationRequest struct {
+	IpAddress string
+	UserAgent string
+	UserId    uuid.UUID
+}
+
+type StartImpersonationResponse struct {
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
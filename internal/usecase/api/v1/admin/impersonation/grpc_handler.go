@@ -0,0 +1,57 @@
+package impersonation
+
+import (
+	"context"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/util"
+	pb "github.com/anonychun/bibit/pkg/pb/api/v1/admin/impersonation"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewGrpcHandler)
+}
+
+type IGrpcHandler interface {
+	pb.ServiceServer
+}
+
+type GrpcHandler struct {
+	pb.UnimplementedServiceServer
+	usecase IUsecase
+}
+
+var _ IGrpcHandler = (*GrpcHandler)(nil)
+
+func NewGrpcHandler(i do.Injector) (*GrpcHandler, error) {
+	return &GrpcHandler{
+		usecase: do.MustInvoke[*Usecase](i),
+	}, nil
+}
+
+func (h *GrpcHandler) StartImpersonation(ctx context.Context, req *pb.StartImpersonationRequest) (*pb.StartImpersonationResponse, error) {
+	userId, err := uuid.Parse(req.GetUserId())
+	if err != nil {
+		return nil, consts.ErrUserNotFound
+	}
+
+	usecaseReq := StartImpersonationRequest{
+		IpAddress: util.GrpcPeerAddress(ctx),
+		UserAgent: util.GrpcMetadataValue(ctx, "user-agent"),
+		UserId:    userId,
+	}
+
+	res, err := h.usecase.StartImpersonation(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartImpersonationResponse{
+		Token:     res.Token,
+		ExpiresAt: timestamppb.New(res.ExpiresAt),
+	}, nil
+}
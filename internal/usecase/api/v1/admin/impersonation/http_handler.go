@@ -0,0 +1,52 @@
+package impersonation
+
+import (
+	"net/http"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewHttpHandler)
+}
+
+type IHttpHandler interface {
+	StartImpersonation(c *echo.Context) error
+}
+
+type HttpHandler struct {
+	usecase IUsecase
+}
+
+var _ IHttpHandler = (*HttpHandler)(nil)
+
+func NewHttpHandler(i do.Injector) (*HttpHandler, error) {
+	return &HttpHandler{
+		usecase: do.MustInvoke[*Usecase](i),
+	}, nil
+}
+
+func (h *HttpHandler) StartImpersonation(c *echo.Context) error {
+	userId, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return consts.ErrUserNotFound
+	}
+
+	req := StartImpersonationRequest{
+		IpAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+		UserId:    userId,
+	}
+
+	res, err := h.usecase.StartImpersonation(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetStatus(http.StatusCreated).SetData(res).Send()
+}
@@ -0,0 +1,51 @@
+package impersonation
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpHandler_StartImpersonation(t *testing.T) {
+	t.Run("returns the impersonated session token in the body", func(t *testing.T) {
+		userID := uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2")
+		req := httptest.NewRequest(http.MethodPost, "/users/"+userID.String()+"/impersonate", nil)
+		req.Header.Set("User-Agent", "Go test")
+		req.Header.Set("X-Real-IP", "192.0.2.1")
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: userID.String()}})
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+		expectedReq := StartImpersonationRequest{IpAddress: "192.0.2.1", UserAgent: "Go test", UserId: userID}
+		expiresAt := time.Date(2026, time.October, 18, 10, 0, 0, 0, time.UTC)
+
+		usecase.EXPECT().StartImpersonation(mock.Anything, expectedReq).Return(&StartImpersonationResponse{Token: "session-token", ExpiresAt: expiresAt}, nil).Once()
+
+		err := httpHandler.StartImpersonation(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"token":"session-token","expiresAt":"2026-10-18T10:00:00Z"},"errors":null}`, rec.Body.String())
+		assert.Empty(t, rec.Result().Cookies())
+	})
+
+	t.Run("returns user not found for malformed ids", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/users/not-a-uuid/impersonate", nil)
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: "not-a-uuid"}})
+		httpHandler := &HttpHandler{usecase: NewMockIUsecase(t)}
+
+		err := httpHandler.StartImpersonation(ctx)
+
+		require.ErrorIs(t, err, consts.ErrUserNotFound)
+	})
+}
@@ -0,0 +1,96 @@
+package impersonation
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryPermission "github.com/anonychun/bibit/internal/repository/permission"
+	repositoryUser "github.com/anonychun/bibit/internal/repository/user"
+	repositoryUserSession "github.com/anonychun/bibit/internal/repository/user_session"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewUsecase)
+}
+
+type IUsecase interface {
+	StartImpersonation(ctx context.Context, req StartImpersonationRequest) (*StartImpersonationResponse, error)
+}
+
+type Usecase struct {
+	config                *config.Config
+	userRepository        repositoryUser.IRepository
+	userSessionRepository repositoryUserSession.IRepository
+	permissionRepository  repositoryPermission.IRepository
+}
+
+var _ IUsecase = (*Usecase)(nil)
+
+func NewUsecase(i do.Injector) (*Usecase, error) {
+	return &Usecase{
+		config:                do.MustInvoke[*config.Config](i),
+		userRepository:        do.MustInvoke[*repositoryUser.Repository](i),
+		userSessionRepository: do.MustInvoke[*repositoryUserSession.Repository](i),
+		permissionRepository:  do.MustInvoke[*repositoryPermission.Repository](i),
+	}, nil
+}
+
+// StartImpersonation creates a short-lived session for the user flagged with
+// the current user as impersonator. The token is returned instead of being
+// set as a cookie so that it does not replace the admin's own session.
+func (u *Usecase) StartImpersonation(ctx context.Context, req StartImpersonationRequest) (*StartImpersonationResponse, error) {
+	impersonator := current.User(ctx)
+	if impersonator == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if !current.Can(ctx, consts.PermissionUsersImpersonate) {
+		return nil, consts.ErrForbidden
+	}
+
+	if current.Impersonator(ctx) != nil || req.UserId == impersonator.Id {
+		return nil, consts.ErrImpersonationNotAllowed
+	}
+
+	user, err := u.userRepository.FindById(ctx, req.UserId)
+	if err == sql.ErrNoRows {
+		return nil, consts.ErrUserNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Impersonating must not grant anything the impersonator does not hold
+	// already, nor chain into another impersonation.
+	permissions, err := u.permissionRepository.FindAllNamesByUserId(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, permission := range permissions {
+		if permission == consts.PermissionUsersImpersonate || !current.Can(ctx, permission) {
+			return nil, consts.ErrImpersonationNotAllowed
+		}
+	}
+
+	userSession := &entity.UserSession{
+		UserId:         user.Id,
+		ImpersonatorId: impersonator.Id,
+		IpAddress:      req.IpAddress,
+		UserAgent:      req.UserAgent,
+	}
+	userSession.GenerateToken()
+	userSession.SetExpiration(u.config.Auth.Impersonation.SessionLifetime)
+
+	err = u.userSessionRepository.Create(ctx, userSession)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartImpersonationResponse{Token: userSession.Token, ExpiresAt: userSession.ExpiresAt}, nil
+}
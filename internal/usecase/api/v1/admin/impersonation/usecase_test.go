@@ -0,0 +1,155 @@
+package impersonation
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryPermission "github.com/anonychun/bibit/internal/repository/permission"
+	repositoryUser "github.com/anonychun/bibit/internal/repository/user"
+	repositoryUserSession "github.com/anonychun/bibit/internal/repository/user_session"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_StartImpersonation(t *testing.T) {
+	t.Run("creates a session for the user flagged with the impersonator", func(t *testing.T) {
+		impersonator := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), impersonator)
+		ctx = current.SetPermissions(ctx, []string{consts.PermissionUsersImpersonate})
+		req := StartImpersonationRequest{IpAddress: "192.0.2.1", UserAgent: "Go test", UserId: user.Id}
+		cfg := &config.Config{}
+		cfg.Auth.Impersonation.SessionLifetime = time.Hour
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                cfg,
+			userRepository:        userRepository,
+			userSessionRepository: userSessionRepository,
+			permissionRepository:  permissionRepository,
+		}
+
+		userRepository.EXPECT().FindById(ctx, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(ctx, user.Id).Return([]string{}, nil).Once()
+
+		var createdSession *entity.UserSession
+		userSessionRepository.EXPECT().Create(ctx, mock.AnythingOfType("*entity.UserSession")).Run(func(ctx context.Context, actual *entity.UserSession) {
+			createdSession = actual
+		}).Return(nil).Once()
+
+		res, err := usecase.StartImpersonation(ctx, req)
+
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		require.NotNil(t, createdSession)
+		assert.Equal(t, user.Id, createdSession.UserId)
+		assert.Equal(t, impersonator.Id, createdSession.ImpersonatorId)
+		assert.Equal(t, req.IpAddress, createdSession.IpAddress)
+		assert.Equal(t, req.UserAgent, createdSession.UserAgent)
+		assert.Equal(t, util.DigestToken(res.Token), createdSession.TokenDigest)
+		assert.Equal(t, createdSession.LastSeenAt.Add(time.Hour), res.ExpiresAt)
+	})
+
+	t.Run("rejects impersonating yourself", func(t *testing.T) {
+		impersonator := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), impersonator)
+		ctx = current.SetPermissions(ctx, []string{consts.PermissionUsersImpersonate})
+		usecase := &Usecase{}
+
+		res, err := usecase.StartImpersonation(ctx, StartImpersonationRequest{UserId: impersonator.Id})
+
+		require.ErrorIs(t, err, consts.ErrImpersonationNotAllowed)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rejects starting an impersonation from an impersonated session", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetPermissions(ctx, []string{consts.PermissionUsersImpersonate})
+		ctx = current.SetImpersonator(ctx, &entity.User{Base: entity.Base{Id: uuid.New()}})
+		usecase := &Usecase{}
+
+		res, err := usecase.StartImpersonation(ctx, StartImpersonationRequest{UserId: uuid.New()})
+
+		require.ErrorIs(t, err, consts.ErrImpersonationNotAllowed)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rejects users who may impersonate themselves", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetPermissions(ctx, []string{consts.PermissionUsersImpersonate})
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userRepository := repositoryUser.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		usecase := &Usecase{userRepository: userRepository, permissionRepository: permissionRepository}
+
+		userRepository.EXPECT().FindById(ctx, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(ctx, user.Id).Return([]string{consts.PermissionUsersImpersonate}, nil).Once()
+
+		res, err := usecase.StartImpersonation(ctx, StartImpersonationRequest{UserId: user.Id})
+
+		require.ErrorIs(t, err, consts.ErrImpersonationNotAllowed)
+		assert.Nil(t, res)
+	})
+
+	t.Run("rejects users holding a permission the impersonator lacks", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetPermissions(ctx, []string{consts.PermissionUsersImpersonate, consts.PermissionUsersRead})
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		userRepository := repositoryUser.NewMockIRepository(t)
+		permissionRepository := repositoryPermission.NewMockIRepository(t)
+		usecase := &Usecase{userRepository: userRepository, permissionRepository: permissionRepository}
+
+		userRepository.EXPECT().FindById(ctx, user.Id).Return(user, nil).Once()
+		permissionRepository.EXPECT().FindAllNamesByUserId(ctx, user.Id).Return([]string{consts.PermissionUsersRead, consts.PermissionRolesManage}, nil).Once()
+
+		res, err := usecase.StartImpersonation(ctx, StartImpersonationRequest{UserId: user.Id})
+
+		require.ErrorIs(t, err, consts.ErrImpersonationNotAllowed)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns user not found for unknown users", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetPermissions(ctx, []string{consts.PermissionUsersImpersonate})
+		req := StartImpersonationRequest{UserId: uuid.New()}
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{userRepository: userRepository}
+
+		userRepository.EXPECT().FindById(ctx, req.UserId).Return(nil, sql.ErrNoRows).Once()
+
+		res, err := usecase.StartImpersonation(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrUserNotFound)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns forbidden without the permission to impersonate", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetPermissions(ctx, []string{consts.PermissionUsersRead})
+		usecase := &Usecase{}
+
+		res, err := usecase.StartImpersonation(ctx, StartImpersonationRequest{UserId: uuid.New()})
+
+		require.ErrorIs(t, err, consts.ErrForbidden)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns unauthorized when there is no current user", func(t *testing.T) {
+		usecase := &Usecase{}
+
+		res, err := usecase.StartImpersonation(context.Background(), StartImpersonationRequest{UserId: uuid.New()})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+}
@@ -0,0 +1,37 @@
+package api_key
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type ApiKeyResponse struct {
+	Id         uuid.UUID `json:"id"`
+	Name       string    `json:"name"`
+	Prefix     string    `json:"prefix"`
+	Scopes     []string  `json:"scopes"`
+	ExpiresAt  time.Time `json:"expiresAt,omitzero"`
+	LastUsedAt time.Time `json:"lastUsedAt,omitzero"`
+	RevokedAt  time.Time `json:"revokedAt,omitzero"`
+	CreatedAt  time.Time `json:"createdAt"`
+}
+
+type ListApiKeysResponse struct {
+	ApiKeys []ApiKeyResponse `json:"apiKeys"`
+}
+
+type CreateApiKeyRequest struct {
+	Name      string    `json:"name" validate:"required|maxLen:100" field:"name" label:"Name"`
+	Scopes    []string  `json:"scopes" validate:"required" field:"scopes" label:"Scopes"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+type CreateApiKeyResponse struct {
+	ApiKey ApiKeyResponse `json:"apiKey"`
+	Key    string         `json:"key"`
+}
+
+type RevokeApiKeyRequest struct {
+	Id uuid.UUID
+}
@@ -0,0 +1,103 @@
+package api_key
+
+import (
+	"context"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	pb "github.com/anonychun/bibit/pkg/pb/api/v1/app/api_key"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewGrpcHandler)
+}
+
+type IGrpcHandler interface {
+	pb.ServiceServer
+}
+
+type GrpcHandler struct {
+	pb.UnimplementedServiceServer
+	usecase IUsecase
+}
+
+var _ IGrpcHandler = (*GrpcHandler)(nil)
+
+func NewGrpcHandler(i do.Injector) (*GrpcHandler, error) {
+	return &GrpcHandler{
+		usecase: do.MustInvoke[*Usecase](i),
+	}, nil
+}
+
+func (h *GrpcHandler) ListApiKeys(ctx context.Context, _ *pb.ListApiKeysRequest) (*pb.ListApiKeysResponse, error) {
+	res, err := h.usecase.ListApiKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]*pb.ApiKey, len(res.ApiKeys))
+	for i, apiKey := range res.ApiKeys {
+		apiKeys[i] = newPbApiKey(apiKey)
+	}
+
+	return &pb.ListApiKeysResponse{ApiKeys: apiKeys}, nil
+}
+
+func (h *GrpcHandler) CreateApiKey(ctx context.Context, req *pb.CreateApiKeyRequest) (*pb.CreateApiKeyResponse, error) {
+	usecaseReq := CreateApiKeyRequest{
+		Name:   req.GetName(),
+		Scopes: req.GetScopes(),
+	}
+	if req.GetExpiresAt() != nil {
+		usecaseReq.ExpiresAt = req.GetExpiresAt().AsTime()
+	}
+
+	res, err := h.usecase.CreateApiKey(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateApiKeyResponse{
+		ApiKey: newPbApiKey(res.ApiKey),
+		Key:    res.Key,
+	}, nil
+}
+
+func (h *GrpcHandler) RevokeApiKey(ctx context.Context, req *pb.RevokeApiKeyRequest) (*pb.RevokeApiKeyResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, consts.ErrApiKeyNotFound
+	}
+
+	err = h.usecase.RevokeApiKey(ctx, RevokeApiKeyRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeApiKeyResponse{}, nil
+}
+
+func newPbApiKey(apiKey ApiKeyResponse) *pb.ApiKey {
+	return &pb.ApiKey{
+		Id:         apiKey.Id.String(),
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		ExpiresAt:  optionalTimestamp(apiKey.ExpiresAt),
+		LastUsedAt: optionalTimestamp(apiKey.LastUsedAt),
+		RevokedAt:  optionalTimestamp(apiKey.RevokedAt),
+		CreatedAt:  timestamppb.New(apiKey.CreatedAt),
+	}
+}
+
+func optionalTimestamp(t time.Time) *timestamppb.Timestamp {
+	if t.IsZero() {
+		return nil
+	}
+
+	return timestamppb.New(t)
+}
@@ -0,0 +1,72 @@
+package api_key
+
+import (
+	"net/http"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewHttpHandler)
+}
+
+type IHttpHandler interface {
+	ListApiKeys(c *echo.Context) error
+	CreateApiKey(c *echo.Context) error
+	RevokeApiKey(c *echo.Context) error
+}
+
+type HttpHandler struct {
+	usecase IUsecase
+}
+
+var _ IHttpHandler = (*HttpHandler)(nil)
+
+func NewHttpHandler(i do.Injector) (*HttpHandler, error) {
+	return &HttpHandler{
+		usecase: do.MustInvoke[*Usecase](i),
+	}, nil
+}
+
+func (h *HttpHandler) ListApiKeys(c *echo.Context) error {
+	res, err := h.usecase.ListApiKeys(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetData(res).Send()
+}
+
+func (h *HttpHandler) CreateApiKey(c *echo.Context) error {
+	req := CreateApiKeyRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.usecase.CreateApiKey(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetStatus(http.StatusCreated).SetData(res).Send()
+}
+
+func (h *HttpHandler) RevokeApiKey(c *echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return consts.ErrApiKeyNotFound
+	}
+
+	err = h.usecase.RevokeApiKey(c.Request().Context(), RevokeApiKeyRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
@@ -0,0 +1,84 @@
+package api_key
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpHandler_CreateApiKey(t *testing.T) {
+	t.Run("binds the request and returns the created key", func(t *testing.T) {
+		e := echo.New()
+		body := `{"name":"CI","scopes":["profile:read"],"expiresAt":"2030-01-01T00:00:00Z"}`
+		req := httptest.NewRequest(http.MethodPost, "/api-keys", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+		expectedReq := CreateApiKeyRequest{
+			Name:      "CI",
+			Scopes:    []string{consts.ScopeProfileRead},
+			ExpiresAt: time.Date(2030, time.January, 1, 0, 0, 0, 0, time.UTC),
+		}
+		res := &CreateApiKeyResponse{
+			ApiKey: ApiKeyResponse{
+				Id:        uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2"),
+				Name:      "CI",
+				Prefix:    "bibit_AbCd1234",
+				Scopes:    []string{consts.ScopeProfileRead},
+				CreatedAt: time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC),
+			},
+			Key: "bibit_AbCd1234secret",
+		}
+
+		usecase.EXPECT().CreateApiKey(mock.Anything, expectedReq).Return(res, nil).Once()
+
+		err := httpHandler.CreateApiKey(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"apiKey":{"id":"019e925f-3f42-76a0-8518-cb8e51c0b8e2","name":"CI","prefix":"bibit_AbCd1234","scopes":["profile:read"],"createdAt":"2026-10-18T09:00:00Z"},"key":"bibit_AbCd1234secret"},"errors":null}`, rec.Body.String())
+	})
+}
+
+func TestHttpHandler_RevokeApiKey(t *testing.T) {
+	t.Run("revokes the api key from the path", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/api-keys/019e925f-3f42-76a0-8518-cb8e51c0b8e2", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: "019e925f-3f42-76a0-8518-cb8e51c0b8e2"}})
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+
+		usecase.EXPECT().RevokeApiKey(mock.Anything, RevokeApiKeyRequest{Id: uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2")}).Return(nil).Once()
+
+		err := httpHandler.RevokeApiKey(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("returns api key not found for malformed ids", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/api-keys/not-a-uuid", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: "not-a-uuid"}})
+		httpHandler := &HttpHandler{usecase: NewMockIUsecase(t)}
+
+		err := httpHandler.RevokeApiKey(ctx)
+
+		require.ErrorIs(t, err, consts.ErrApiKeyNotFound)
+	})
+}
@@ -0,0 +1,138 @@
+package api_key
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryApiKey "github.com/anonychun/bibit/internal/repository/api_key"
+	"github.com/anonychun/bibit/internal/validation"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewUsecase)
+}
+
+type IUsecase interface {
+	ListApiKeys(ctx context.Context) (*ListApiKeysResponse, error)
+	CreateApiKey(ctx context.Context, req CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	RevokeApiKey(ctx context.Context, req RevokeApiKeyRequest) error
+}
+
+type Usecase struct {
+	validator        validation.IValidator
+	apiKeyRepository repositoryApiKey.IRepository
+}
+
+var _ IUsecase = (*Usecase)(nil)
+
+func NewUsecase(i do.Injector) (*Usecase, error) {
+	return &Usecase{
+		validator:        do.MustInvoke[*validation.Validator](i),
+		apiKeyRepository: do.MustInvoke[*repositoryApiKey.Repository](i),
+	}, nil
+}
+
+func (u *Usecase) ListApiKeys(ctx context.Context) (*ListApiKeysResponse, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	apiKeys, err := u.apiKeyRepository.FindAllByUserId(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListApiKeysResponse{ApiKeys: make([]ApiKeyResponse, len(apiKeys))}
+	for i, apiKey := range apiKeys {
+		res.ApiKeys[i] = newApiKeyResponse(apiKey)
+	}
+
+	return res, nil
+}
+
+func (u *Usecase) CreateApiKey(ctx context.Context, req CreateApiKeyRequest) (*CreateApiKeyResponse, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if current.Impersonator(ctx) != nil {
+		return nil, consts.ErrImpersonationRestricted
+	}
+
+	validationErr := u.validator.Struct(&req)
+	for _, scope := range req.Scopes {
+		if !slices.Contains(consts.Scopes, scope) {
+			validationErr.Add("scopes", fmt.Sprintf("Scope %q is not supported", scope))
+		}
+	}
+
+	if !req.ExpiresAt.IsZero() && !req.ExpiresAt.After(time.Now()) {
+		validationErr.Add("expiresAt", "Expiry must be in the future")
+	}
+
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	apiKey := &entity.ApiKey{
+		UserId:    user.Id,
+		Name:      req.Name,
+		Scopes:    slices.Compact(slices.Sorted(slices.Values(req.Scopes))),
+		ExpiresAt: req.ExpiresAt,
+	}
+	apiKey.GenerateKey()
+
+	err := u.apiKeyRepository.Create(ctx, apiKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateApiKeyResponse{
+		ApiKey: newApiKeyResponse(apiKey),
+		Key:    apiKey.Key,
+	}, nil
+}
+
+func (u *Usecase) RevokeApiKey(ctx context.Context, req RevokeApiKeyRequest) error {
+	user := current.User(ctx)
+	if user == nil {
+		return consts.ErrUnauthorized
+	}
+
+	if current.Impersonator(ctx) != nil {
+		return consts.ErrImpersonationRestricted
+	}
+
+	isRevoked, err := u.apiKeyRepository.RevokeByIdAndUserId(ctx, req.Id, user.Id, time.Now())
+	if err != nil {
+		return err
+	}
+
+	if !isRevoked {
+		return consts.ErrApiKeyNotFound
+	}
+
+	return nil
+}
+
+func newApiKeyResponse(apiKey *entity.ApiKey) ApiKeyResponse {
+	return ApiKeyResponse{
+		Id:         apiKey.Id,
+		Name:       apiKey.Name,
+		Prefix:     apiKey.Prefix,
+		Scopes:     apiKey.Scopes,
+		ExpiresAt:  apiKey.ExpiresAt,
+		LastUsedAt: apiKey.LastUsedAt,
+		RevokedAt:  apiKey.RevokedAt,
+		CreatedAt:  apiKey.CreatedAt,
+	}
+}
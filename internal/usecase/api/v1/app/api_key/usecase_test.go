@@ -0,0 +1,174 @@
+package api_key
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryApiKey "github.com/anonychun/bibit/internal/repository/api_key"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/anonychun/bibit/internal/validation"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_ListApiKeys(t *testing.T) {
+	t.Run("returns the api keys of the current user", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		apiKey := &entity.ApiKey{
+			Base:   entity.Base{Id: uuid.New()},
+			UserId: user.Id,
+			Name:   "CI",
+			Prefix: "bibit_AbCd1234",
+			Scopes: []string{consts.ScopeProfileRead},
+		}
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		usecase := &Usecase{apiKeyRepository: apiKeyRepository}
+
+		apiKeyRepository.EXPECT().FindAllByUserId(ctx, user.Id).Return([]*entity.ApiKey{apiKey}, nil).Once()
+
+		res, err := usecase.ListApiKeys(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, res.ApiKeys, 1)
+		assert.Equal(t, apiKey.Id, res.ApiKeys[0].Id)
+		assert.Equal(t, "CI", res.ApiKeys[0].Name)
+		assert.Equal(t, "bibit_AbCd1234", res.ApiKeys[0].Prefix)
+		assert.Equal(t, []string{consts.ScopeProfileRead}, res.ApiKeys[0].Scopes)
+	})
+
+	t.Run("returns unauthorized without a current user", func(t *testing.T) {
+		usecase := &Usecase{}
+
+		res, err := usecase.ListApiKeys(context.Background())
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_CreateApiKey(t *testing.T) {
+	t.Run("creates an api key and returns the key once", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		expiresAt := time.Now().Add(24 * time.Hour)
+		req := CreateApiKeyRequest{
+			Name:      "CI",
+			Scopes:    []string{consts.ScopeProfileRead, consts.ScopeProfileRead},
+			ExpiresAt: expiresAt,
+		}
+		validator := validation.NewMockIValidator(t)
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		usecase := &Usecase{validator: validator, apiKeyRepository: apiKeyRepository}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+		apiKeyRepository.EXPECT().Create(ctx, mock.MatchedBy(func(apiKey *entity.ApiKey) bool {
+			return apiKey.UserId == user.Id &&
+				apiKey.Name == "CI" &&
+				assert.ObjectsAreEqual([]string{consts.ScopeProfileRead}, apiKey.Scopes) &&
+				apiKey.ExpiresAt.Equal(expiresAt) &&
+				apiKey.KeyDigest == util.DigestToken(apiKey.Key)
+		})).Return(nil).Once()
+
+		res, err := usecase.CreateApiKey(ctx, req)
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(res.Key, entity.ApiKeyPrefix))
+		assert.True(t, strings.HasPrefix(res.Key, res.ApiKey.Prefix))
+		assert.Equal(t, "CI", res.ApiKey.Name)
+	})
+
+	t.Run("returns validation errors for unsupported scopes and past expiries", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		req := CreateApiKeyRequest{
+			Name:      "CI",
+			Scopes:    []string{"admin:everything"},
+			ExpiresAt: time.Now().Add(-time.Minute),
+		}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+
+		res, err := usecase.CreateApiKey(ctx, req)
+
+		var validationErr api.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "scopes")
+		assert.Contains(t, validationErr, "expiresAt")
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns unauthorized without a current user", func(t *testing.T) {
+		usecase := &Usecase{}
+
+		res, err := usecase.CreateApiKey(context.Background(), CreateApiKeyRequest{})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+
+	t.Run("refuses while impersonating", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetImpersonator(ctx, &entity.User{Base: entity.Base{Id: uuid.New()}})
+		usecase := &Usecase{}
+
+		res, err := usecase.CreateApiKey(ctx, CreateApiKeyRequest{Name: "CI", Scopes: []string{consts.ScopeProfileRead}})
+
+		require.ErrorIs(t, err, consts.ErrImpersonationRestricted)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_RevokeApiKey(t *testing.T) {
+	t.Run("revokes the api key of the current user", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		apiKeyID := uuid.New()
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		usecase := &Usecase{apiKeyRepository: apiKeyRepository}
+
+		apiKeyRepository.EXPECT().RevokeByIdAndUserId(ctx, apiKeyID, user.Id, mock.AnythingOfType("time.Time")).Return(true, nil).Once()
+
+		err := usecase.RevokeApiKey(ctx, RevokeApiKeyRequest{Id: apiKeyID})
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns not found when no active api key of the user matches", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		usecase := &Usecase{apiKeyRepository: apiKeyRepository}
+
+		apiKeyRepository.EXPECT().RevokeByIdAndUserId(ctx, mock.Anything, user.Id, mock.Anything).Return(false, nil).Once()
+
+		err := usecase.RevokeApiKey(ctx, RevokeApiKeyRequest{Id: uuid.New()})
+
+		require.ErrorIs(t, err, consts.ErrApiKeyNotFound)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		expectedErr := errors.New("revoke api key")
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		usecase := &Usecase{apiKeyRepository: apiKeyRepository}
+
+		apiKeyRepository.EXPECT().RevokeByIdAndUserId(ctx, mock.Anything, user.Id, mock.Anything).Return(false, expectedErr).Once()
+
+		err := usecase.RevokeApiKey(ctx, RevokeApiKeyRequest{Id: uuid.New()})
+
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
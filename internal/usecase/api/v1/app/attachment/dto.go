@@ -0,0 +1,34 @@
+package attachment
+
+import (
+	"mime/multipart"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+type UploadAttachmentRequest struct {
+	File *multipart.FileHeader
+}
+
+type GetAttachmentRequest struct {
+	Id uuid.UUID
+}
+
+type CreateUploadRequest struct {
+	FileName    string `json:"fileName" validate:"required|maxLen:255" field:"fileName" label:"File name"`
+	ByteSize    int64  `json:"byteSize" validate:"required|min:1" field:"byteSize" label:"Byte size"`
+	ContentType string `json:"contentType" validate:"required|maxLen:255" field:"contentType" label:"Content type"`
+	Checksum    string `json:"checksum" validate:"required" field:"checksum" label:"Checksum"`
+}
+
+type CreateUploadResponse struct {
+	AttachmentId uuid.UUID         `json:"attachmentId"`
+	Url          string            `json:"url"`
+	Headers      map[string]string `json:"headers"`
+	ExpiresAt    time.Time         `json:"expiresAt"`
+}
+
+type ConfirmUploadRequest struct {
+	Id uuid.UUID
+}
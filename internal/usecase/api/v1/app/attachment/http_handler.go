@@ -0,0 +1,103 @@
+package attachment
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewHttpHandler)
+}
+
+type IHttpHandler interface {
+	UploadAttachment(c *echo.Context) error
+	GetAttachment(c *echo.Context) error
+	CreateUpload(c *echo.Context) error
+	ConfirmUpload(c *echo.Context) error
+}
+
+type HttpHandler struct {
+	usecase IUsecase
+}
+
+var _ IHttpHandler = (*HttpHandler)(nil)
+
+func NewHttpHandler(i do.Injector) (*HttpHandler, error) {
+	return &HttpHandler{
+		usecase: do.MustInvoke[*Usecase](i),
+	}, nil
+}
+
+// UploadAttachment limits the request body before the multipart form is
+// parsed, leaving room for the part headers around the largest allowed file.
+func (h *HttpHandler) UploadAttachment(c *echo.Context) error {
+	c.Request().Body = http.MaxBytesReader(c.Response(), c.Request().Body, attachmentRule.MaxByteSize+1<<20)
+
+	req := UploadAttachmentRequest{}
+	fileHeader, err := c.FormFile("file")
+	var maxBytesErr *http.MaxBytesError
+	if err == nil {
+		req.File = fileHeader
+	} else if errors.As(err, &maxBytesErr) {
+		return consts.ErrFileTooLarge
+	} else if !errors.Is(err, http.ErrMissingFile) && !errors.Is(err, http.ErrNotMultipart) {
+		return err
+	}
+
+	res, err := h.usecase.UploadAttachment(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetStatus(http.StatusCreated).SetData(res).Send()
+}
+
+func (h *HttpHandler) GetAttachment(c *echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return consts.ErrAttachmentNotFound
+	}
+
+	res, err := h.usecase.GetAttachment(c.Request().Context(), GetAttachmentRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetData(res).Send()
+}
+
+func (h *HttpHandler) CreateUpload(c *echo.Context) error {
+	req := CreateUploadRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.usecase.CreateUpload(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetStatus(http.StatusCreated).SetData(res).Send()
+}
+
+func (h *HttpHandler) ConfirmUpload(c *echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return consts.ErrAttachmentNotFound
+	}
+
+	res, err := h.usecase.ConfirmUpload(c.Request().Context(), ConfirmUploadRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetData(res).Send()
+}
@@ -0,0 +1,166 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/dto"
+	"github.com/google/uuid"
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestHttpHandler_UploadAttachment(t *testing.T) {
+	t.Run("passes the multipart file and returns the created attachment", func(t *testing.T) {
+		body := &bytes.Buffer{}
+		writer := multipart.NewWriter(body)
+		part, err := writer.CreateFormFile("file", "avatar.png")
+		require.NoError(t, err)
+		_, err = part.Write([]byte("image bytes"))
+		require.NoError(t, err)
+		require.NoError(t, writer.Close())
+
+		req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+		attachmentId := uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2")
+
+		usecase.EXPECT().UploadAttachment(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, req UploadAttachmentRequest) (*dto.AttachmentBlueprint, error) {
+			require.NotNil(t, req.File)
+			assert.Equal(t, "avatar.png", req.File.Filename)
+			return &dto.AttachmentBlueprint{Id: attachmentId, FileName: "avatar.png", ContentType: "image/png", ByteSize: 11, Metadata: map[string]any{"width": 3, "height": 2}, Url: "https://example.com/avatar.png"}, nil
+		}).Once()
+
+		err = httpHandler.UploadAttachment(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{
+			"ok": true,
+			"meta": null,
+			"data": {"id": "019e925f-3f42-76a0-8518-cb8e51c0b8e2", "fileName": "avatar.png", "contentType": "image/png", "byteSize": 11, "metadata": {"width": 3, "height": 2}, "url": "https://example.com/avatar.png"},
+			"errors": null
+		}`, rec.Body.String())
+	})
+
+	t.Run("rejects bodies larger than the largest allowed file before parsing them", func(t *testing.T) {
+		body, bodyWriter := io.Pipe()
+		writer := multipart.NewWriter(bodyWriter)
+		go func() {
+			part, err := writer.CreateFormFile("file", "large.bin")
+			if err == nil {
+				_, err = io.CopyN(part, zeroReader{}, attachmentRule.MaxByteSize+2<<20)
+			}
+			if err == nil {
+				err = writer.Close()
+			}
+			bodyWriter.CloseWithError(err)
+		}()
+		defer body.Close()
+
+		req := httptest.NewRequest(http.MethodPost, "/attachments", body)
+		req.Header.Set(echo.HeaderContentType, writer.FormDataContentType())
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		httpHandler := &HttpHandler{usecase: NewMockIUsecase(t)}
+
+		err := httpHandler.UploadAttachment(ctx)
+
+		require.ErrorIs(t, err, consts.ErrFileTooLarge)
+	})
+
+	t.Run("passes an empty request when no file is sent", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodPost, "/attachments", strings.NewReader(""))
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+
+		usecase.EXPECT().UploadAttachment(mock.Anything, UploadAttachmentRequest{}).Return(nil, consts.ErrUnauthorized).Once()
+
+		err := httpHandler.UploadAttachment(ctx)
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+	})
+}
+
+func TestHttpHandler_GetAttachment(t *testing.T) {
+	t.Run("returns not found for invalid ids", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/attachments/not-a-uuid", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: "not-a-uuid"}})
+		httpHandler := &HttpHandler{usecase: NewMockIUsecase(t)}
+
+		err := httpHandler.GetAttachment(ctx)
+
+		require.ErrorIs(t, err, consts.ErrAttachmentNotFound)
+	})
+}
+
+func TestHttpHandler_CreateUpload(t *testing.T) {
+	t.Run("binds the request and returns the upload slot", func(t *testing.T) {
+		body := `{"fileName":"report.pdf","byteSize":11,"contentType":"application/pdf","checksum":"Y2hlY2tzdW0="}`
+		req := httptest.NewRequest(http.MethodPost, "/attachments/uploads", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+		expectedReq := CreateUploadRequest{FileName: "report.pdf", ByteSize: 11, ContentType: "application/pdf", Checksum: "Y2hlY2tzdW0="}
+
+		usecase.EXPECT().CreateUpload(mock.Anything, expectedReq).Return(&CreateUploadResponse{
+			AttachmentId: uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2"),
+			Url:          "https://example.com/upload",
+			Headers:      map[string]string{"Content-Type": "application/pdf"},
+			ExpiresAt:    time.Date(2026, time.October, 18, 13, 0, 0, 0, time.UTC),
+		}, nil).Once()
+
+		err := httpHandler.CreateUpload(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusCreated, rec.Code)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"attachmentId":"019e925f-3f42-76a0-8518-cb8e51c0b8e2","url":"https://example.com/upload","headers":{"Content-Type":"application/pdf"},"expiresAt":"2026-10-18T13:00:00Z"},"errors":null}`, rec.Body.String())
+	})
+}
+
+func TestHttpHandler_ConfirmUpload(t *testing.T) {
+	t.Run("confirms the upload of the attachment in the path", func(t *testing.T) {
+		attachmentId := uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2")
+		req := httptest.NewRequest(http.MethodPost, "/attachments/"+attachmentId.String()+"/confirm", nil)
+		rec := httptest.NewRecorder()
+		ctx := echo.New().NewContext(req, rec)
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: attachmentId.String()}})
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase}
+
+		usecase.EXPECT().ConfirmUpload(mock.Anything, ConfirmUploadRequest{Id: attachmentId}).
+			Return(&dto.AttachmentBlueprint{Id: attachmentId, FileName: "report.pdf", ContentType: "application/pdf", ByteSize: 11, Url: "https://example.com/report.pdf"}, nil).Once()
+
+		err := httpHandler.ConfirmUpload(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"id":"019e925f-3f42-76a0-8518-cb8e51c0b8e2","fileName":"report.pdf","contentType":"application/pdf","byteSize":11,"metadata":null,"url":"https://example.com/report.pdf"},"errors":null}`, rec.Body.String())
+	})
+}
+
+type zeroReader struct{}
+
+func (zeroReader) Read(p []byte) (int, error) {
+	clear(p)
+	return len(p), nil
+}
@@ -0,0 +1,300 @@
+package attachment
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime"
+	"net/http"
+	"time"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/dto"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryAttachment "github.com/anonychun/bibit/internal/repository/attachment"
+	"github.com/anonychun/bibit/internal/storage"
+	"github.com/anonychun/bibit/internal/validation"
+	"github.com/samber/do/v2"
+)
+
+func init() {
+	do.Provide(bootstrap.Injector, NewUsecase)
+}
+
+// attachmentRule applies to every attachment, whichever way it is uploaded.
+// The wildcards do not match markup such as SVG, so neither the declared nor
+// the sniffed content type can be a document browsers run scripts from.
+var attachmentRule = validation.FileRule{
+	ContentTypes: []string{"image/*", "video/*", "audio/*", "application/pdf", "text/plain"},
+	MaxByteSize:  100 << 20,
+}
+
+type IUsecase interface {
+	UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (*dto.AttachmentBlueprint, error)
+	GetAttachment(ctx context.Context, req GetAttachmentRequest) (*dto.AttachmentBlueprint, error)
+	CreateUpload(ctx context.Context, req CreateUploadRequest) (*CreateUploadResponse, error)
+	ConfirmUpload(ctx context.Context, req ConfirmUploadRequest) (*dto.AttachmentBlueprint, error)
+}
+
+type Usecase struct {
+	config               *config.Config
+	validator            validation.IValidator
+	storage              storage.IStorage
+	attachmentRepository repositoryAttachment.IRepository
+}
+
+var _ IUsecase = (*Usecase)(nil)
+
+func NewUsecase(i do.Injector) (*Usecase, error) {
+	return &Usecase{
+		config:               do.MustInvoke[*config.Config](i),
+		validator:            do.MustInvoke[*validation.Validator](i),
+		storage:              do.MustInvoke[*storage.Storage](i),
+		attachmentRepository: do.MustInvoke[*repositoryAttachment.Repository](i),
+	}, nil
+}
+
+// UploadAttachment stores the file before inserting its row and deletes the
+// object again when the insert fails, so no row points at a missing object.
+func (u *Usecase) UploadAttachment(ctx context.Context, req UploadAttachmentRequest) (*dto.AttachmentBlueprint, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if req.File == nil {
+		validationErr := make(api.ValidationError)
+		validationErr.Add("file", "File is required")
+		return nil, validationErr
+	}
+
+	attachment, err := entity.NewAttachmentFromFileHeader(req.File)
+	if err != nil {
+		return nil, err
+	}
+	attachment.UserId = user.Id
+
+	validationErr := make(api.ValidationError)
+	attachmentRule.ValidateContentType(validationErr, "file", "File", attachment.ContentType)
+	attachmentRule.ValidateByteSize(validationErr, "file", "File", attachment.ByteSize)
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	file, err := req.File.Open()
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	err = u.storage.Put(ctx, attachment.ObjectName, file, storage.PutOptions{
+		ContentType: attachment.ContentType,
+		Checksum:    attachment.Checksum,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.attachmentRepository.Create(ctx, attachment)
+	if err != nil {
+		deleteErr := u.storage.Delete(context.WithoutCancel(ctx), attachment.ObjectName)
+		if deleteErr != nil {
+			return nil, errors.Join(err, deleteErr)
+		}
+
+		return nil, err
+	}
+
+	return dto.NewAttachmentBlueprint(ctx, attachment)
+}
+
+func (u *Usecase) GetAttachment(ctx context.Context, req GetAttachmentRequest) (*dto.AttachmentBlueprint, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	attachment, err := u.attachmentRepository.FindByIdAndUserId(ctx, req.Id, user.Id)
+	if err == sql.ErrNoRows {
+		return nil, consts.ErrAttachmentNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if !attachment.IsCommitted() {
+		return nil, consts.ErrAttachmentNotFound
+	}
+
+	return dto.NewAttachmentBlueprint(ctx, attachment)
+}
+
+// CreateUpload reserves a pending attachment and returns a presigned request
+// the client uses to upload the file directly to storage. The attachment is
+// only usable once ConfirmUpload succeeds before it expires.
+func (u *Usecase) CreateUpload(ctx context.Context, req CreateUploadRequest) (*CreateUploadResponse, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	validationErr := u.validator.Struct(&req)
+	checksum, err := base64.StdEncoding.DecodeString(req.Checksum)
+	if req.Checksum != "" && (err != nil || len(checksum) != sha256.Size) {
+		validationErr.Add("checksum", "Checksum must be a base64 encoded SHA-256 digest")
+	}
+
+	if req.ContentType != "" {
+		attachmentRule.ValidateContentType(validationErr, "contentType", "Content type", req.ContentType)
+	}
+	attachmentRule.ValidateByteSize(validationErr, "byteSize", "Byte size", req.ByteSize)
+
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	attachment := entity.NewPendingAttachment(req.FileName, req.ByteSize, req.ContentType, req.Checksum, time.Now().Add(u.config.Storage.UploadExpiration))
+	attachment.UserId = user.Id
+	presignedRequest, err := u.storage.PresignPut(ctx, attachment.ObjectName, storage.PutOptions{
+		ContentType:   req.ContentType,
+		ContentLength: req.ByteSize,
+		Checksum:      req.Checksum,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.attachmentRepository.Create(ctx, attachment)
+	if err != nil {
+		return nil, err
+	}
+
+	return &CreateUploadResponse{
+		AttachmentId: attachment.Id,
+		Url:          presignedRequest.Url,
+		Headers:      presignedRequest.Headers,
+		ExpiresAt:    attachment.ExpiresAt,
+	}, nil
+}
+
+// ConfirmUpload checks the uploaded object against the size, checksum and
+// content type declared in CreateUpload before committing the attachment. The
+// declared content type is signed into the upload and stored with the object,
+// so it is only kept when it matches the sniffed one. Objects that do not
+// match are deleted so the client can upload again.
+func (u *Usecase) ConfirmUpload(ctx context.Context, req ConfirmUploadRequest) (*dto.AttachmentBlueprint, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	attachment, err := u.attachmentRepository.FindByIdAndUserId(ctx, req.Id, user.Id)
+	if err == sql.ErrNoRows {
+		return nil, consts.ErrAttachmentNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	if attachment.IsCommitted() {
+		return dto.NewAttachmentBlueprint(ctx, attachment)
+	}
+
+	if attachment.IsExpired() {
+		return nil, consts.ErrUploadExpired
+	}
+
+	objectInfo, err := u.storage.Stat(ctx, attachment.ObjectName)
+	if errors.Is(err, storage.ErrObjectNotFound) {
+		return nil, consts.ErrUploadIncomplete
+	} else if err != nil {
+		return nil, err
+	}
+
+	if objectInfo.Size != attachment.ByteSize || objectInfo.Checksum != attachment.Checksum {
+		err = u.storage.Delete(ctx, attachment.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, consts.ErrUploadMismatch
+	}
+
+	contentType, err := u.detectContentType(ctx, attachment.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	validationErr := make(api.ValidationError)
+	attachmentRule.ValidateContentType(validationErr, "file", "File", contentType)
+	if validationErr.IsFail() {
+		err = u.storage.Delete(ctx, attachment.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, validationErr
+	}
+
+	if !isSameMediaType(attachment.ContentType, contentType) {
+		err = u.storage.Delete(ctx, attachment.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, consts.ErrUploadContentTypeMismatch
+	}
+
+	isCommitted, err := u.attachmentRepository.CommitById(ctx, attachment.Id, attachment.ContentType)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isCommitted {
+		return nil, consts.ErrAttachmentNotFound
+	}
+
+	attachment.Status = entity.AttachmentStatusCommitted
+	attachment.ExpiresAt = time.Time{}
+
+	return dto.NewAttachmentBlueprint(ctx, attachment)
+}
+
+// detectContentType sniffs the content type from the leading bytes of the
+// object, like entity.Attachment does for server-side uploads.
+func (u *Usecase) detectContentType(ctx context.Context, objectName string) (string, error) {
+	body, err := u.storage.Get(ctx, objectName)
+	if err != nil {
+		return "", err
+	}
+	defer body.Close()
+
+	head := make([]byte, 512)
+	n, err := io.ReadFull(body, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return "", err
+	}
+
+	return http.DetectContentType(head[:n]), nil
+}
+
+// isSameMediaType compares content types ignoring parameters such as the
+// charset, which sniffing adds for text.
+func isSameMediaType(a, b string) bool {
+	aMediaType, _, err := mime.ParseMediaType(a)
+	if err != nil {
+		return false
+	}
+
+	bMediaType, _, err := mime.ParseMediaType(b)
+	if err != nil {
+		return false
+	}
+
+	return aMediaType == bMediaType
+}
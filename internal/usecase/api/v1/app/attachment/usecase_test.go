@@ -0,0 +1,521 @@
+package attachment
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"io"
+	"mime/multipart"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/config"
+	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/current"
+	"github.com/anonychun/bibit/internal/entity"
+	repositoryAttachment "github.com/anonychun/bibit/internal/repository/attachment"
+	"github.com/anonychun/bibit/internal/storage"
+	"github.com/anonychun/bibit/internal/validation"
+	"github.com/google/uuid"
+	"github.com/samber/do/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUsecase_UploadAttachment(t *testing.T) {
+	t.Run("stores the file and inserts the attachment", func(t *testing.T) {
+		registerMemoryStorage(t)
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+		req := UploadAttachmentRequest{File: newFileHeader(t, "avatar.png", testPNGContent)}
+		var objectName string
+
+		objectStorage.EXPECT().Put(ctx, mock.Anything, mock.Anything, storage.PutOptions{ContentType: "image/png", Checksum: testPNGChecksum}).
+			RunAndReturn(func(ctx context.Context, key string, body io.Reader, opts storage.PutOptions) error {
+				data, err := io.ReadAll(body)
+				require.NoError(t, err)
+				assert.Equal(t, testPNGContent, string(data))
+				objectName = key
+				return nil
+			}).Once()
+		attachmentRepository.EXPECT().Create(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, attachment *entity.Attachment) error {
+			assert.Equal(t, user.Id, attachment.UserId)
+			assert.Equal(t, objectName, attachment.ObjectName)
+			assert.Equal(t, "avatar.png", attachment.FileName)
+			assert.Equal(t, int64(len(testPNGContent)), attachment.ByteSize)
+			assert.Equal(t, "image/png", attachment.ContentType)
+			assert.Equal(t, testPNGChecksum, attachment.Checksum)
+			attachment.Id = uuid.New()
+			return nil
+		}).Once()
+
+		res, err := usecase.UploadAttachment(ctx, req)
+
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.NotEqual(t, uuid.Nil, res.Id)
+		assert.Equal(t, "avatar.png", res.FileName)
+		assert.Contains(t, res.Url, objectName)
+	})
+
+	t.Run("deletes the stored object when the insert fails", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		expectedErr := errors.New("insert attachment")
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+		req := UploadAttachmentRequest{File: newFileHeader(t, "avatar.png", "image bytes")}
+		var objectName string
+
+		objectStorage.EXPECT().Put(ctx, mock.Anything, mock.Anything, mock.Anything).
+			RunAndReturn(func(ctx context.Context, key string, body io.Reader, opts storage.PutOptions) error {
+				objectName = key
+				return nil
+			}).Once()
+		attachmentRepository.EXPECT().Create(ctx, mock.Anything).Return(expectedErr).Once()
+		objectStorage.EXPECT().Delete(mock.Anything, mock.Anything).RunAndReturn(func(ctx context.Context, key string) error {
+			assert.Equal(t, objectName, key)
+			return nil
+		}).Once()
+
+		res, err := usecase.UploadAttachment(ctx, req)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, res)
+	})
+
+	t.Run("does not insert the attachment when the upload fails", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		expectedErr := errors.New("put object")
+		objectStorage := storage.NewMockIStorage(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: repositoryAttachment.NewMockIRepository(t)}
+		req := UploadAttachmentRequest{File: newFileHeader(t, "avatar.png", "image bytes")}
+
+		objectStorage.EXPECT().Put(ctx, mock.Anything, mock.Anything, mock.Anything).Return(expectedErr).Once()
+
+		res, err := usecase.UploadAttachment(ctx, req)
+
+		require.ErrorIs(t, err, expectedErr)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns a validation error for content types that are not allowed", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		usecase := &Usecase{}
+		req := UploadAttachmentRequest{File: newFileHeader(t, "avatar.png", "<html><script>alert(1)</script></html>")}
+
+		res, err := usecase.UploadAttachment(ctx, req)
+
+		validationErr := api.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "file")
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns a validation error for files larger than allowed", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		usecase := &Usecase{}
+		req := UploadAttachmentRequest{File: newFileHeader(t, "avatar.png", testPNGContent)}
+		req.File.Size = attachmentRule.MaxByteSize + 1
+
+		res, err := usecase.UploadAttachment(ctx, req)
+
+		validationErr := api.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "file")
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns a validation error without a file", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		usecase := &Usecase{}
+
+		res, err := usecase.UploadAttachment(ctx, UploadAttachmentRequest{})
+
+		validationErr := api.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "file")
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns unauthorized without a current user", func(t *testing.T) {
+		usecase := &Usecase{}
+
+		res, err := usecase.UploadAttachment(context.Background(), UploadAttachmentRequest{})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_GetAttachment(t *testing.T) {
+	t.Run("returns the attachment blueprint", func(t *testing.T) {
+		registerMemoryStorage(t)
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := &entity.Attachment{Base: entity.Base{Id: uuid.New()}, ObjectName: "01JZ.png", FileName: "avatar.png", Status: entity.AttachmentStatusCommitted}
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+
+		res, err := usecase.GetAttachment(ctx, GetAttachmentRequest{Id: attachment.Id})
+
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, attachment.Id, res.Id)
+		assert.Equal(t, "avatar.png", res.FileName)
+		assert.Contains(t, res.Url, "01JZ.png")
+	})
+
+	t.Run("returns not found for pending attachments", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("report.pdf", 11, "application/pdf", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+
+		res, err := usecase.GetAttachment(ctx, GetAttachmentRequest{Id: attachment.Id})
+
+		require.ErrorIs(t, err, consts.ErrAttachmentNotFound)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns not found for unknown attachments", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		id := uuid.New()
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, id, user.Id).Return(nil, sql.ErrNoRows).Once()
+
+		res, err := usecase.GetAttachment(ctx, GetAttachmentRequest{Id: id})
+
+		require.ErrorIs(t, err, consts.ErrAttachmentNotFound)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_CreateUpload(t *testing.T) {
+	t.Run("reserves a pending attachment and returns a presigned request", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		cfg := &config.Config{}
+		cfg.Storage.UploadExpiration = time.Hour
+		validator := validation.NewMockIValidator(t)
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{config: cfg, validator: validator, storage: objectStorage, attachmentRepository: attachmentRepository}
+		req := CreateUploadRequest{FileName: "report.pdf", ByteSize: 11, ContentType: "application/pdf", Checksum: testChecksum}
+		attachmentId := uuid.New()
+		presignedRequest := &storage.PresignedRequest{Url: "https://example.com/upload", Headers: map[string]string{"Content-Type": "application/pdf"}}
+		var objectName string
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+		objectStorage.EXPECT().PresignPut(ctx, mock.Anything, storage.PutOptions{ContentType: "application/pdf", ContentLength: 11, Checksum: testChecksum}).
+			RunAndReturn(func(ctx context.Context, key string, opts storage.PutOptions) (*storage.PresignedRequest, error) {
+				objectName = key
+				return presignedRequest, nil
+			}).Once()
+		attachmentRepository.EXPECT().Create(ctx, mock.Anything).RunAndReturn(func(ctx context.Context, attachment *entity.Attachment) error {
+			assert.Equal(t, user.Id, attachment.UserId)
+			assert.Equal(t, objectName, attachment.ObjectName)
+			assert.Equal(t, "report.pdf", attachment.FileName)
+			assert.Equal(t, int64(11), attachment.ByteSize)
+			assert.Equal(t, testChecksum, attachment.Checksum)
+			assert.Equal(t, entity.AttachmentStatusPending, attachment.Status)
+			assert.WithinDuration(t, time.Now().Add(time.Hour), attachment.ExpiresAt, time.Minute)
+			attachment.Id = attachmentId
+			return nil
+		}).Once()
+
+		res, err := usecase.CreateUpload(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, attachmentId, res.AttachmentId)
+		assert.Equal(t, "https://example.com/upload", res.Url)
+		assert.Equal(t, presignedRequest.Headers, res.Headers)
+		assert.WithinDuration(t, time.Now().Add(time.Hour), res.ExpiresAt, time.Minute)
+	})
+
+	t.Run("returns a validation error for malformed checksums", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+		req := CreateUploadRequest{FileName: "report.pdf", ByteSize: 11, Checksum: "not-a-digest"}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+
+		res, err := usecase.CreateUpload(ctx, req)
+
+		validationErr := api.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "checksum")
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns a validation error for svg images", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+		req := CreateUploadRequest{FileName: "avatar.svg", ByteSize: 11, ContentType: "image/svg+xml", Checksum: testChecksum}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+
+		res, err := usecase.CreateUpload(ctx, req)
+
+		validationErr := api.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "contentType")
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns a validation error for files larger than allowed", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+		req := CreateUploadRequest{FileName: "report.pdf", ByteSize: attachmentRule.MaxByteSize + 1, Checksum: testChecksum}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+
+		res, err := usecase.CreateUpload(ctx, req)
+
+		validationErr := api.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "byteSize")
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns unauthorized without a current user", func(t *testing.T) {
+		usecase := &Usecase{}
+
+		res, err := usecase.CreateUpload(context.Background(), CreateUploadRequest{})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_ConfirmUpload(t *testing.T) {
+	t.Run("commits the attachment when the uploaded object matches", func(t *testing.T) {
+		registerMemoryStorage(t)
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("report.pdf", 11, "application/pdf", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+		objectStorage.EXPECT().Stat(ctx, attachment.ObjectName).Return(&storage.ObjectInfo{Size: 11, Checksum: testChecksum}, nil).Once()
+		objectStorage.EXPECT().Get(ctx, attachment.ObjectName).Return(io.NopCloser(strings.NewReader("%PDF-1.7\n")), nil).Once()
+		attachmentRepository.EXPECT().CommitById(ctx, attachment.Id, "application/pdf").Return(true, nil).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		require.NoError(t, err)
+		assert.Equal(t, attachment.Id, res.Id)
+		assert.Equal(t, "report.pdf", res.FileName)
+		assert.Equal(t, "application/pdf", res.ContentType)
+		assert.True(t, attachment.IsCommitted())
+	})
+
+	t.Run("keeps the declared content type when the sniffed one only adds parameters", func(t *testing.T) {
+		registerMemoryStorage(t)
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("notes.txt", 11, "text/plain", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+		objectStorage.EXPECT().Stat(ctx, attachment.ObjectName).Return(&storage.ObjectInfo{Size: 11, Checksum: testChecksum}, nil).Once()
+		objectStorage.EXPECT().Get(ctx, attachment.ObjectName).Return(io.NopCloser(strings.NewReader("image bytes")), nil).Once()
+		attachmentRepository.EXPECT().CommitById(ctx, attachment.Id, "text/plain").Return(true, nil).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain", res.ContentType)
+	})
+
+	t.Run("deletes the object when its sniffed content type differs from the declared one", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("avatar.png", 11, "application/pdf", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+		objectStorage.EXPECT().Stat(ctx, attachment.ObjectName).Return(&storage.ObjectInfo{Size: 11, Checksum: testChecksum}, nil).Once()
+		objectStorage.EXPECT().Get(ctx, attachment.ObjectName).Return(io.NopCloser(strings.NewReader(testPNGContent)), nil).Once()
+		objectStorage.EXPECT().Delete(ctx, attachment.ObjectName).Return(nil).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		require.ErrorIs(t, err, consts.ErrUploadContentTypeMismatch)
+		assert.Nil(t, res)
+	})
+
+	t.Run("deletes the object when its sniffed content type is not allowed", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("avatar.png", 11, "image/png", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+		objectStorage.EXPECT().Stat(ctx, attachment.ObjectName).Return(&storage.ObjectInfo{Size: 11, Checksum: testChecksum}, nil).Once()
+		objectStorage.EXPECT().Get(ctx, attachment.ObjectName).Return(io.NopCloser(strings.NewReader("<html><script>alert(1)</script></html>")), nil).Once()
+		objectStorage.EXPECT().Delete(ctx, attachment.ObjectName).Return(nil).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		validationErr := api.ValidationError{}
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "file")
+		assert.Nil(t, res)
+	})
+
+	t.Run("deletes the object when its size or checksum does not match", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("report.pdf", 11, "application/pdf", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+		objectStorage.EXPECT().Stat(ctx, attachment.ObjectName).Return(&storage.ObjectInfo{Size: 11, Checksum: "other"}, nil).Once()
+		objectStorage.EXPECT().Delete(ctx, attachment.ObjectName).Return(nil).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		require.ErrorIs(t, err, consts.ErrUploadMismatch)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns upload incomplete when the object does not exist yet", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("report.pdf", 11, "application/pdf", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+		objectStorage.EXPECT().Stat(ctx, attachment.ObjectName).Return(nil, storage.ErrObjectNotFound).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		require.ErrorIs(t, err, consts.ErrUploadIncomplete)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns upload expired for expired pending attachments", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("report.pdf", 11, "application/pdf", testChecksum, time.Now().Add(-time.Minute))
+		attachment.Id = uuid.New()
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		require.ErrorIs(t, err, consts.ErrUploadExpired)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns not found when the attachment was committed or purged concurrently", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		attachment := entity.NewPendingAttachment("report.pdf", 11, "application/pdf", testChecksum, time.Now().Add(time.Hour))
+		attachment.Id = uuid.New()
+		objectStorage := storage.NewMockIStorage(t)
+		attachmentRepository := repositoryAttachment.NewMockIRepository(t)
+		usecase := &Usecase{storage: objectStorage, attachmentRepository: attachmentRepository}
+
+		attachmentRepository.EXPECT().FindByIdAndUserId(ctx, attachment.Id, user.Id).Return(attachment, nil).Once()
+		objectStorage.EXPECT().Stat(ctx, attachment.ObjectName).Return(&storage.ObjectInfo{Size: 11, Checksum: testChecksum}, nil).Once()
+		objectStorage.EXPECT().Get(ctx, attachment.ObjectName).Return(io.NopCloser(strings.NewReader("%PDF-1.7\n")), nil).Once()
+		attachmentRepository.EXPECT().CommitById(ctx, attachment.Id, "application/pdf").Return(false, nil).Once()
+
+		res, err := usecase.ConfirmUpload(ctx, ConfirmUploadRequest{Id: attachment.Id})
+
+		require.ErrorIs(t, err, consts.ErrAttachmentNotFound)
+		assert.Nil(t, res)
+	})
+}
+
+// testChecksum is the base64 encoded SHA-256 digest of "image bytes".
+var testChecksum = func() string {
+	digest := sha256.Sum256([]byte("image bytes"))
+	return base64.StdEncoding.EncodeToString(digest[:])
+}()
+
+const testPNGContent = "\x89PNG\r\n\x1a\nimage bytes"
+
+var testPNGChecksum = func() string {
+	digest := sha256.Sum256([]byte(testPNGContent))
+	return base64.StdEncoding.EncodeToString(digest[:])
+}()
+
+func newFileHeader(t *testing.T, fileName, content string) *multipart.FileHeader {
+	t.Helper()
+
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile("file", fileName)
+	require.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+
+	req := httptest.NewRequest("POST", "/attachments", body)
+	req.Header.Set("Content-Type", writer.FormDataContentType())
+	require.NoError(t, req.ParseMultipartForm(1<<20))
+
+	fileHeader := req.MultipartForm.File["file"][0]
+	fileHeader.Header.Set("Content-Type", "image/png")
+	return fileHeader
+}
+
+func registerMemoryStorage(t *testing.T) {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Storage.Driver = "memory"
+	i := do.New()
+	do.ProvideValue(i, cfg)
+
+	objectStorage, err := storage.NewStorage(i)
+	require.NoError(t, err)
+
+	do.OverrideValue(bootstrap.Injector, objectStorage)
+}
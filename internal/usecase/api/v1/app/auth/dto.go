@@ -1,6 +1,10 @@
 package auth
 
-import "github.com/google/uuid"
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
 
 type SignUpRequest struct {
 	IpAddress    string `json:"-"`
@@ -11,7 +15,8 @@ type SignUpRequest struct {
 }
 
 type SignUpResponse struct {
-	Token string
+	Token     string    `json:"token"`
+	ExpiresAt time.Time `json:"expiresAt"`
 }
 
 type SignInRequest struct {
@@ -22,17 +27,130 @@ type SignInRequest struct {
 }
 
 type SignInResponse struct {
-	Token string
+	Token                string    `json:"token,omitempty"`
+	ExpiresAt            time.Time `json:"expiresAt"`
+	SecondFactorRequired bool      `json:"secondFactorRequired"`
+	ChallengeToken       string    `json:"challengeToken,omitempty"`
+}
+
+type CompleteSignInRequest struct {
+	IpAddress      string `json:"-"`
+	UserAgent      string `json:"-"`
+	ChallengeToken string `json:"challengeToken" validate:"required" field:"challengeToken" label:"Challenge token"`
+	Code           string `json:"code" validate:"required" field:"code" label:"Code"`
+}
+
+type StartOidcSignInRequest struct {
+	Provider string
+}
+
+type StartOidcSignInResponse struct {
+	AuthorizationUrl string    `json:"authorizationUrl"`
+	StateDigest      string    `json:"-"`
+	ExpiresAt        time.Time `json:"-"`
+}
+
+type CompleteOidcSignInRequest struct {
+	IpAddress   string `json:"-"`
+	UserAgent   string `json:"-"`
+	Provider    string `json:"-"`
+	StateDigest string `json:"-"`
+	Code        string `json:"code" validate:"required" field:"code" label:"Code"`
+	State       string `json:"state" validate:"required" field:"state" label:"State"`
 }
 
 type SignOutRequest struct {
 	Token string
 }
 
+type RequestPasswordResetRequest struct {
+	EmailAddress string `json:"emailAddress" validate:"required|email" field:"emailAddress" label:"Email address"`
+}
+
+type ResetPasswordRequest struct {
+	Token    string `json:"token" validate:"required" field:"token" label:"Token"`
+	Password string `json:"password" validate:"required|minLen:8" field:"password" label:"Password"`
+}
+
+type RequestMagicLinkRequest struct {
+	EmailAddress string `json:"emailAddress" validate:"required|email" field:"emailAddress" label:"Email address"`
+}
+
+type ConsumeMagicLinkRequest struct {
+	IpAddress string `json:"-"`
+	UserAgent string `json:"-"`
+	Token     string `json:"token" validate:"required" field:"token" label:"Token"`
+}
+
+type VerifyEmailAddressRequest struct {
+	Token string `json:"token" validate:"required" field:"token" label:"Token"`
+}
+
+type ResendEmailVerificationRequest struct {
+	EmailAddress string `json:"emailAddress" validate:"required|email" field:"emailAddress" label:"Email address"`
+}
+
+type EnrollTotpResponse struct {
+	Secret string `json:"secret"`
+	Uri    string `json:"uri"`
+}
+
+type ConfirmTotpRequest struct {
+	Code string `json:"code" validate:"required" field:"code" label:"Code"`
+}
+
+type ConfirmTotpResponse struct {
+	RecoveryCodes []string `json:"recoveryCodes"`
+}
+
+type ListSessionsResponse struct {
+	Sessions []UserSessionResponse `json:"sessions"`
+}
+
+type UserSessionResponse struct {
+	Id         uuid.UUID `json:"id"`
+	IpAddress  string    `json:"ipAddress"`
+	UserAgent  string    `json:"userAgent"`
+	LastSeenAt time.Time `json:"lastSeenAt"`
+	ExpiresAt  time.Time `json:"expiresAt"`
+	CreatedAt  time.Time `json:"createdAt"`
+	Current    bool      `json:"current"`
+}
+
+type RevokeSessionRequest struct {
+	Id uuid.UUID
+}
+
 type MeResponse struct {
 	User struct {
 		Id           uuid.UUID `json:"id"`
 		Name         string    `json:"name"`
 		EmailAddress string    `json:"emailAddress"`
 	} `json:"user"`
+	Impersonated bool                  `json:"impersonated"`
+	Impersonator *ImpersonatorResponse `json:"impersonator,omitempty"`
+}
+
+type ImpersonatorResponse struct {
+	Id           uuid.UUID `json:"id"`
+	Name         string    `json:"name"`
+	EmailAddress string    `json:"emailAddress"`
+}
+
+type UpdateProfileRequest struct {
+	Name string `json:"name" validate:"required" field:"name" label:"Name"`
+}
+
+type ChangePasswordRequest struct {
+	CurrentPassword string `json:"currentPassword"`
+	NewPassword     string `json:"newPassword" validate:"required|minLen:8" field:"newPassword" label:"New password"`
+}
+
+type ChangeEmailAddressRequest struct {
+	EmailAddress    string `json:"emailAddress" validate:"required|email" field:"emailAddress" label:"Email address"`
+	CurrentPassword string `json:"currentPassword"`
+}
+
+type DeleteAccountRequest struct {
+	CurrentPassword string `json:"currentPassword"`
 }
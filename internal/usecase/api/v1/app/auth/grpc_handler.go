@@ -4,9 +4,14 @@ import (
 	"context"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
+	"github.com/anonychun/bibit/internal/consts"
 	"github.com/anonychun/bibit/internal/util"
 	pb "github.com/anonychun/bibit/pkg/pb/api/v1/app/auth"
+	"github.com/google/uuid"
 	"github.com/samber/do/v2"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func init() {
@@ -44,7 +49,10 @@ func (h *GrpcHandler) SignUp(ctx context.Context, req *pb.SignUpRequest) (*pb.Si
 		return nil, err
 	}
 
-	return &pb.SignUpResponse{Token: res.Token}, nil
+	return &pb.SignUpResponse{
+		Token:     res.Token,
+		ExpiresAt: timestamppb.New(res.ExpiresAt),
+	}, nil
 }
 
 func (h *GrpcHandler) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.SignInResponse, error) {
@@ -60,13 +68,82 @@ func (h *GrpcHandler) SignIn(ctx context.Context, req *pb.SignInRequest) (*pb.Si
 		return nil, err
 	}
 
-	return &pb.SignInResponse{Token: res.Token}, nil
+	return &pb.SignInResponse{
+		Token:                res.Token,
+		ExpiresAt:            timestamppb.New(res.ExpiresAt),
+		SecondFactorRequired: res.SecondFactorRequired,
+		ChallengeToken:       res.ChallengeToken,
+	}, nil
+}
+
+func (h *GrpcHandler) CompleteSignIn(ctx context.Context, req *pb.CompleteSignInRequest) (*pb.CompleteSignInResponse, error) {
+	usecaseReq := CompleteSignInRequest{
+		IpAddress:      util.GrpcPeerAddress(ctx),
+		UserAgent:      util.GrpcMetadataValue(ctx, "user-agent"),
+		ChallengeToken: req.GetChallengeToken(),
+		Code:           req.GetCode(),
+	}
+
+	res, err := h.usecase.CompleteSignIn(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompleteSignInResponse{
+		Token:     res.Token,
+		ExpiresAt: timestamppb.New(res.ExpiresAt),
+	}, nil
+}
+
+func (h *GrpcHandler) StartOidcSignIn(ctx context.Context, req *pb.StartOidcSignInRequest) (*pb.StartOidcSignInResponse, error) {
+	usecaseReq := StartOidcSignInRequest{
+		Provider: req.GetProvider(),
+	}
+
+	res, err := h.usecase.StartOidcSignIn(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clients send the digest back as metadata of CompleteOidcSignIn, the
+	// same way browsers return the state cookie.
+	err = grpc.SetHeader(ctx, metadata.Pairs(consts.HeaderOidcStateDigest, res.StateDigest))
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.StartOidcSignInResponse{
+		AuthorizationUrl: res.AuthorizationUrl,
+	}, nil
+}
+
+func (h *GrpcHandler) CompleteOidcSignIn(ctx context.Context, req *pb.CompleteOidcSignInRequest) (*pb.CompleteOidcSignInResponse, error) {
+	usecaseReq := CompleteOidcSignInRequest{
+		IpAddress:   util.GrpcPeerAddress(ctx),
+		UserAgent:   util.GrpcMetadataValue(ctx, "user-agent"),
+		Provider:    req.GetProvider(),
+		StateDigest: util.GrpcMetadataValue(ctx, consts.HeaderOidcStateDigest),
+		Code:        req.GetCode(),
+		State:       req.GetState(),
+	}
+
+	res, err := h.usecase.CompleteOidcSignIn(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CompleteOidcSignInResponse{
+		Token:                res.Token,
+		ExpiresAt:            timestamppb.New(res.ExpiresAt),
+		SecondFactorRequired: res.SecondFactorRequired,
+		ChallengeToken:       res.ChallengeToken,
+	}, nil
 }
 
 func (h *GrpcHandler) SignOut(ctx context.Context, req *pb.SignOutRequest) (*pb.SignOutResponse, error) {
 	token := req.GetToken()
 	if token == "" {
-		token = util.GrpcMetadataValue(ctx, "authorization")
+		token = util.GrpcSessionToken(ctx)
 	}
 
 	usecaseReq := SignOutRequest{
@@ -81,13 +158,200 @@ func (h *GrpcHandler) SignOut(ctx context.Context, req *pb.SignOutRequest) (*pb.
 	return &pb.SignOutResponse{}, nil
 }
 
+func (h *GrpcHandler) RequestPasswordReset(ctx context.Context, req *pb.RequestPasswordResetRequest) (*pb.RequestPasswordResetResponse, error) {
+	usecaseReq := RequestPasswordResetRequest{
+		EmailAddress: req.GetEmailAddress(),
+	}
+
+	err := h.usecase.RequestPasswordReset(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestPasswordResetResponse{}, nil
+}
+
+func (h *GrpcHandler) ResetPassword(ctx context.Context, req *pb.ResetPasswordRequest) (*pb.ResetPasswordResponse, error) {
+	usecaseReq := ResetPasswordRequest{
+		Token:    req.GetToken(),
+		Password: req.GetPassword(),
+	}
+
+	err := h.usecase.ResetPassword(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResetPasswordResponse{}, nil
+}
+
+func (h *GrpcHandler) RequestMagicLink(ctx context.Context, req *pb.RequestMagicLinkRequest) (*pb.RequestMagicLinkResponse, error) {
+	usecaseReq := RequestMagicLinkRequest{
+		EmailAddress: req.GetEmailAddress(),
+	}
+
+	err := h.usecase.RequestMagicLink(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RequestMagicLinkResponse{}, nil
+}
+
+func (h *GrpcHandler) ConsumeMagicLink(ctx context.Context, req *pb.ConsumeMagicLinkRequest) (*pb.ConsumeMagicLinkResponse, error) {
+	usecaseReq := ConsumeMagicLinkRequest{
+		IpAddress: util.GrpcPeerAddress(ctx),
+		UserAgent: util.GrpcMetadataValue(ctx, "user-agent"),
+		Token:     req.GetToken(),
+	}
+
+	res, err := h.usecase.ConsumeMagicLink(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConsumeMagicLinkResponse{
+		Token:                res.Token,
+		ExpiresAt:            timestamppb.New(res.ExpiresAt),
+		SecondFactorRequired: res.SecondFactorRequired,
+		ChallengeToken:       res.ChallengeToken,
+	}, nil
+}
+
+func (h *GrpcHandler) VerifyEmailAddress(ctx context.Context, req *pb.VerifyEmailAddressRequest) (*pb.VerifyEmailAddressResponse, error) {
+	usecaseReq := VerifyEmailAddressRequest{
+		Token: req.GetToken(),
+	}
+
+	err := h.usecase.VerifyEmailAddress(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.VerifyEmailAddressResponse{}, nil
+}
+
+func (h *GrpcHandler) ResendEmailVerification(ctx context.Context, req *pb.ResendEmailVerificationRequest) (*pb.ResendEmailVerificationResponse, error) {
+	usecaseReq := ResendEmailVerificationRequest{
+		EmailAddress: req.GetEmailAddress(),
+	}
+
+	err := h.usecase.ResendEmailVerification(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ResendEmailVerificationResponse{}, nil
+}
+
+func (h *GrpcHandler) EnrollTotp(ctx context.Context, _ *pb.EnrollTotpRequest) (*pb.EnrollTotpResponse, error) {
+	res, err := h.usecase.EnrollTotp(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.EnrollTotpResponse{
+		Secret: res.Secret,
+		Uri:    res.Uri,
+	}, nil
+}
+
+func (h *GrpcHandler) ConfirmTotp(ctx context.Context, req *pb.ConfirmTotpRequest) (*pb.ConfirmTotpResponse, error) {
+	usecaseReq := ConfirmTotpRequest{
+		Code: req.GetCode(),
+	}
+
+	res, err := h.usecase.ConfirmTotp(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ConfirmTotpResponse{
+		RecoveryCodes: res.RecoveryCodes,
+	}, nil
+}
+
+func (h *GrpcHandler) ListSessions(ctx context.Context, _ *pb.ListSessionsRequest) (*pb.ListSessionsResponse, error) {
+	res, err := h.usecase.ListSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sessions := make([]*pb.ListSessionsResponse_Session, len(res.Sessions))
+	for i, session := range res.Sessions {
+		sessions[i] = &pb.ListSessionsResponse_Session{
+			Id:         session.Id.String(),
+			IpAddress:  session.IpAddress,
+			UserAgent:  session.UserAgent,
+			LastSeenAt: timestamppb.New(session.LastSeenAt),
+			ExpiresAt:  timestamppb.New(session.ExpiresAt),
+			CreatedAt:  timestamppb.New(session.CreatedAt),
+			Current:    session.Current,
+		}
+	}
+
+	return &pb.ListSessionsResponse{Sessions: sessions}, nil
+}
+
+func (h *GrpcHandler) RevokeSession(ctx context.Context, req *pb.RevokeSessionRequest) (*pb.RevokeSessionResponse, error) {
+	id, err := uuid.Parse(req.GetId())
+	if err != nil {
+		return nil, consts.ErrUserSessionNotFound
+	}
+
+	err = h.usecase.RevokeSession(ctx, RevokeSessionRequest{Id: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeSessionResponse{}, nil
+}
+
+func (h *GrpcHandler) RevokeOtherSessions(ctx context.Context, _ *pb.RevokeOtherSessionsRequest) (*pb.RevokeOtherSessionsResponse, error) {
+	err := h.usecase.RevokeOtherSessions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RevokeOtherSessionsResponse{}, nil
+}
+
 func (h *GrpcHandler) Me(ctx context.Context, _ *pb.MeRequest) (*pb.MeResponse, error) {
 	res, err := h.usecase.Me(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.MeResponse{
+	pbRes := &pb.MeResponse{
+		User: &pb.MeResponse_User{
+			Id:           res.User.Id.String(),
+			Name:         res.User.Name,
+			EmailAddress: res.User.EmailAddress,
+		},
+		Impersonated: res.Impersonated,
+	}
+	if res.Impersonator != nil {
+		pbRes.Impersonator = &pb.MeResponse_User{
+			Id:           res.Impersonator.Id.String(),
+			Name:         res.Impersonator.Name,
+			EmailAddress: res.Impersonator.EmailAddress,
+		}
+	}
+
+	return pbRes, nil
+}
+
+func (h *GrpcHandler) UpdateProfile(ctx context.Context, req *pb.UpdateProfileRequest) (*pb.UpdateProfileResponse, error) {
+	usecaseReq := UpdateProfileRequest{
+		Name: req.GetName(),
+	}
+
+	res, err := h.usecase.UpdateProfile(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateProfileResponse{
 		User: &pb.MeResponse_User{
 			Id:           res.User.Id.String(),
 			Name:         res.User.Name,
@@ -95,3 +359,44 @@ func (h *GrpcHandler) Me(ctx context.Context, _ *pb.MeRequest) (*pb.MeResponse,
 		},
 	}, nil
 }
+
+func (h *GrpcHandler) ChangePassword(ctx context.Context, req *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	usecaseReq := ChangePasswordRequest{
+		CurrentPassword: req.GetCurrentPassword(),
+		NewPassword:     req.GetNewPassword(),
+	}
+
+	err := h.usecase.ChangePassword(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangePasswordResponse{}, nil
+}
+
+func (h *GrpcHandler) ChangeEmailAddress(ctx context.Context, req *pb.ChangeEmailAddressRequest) (*pb.ChangeEmailAddressResponse, error) {
+	usecaseReq := ChangeEmailAddressRequest{
+		EmailAddress:    req.GetEmailAddress(),
+		CurrentPassword: req.GetCurrentPassword(),
+	}
+
+	err := h.usecase.ChangeEmailAddress(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangeEmailAddressResponse{}, nil
+}
+
+func (h *GrpcHandler) DeleteAccount(ctx context.Context, req *pb.DeleteAccountRequest) (*pb.DeleteAccountResponse, error) {
+	usecaseReq := DeleteAccountRequest{
+		CurrentPassword: req.GetCurrentPassword(),
+	}
+
+	err := h.usecase.DeleteAccount(ctx, usecaseReq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteAccountResponse{}, nil
+}
@@ -6,6 +6,9 @@ import (
 	"github.com/anonychun/bibit/internal/api"
 	"github.com/anonychun/bibit/internal/bootstrap"
 	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/cookie"
+	"github.com/anonychun/bibit/internal/util"
+	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
 	"github.com/samber/do/v2"
 )
@@ -17,12 +20,31 @@ func init() {
 type IHttpHandler interface {
 	SignUp(c *echo.Context) error
 	SignIn(c *echo.Context) error
+	CompleteSignIn(c *echo.Context) error
+	StartOidcSignIn(c *echo.Context) error
+	CompleteOidcSignIn(c *echo.Context) error
 	SignOut(c *echo.Context) error
+	RequestPasswordReset(c *echo.Context) error
+	ResetPassword(c *echo.Context) error
+	RequestMagicLink(c *echo.Context) error
+	ConsumeMagicLink(c *echo.Context) error
+	VerifyEmailAddress(c *echo.Context) error
+	ResendEmailVerification(c *echo.Context) error
+	EnrollTotp(c *echo.Context) error
+	ConfirmTotp(c *echo.Context) error
+	ListSessions(c *echo.Context) error
+	RevokeSession(c *echo.Context) error
+	RevokeOtherSessions(c *echo.Context) error
 	Me(c *echo.Context) error
+	UpdateProfile(c *echo.Context) error
+	ChangePassword(c *echo.Context) error
+	ChangeEmailAddress(c *echo.Context) error
+	DeleteAccount(c *echo.Context) error
 }
 
 type HttpHandler struct {
 	usecase IUsecase
+	cookie  cookie.ICookie
 }
 
 var _ IHttpHandler = (*HttpHandler)(nil)
@@ -30,6 +52,7 @@ var _ IHttpHandler = (*HttpHandler)(nil)
 func NewHttpHandler(i do.Injector) (*HttpHandler, error) {
 	return &HttpHandler{
 		usecase: do.MustInvoke[*Usecase](i),
+		cookie:  do.MustInvoke[*cookie.Cookie](i),
 	}, nil
 }
 
@@ -49,13 +72,11 @@ func (h *HttpHandler) SignUp(c *echo.Context) error {
 		return err
 	}
 
-	c.SetCookie(&http.Cookie{
-		Name:     consts.CookieUserSession,
-		Value:    res.Token,
-		Path:     "/",
-		HttpOnly: true,
-	})
+	if isSessionTokenRequested(c) {
+		return api.NewResponse(c).SetData(res).Send()
+	}
 
+	h.cookie.SetUserSession(c, res.Token, res.ExpiresAt)
 	return api.NewResponse(c).SendOk()
 }
 
@@ -75,38 +96,256 @@ func (h *HttpHandler) SignIn(c *echo.Context) error {
 		return err
 	}
 
-	c.SetCookie(&http.Cookie{
-		Name:     consts.CookieUserSession,
-		Value:    res.Token,
-		Path:     "/",
-		HttpOnly: true,
-	})
+	if res.SecondFactorRequired || isSessionTokenRequested(c) {
+		return api.NewResponse(c).SetData(res).Send()
+	}
 
+	h.cookie.SetUserSession(c, res.Token, res.ExpiresAt)
 	return api.NewResponse(c).SendOk()
 }
 
-func (h *HttpHandler) SignOut(c *echo.Context) error {
-	cookie, err := c.Cookie(consts.CookieUserSession)
+func (h *HttpHandler) CompleteSignIn(c *echo.Context) error {
+	req := CompleteSignInRequest{
+		IpAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.usecase.CompleteSignIn(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if isSessionTokenRequested(c) {
+		return api.NewResponse(c).SetData(res).Send()
+	}
+
+	h.cookie.SetUserSession(c, res.Token, res.ExpiresAt)
+	return api.NewResponse(c).SendOk()
+}
+
+func (h *HttpHandler) StartOidcSignIn(c *echo.Context) error {
+	req := StartOidcSignInRequest{
+		Provider: c.Param("provider"),
+	}
+
+	res, err := h.usecase.StartOidcSignIn(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	h.cookie.SetOidcState(c, res.StateDigest, res.ExpiresAt)
+	return api.NewResponse(c).SetData(res).Send()
+}
+
+func (h *HttpHandler) CompleteOidcSignIn(c *echo.Context) error {
+	req := CompleteOidcSignInRequest{
+		IpAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+		Provider:  c.Param("provider"),
+	}
+
+	stateCookie, err := c.Cookie(consts.CookieOidcState)
+	if err == nil {
+		req.StateDigest = stateCookie.Value
+	}
+
+	err = c.Bind(&req)
 	if err != nil {
 		return err
 	}
 
+	h.cookie.ClearOidcState(c)
+	res, err := h.usecase.CompleteOidcSignIn(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if res.SecondFactorRequired || isSessionTokenRequested(c) {
+		return api.NewResponse(c).SetData(res).Send()
+	}
+
+	h.cookie.SetUserSession(c, res.Token, res.ExpiresAt)
+	return api.NewResponse(c).SendOk()
+}
+
+func (h *HttpHandler) SignOut(c *echo.Context) error {
+	token := util.HttpBearerToken(c)
+	if token == "" {
+		sessionCookie, err := c.Cookie(consts.CookieUserSession)
+		if err != nil {
+			return err
+		}
+
+		token = sessionCookie.Value
+	}
+
 	req := SignOutRequest{
-		Token: cookie.Value,
+		Token: token,
+	}
+
+	err := h.usecase.SignOut(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	h.cookie.ClearUserSession(c)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *HttpHandler) RequestPasswordReset(c *echo.Context) error {
+	req := RequestPasswordResetRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.RequestPasswordReset(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (h *HttpHandler) ResetPassword(c *echo.Context) error {
+	req := ResetPasswordRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.ResetPassword(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *HttpHandler) RequestMagicLink(c *echo.Context) error {
+	req := RequestMagicLinkRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.RequestMagicLink(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (h *HttpHandler) ConsumeMagicLink(c *echo.Context) error {
+	req := ConsumeMagicLinkRequest{
+		IpAddress: c.RealIP(),
+		UserAgent: c.Request().UserAgent(),
+	}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.usecase.ConsumeMagicLink(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	if res.SecondFactorRequired || isSessionTokenRequested(c) {
+		return api.NewResponse(c).SetData(res).Send()
+	}
+
+	h.cookie.SetUserSession(c, res.Token, res.ExpiresAt)
+	return api.NewResponse(c).SendOk()
+}
+
+func (h *HttpHandler) VerifyEmailAddress(c *echo.Context) error {
+	req := VerifyEmailAddressRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.VerifyEmailAddress(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *HttpHandler) ResendEmailVerification(c *echo.Context) error {
+	req := ResendEmailVerificationRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.ResendEmailVerification(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusAccepted)
+}
+
+func (h *HttpHandler) EnrollTotp(c *echo.Context) error {
+	res, err := h.usecase.EnrollTotp(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetData(res).Send()
+}
+
+func (h *HttpHandler) ConfirmTotp(c *echo.Context) error {
+	req := ConfirmTotpRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
 	}
 
-	err = h.usecase.SignOut(c.Request().Context(), req)
+	res, err := h.usecase.ConfirmTotp(c.Request().Context(), req)
 	if err != nil {
 		return err
 	}
 
-	c.SetCookie(&http.Cookie{
-		Name:     consts.CookieUserSession,
-		Value:    "",
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   -1,
-	})
+	return api.NewResponse(c).SetData(res).Send()
+}
+
+func (h *HttpHandler) ListSessions(c *echo.Context) error {
+	res, err := h.usecase.ListSessions(c.Request().Context())
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetData(res).Send()
+}
+
+func (h *HttpHandler) RevokeSession(c *echo.Context) error {
+	id, err := uuid.Parse(c.Param("id"))
+	if err != nil {
+		return consts.ErrUserSessionNotFound
+	}
+
+	err = h.usecase.RevokeSession(c.Request().Context(), RevokeSessionRequest{Id: id})
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *HttpHandler) RevokeOtherSessions(c *echo.Context) error {
+	err := h.usecase.RevokeOtherSessions(c.Request().Context())
+	if err != nil {
+		return err
+	}
 
 	return c.NoContent(http.StatusNoContent)
 }
@@ -119,3 +358,68 @@ func (h *HttpHandler) Me(c *echo.Context) error {
 
 	return api.NewResponse(c).SetData(res).Send()
 }
+
+func (h *HttpHandler) UpdateProfile(c *echo.Context) error {
+	req := UpdateProfileRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	res, err := h.usecase.UpdateProfile(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return api.NewResponse(c).SetData(res).Send()
+}
+
+func (h *HttpHandler) ChangePassword(c *echo.Context) error {
+	req := ChangePasswordRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.ChangePassword(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *HttpHandler) ChangeEmailAddress(c *echo.Context) error {
+	req := ChangeEmailAddressRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.ChangeEmailAddress(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	return c.NoContent(http.StatusNoContent)
+}
+
+func (h *HttpHandler) DeleteAccount(c *echo.Context) error {
+	req := DeleteAccountRequest{}
+	err := c.Bind(&req)
+	if err != nil {
+		return err
+	}
+
+	err = h.usecase.DeleteAccount(c.Request().Context(), req)
+	if err != nil {
+		return err
+	}
+
+	h.cookie.ClearUserSession(c)
+	return c.NoContent(http.StatusNoContent)
+}
+
+func isSessionTokenRequested(c *echo.Context) bool {
+	return c.Request().Header.Get(consts.HeaderSessionDelivery) == consts.SessionDeliveryToken
+}
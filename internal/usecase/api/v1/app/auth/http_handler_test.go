@@ -6,10 +6,14 @@ import (
 	"net/http/httptest"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/anonychun/bibit/internal/config"
 	"github.com/anonychun/bibit/internal/consts"
+	"github.com/anonychun/bibit/internal/cookie"
 	"github.com/google/uuid"
 	"github.com/labstack/echo/v5"
+	"github.com/samber/do/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -26,7 +30,7 @@ func TestHttpHandler_SignUp(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 		expectedReq := SignUpRequest{
 			IpAddress:    "192.0.2.1",
 			UserAgent:    "Go test",
@@ -35,7 +39,9 @@ func TestHttpHandler_SignUp(t *testing.T) {
 			Password:     "correct horse battery staple",
 		}
 
-		usecase.EXPECT().SignUp(mock.Anything, expectedReq).Return(&SignUpResponse{Token: "session-token"}, nil).Once()
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		usecase.EXPECT().SignUp(mock.Anything, expectedReq).Return(&SignUpResponse{Token: "session-token", ExpiresAt: expiresAt}, nil).Once()
 
 		err := httpHandler.SignUp(ctx)
 
@@ -49,6 +55,32 @@ func TestHttpHandler_SignUp(t *testing.T) {
 		assert.Equal(t, "session-token", cookies[0].Value)
 		assert.Equal(t, "/", cookies[0].Path)
 		assert.True(t, cookies[0].HttpOnly)
+		assert.True(t, cookies[0].Secure)
+		assert.Equal(t, http.SameSiteLaxMode, cookies[0].SameSite)
+		assert.True(t, expiresAt.Equal(cookies[0].Expires))
+		assert.Positive(t, cookies[0].MaxAge)
+	})
+
+	t.Run("returns the session token in the body when the client asks for it", func(t *testing.T) {
+		e := echo.New()
+		body := `{"name":"Ada Lovelace","emailAddress":"ada@example.com","password":"correct horse battery staple"}`
+		req := httptest.NewRequest(http.MethodPost, "/sign-up", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(consts.HeaderSessionDelivery, consts.SessionDeliveryToken)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+		expiresAt := time.Date(2026, time.November, 17, 9, 0, 0, 0, time.UTC)
+
+		usecase.EXPECT().SignUp(mock.Anything, mock.Anything).Return(&SignUpResponse{Token: "session-token", ExpiresAt: expiresAt}, nil).Once()
+
+		err := httpHandler.SignUp(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"token":"session-token","expiresAt":"2026-11-17T09:00:00Z"},"errors":null}`, rec.Body.String())
+		assert.Empty(t, rec.Result().Cookies())
 	})
 
 	t.Run("returns bind errors", func(t *testing.T) {
@@ -73,7 +105,7 @@ func TestHttpHandler_SignUp(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 		expectedErr := errors.New("sign up")
 
 		usecase.EXPECT().SignUp(mock.Anything, mock.Anything).Return(nil, expectedErr).Once()
@@ -96,7 +128,7 @@ func TestHttpHandler_SignIn(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 		expectedReq := SignInRequest{
 			IpAddress:    "192.0.2.1",
 			UserAgent:    "Go test",
@@ -104,7 +136,9 @@ func TestHttpHandler_SignIn(t *testing.T) {
 			Password:     "correct horse battery staple",
 		}
 
-		usecase.EXPECT().SignIn(mock.Anything, expectedReq).Return(&SignInResponse{Token: "session-token"}, nil).Once()
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		usecase.EXPECT().SignIn(mock.Anything, expectedReq).Return(&SignInResponse{Token: "session-token", ExpiresAt: expiresAt}, nil).Once()
 
 		err := httpHandler.SignIn(ctx)
 
@@ -118,6 +152,55 @@ func TestHttpHandler_SignIn(t *testing.T) {
 		assert.Equal(t, "session-token", cookies[0].Value)
 		assert.Equal(t, "/", cookies[0].Path)
 		assert.True(t, cookies[0].HttpOnly)
+		assert.True(t, expiresAt.Equal(cookies[0].Expires))
+		assert.Positive(t, cookies[0].MaxAge)
+	})
+
+	t.Run("returns the session token in the body when the client asks for it", func(t *testing.T) {
+		e := echo.New()
+		body := `{"emailAddress":"ada@example.com","password":"correct horse battery staple"}`
+		req := httptest.NewRequest(http.MethodPost, "/sign-in", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set(consts.HeaderSessionDelivery, consts.SessionDeliveryToken)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+		expiresAt := time.Date(2026, time.November, 17, 9, 0, 0, 0, time.UTC)
+
+		usecase.EXPECT().SignIn(mock.Anything, mock.Anything).Return(&SignInResponse{Token: "session-token", ExpiresAt: expiresAt}, nil).Once()
+
+		err := httpHandler.SignIn(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"token":"session-token","expiresAt":"2026-11-17T09:00:00Z","secondFactorRequired":false},"errors":null}`, rec.Body.String())
+		assert.Empty(t, rec.Result().Cookies())
+	})
+
+	t.Run("returns the challenge in the body without a cookie when a second factor is required", func(t *testing.T) {
+		e := echo.New()
+		body := `{"emailAddress":"ada@example.com","password":"correct horse battery staple"}`
+		req := httptest.NewRequest(http.MethodPost, "/sign-in", strings.NewReader(body))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+		expiresAt := time.Date(2026, time.October, 18, 9, 5, 0, 0, time.UTC)
+
+		usecase.EXPECT().SignIn(mock.Anything, mock.Anything).Return(&SignInResponse{
+			ExpiresAt:            expiresAt,
+			SecondFactorRequired: true,
+			ChallengeToken:       "challenge-token",
+		}, nil).Once()
+
+		err := httpHandler.SignIn(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"expiresAt":"2026-10-18T09:05:00Z","secondFactorRequired":true,"challengeToken":"challenge-token"},"errors":null}`, rec.Body.String())
+		assert.Empty(t, rec.Result().Cookies())
 	})
 
 	t.Run("returns usecase errors", func(t *testing.T) {
@@ -128,7 +211,7 @@ func TestHttpHandler_SignIn(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 		expectedErr := errors.New("sign in")
 
 		usecase.EXPECT().SignIn(mock.Anything, mock.Anything).Return(nil, expectedErr).Once()
@@ -140,6 +223,78 @@ func TestHttpHandler_SignIn(t *testing.T) {
 	})
 }
 
+func TestHttpHandler_ConsumeMagicLink(t *testing.T) {
+	t.Run("binds the token with the client details and creates a session cookie", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link/consume", strings.NewReader(`{"token":"magic-link-token"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		req.Header.Set("User-Agent", "Go test")
+		req.Header.Set("X-Real-IP", "192.0.2.1")
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+		expectedReq := ConsumeMagicLinkRequest{
+			IpAddress: "192.0.2.1",
+			UserAgent: "Go test",
+			Token:     "magic-link-token",
+		}
+		expiresAt := time.Now().Add(time.Hour).Truncate(time.Second)
+
+		usecase.EXPECT().ConsumeMagicLink(mock.Anything, expectedReq).Return(&SignInResponse{Token: "session-token", ExpiresAt: expiresAt}, nil).Once()
+
+		err := httpHandler.ConsumeMagicLink(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusOK, rec.Code)
+
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, consts.CookieUserSession, cookies[0].Name)
+		assert.Equal(t, "session-token", cookies[0].Value)
+	})
+
+	t.Run("returns the challenge in the body without a cookie when a second factor is required", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link/consume", strings.NewReader(`{"token":"magic-link-token"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+		expiresAt := time.Date(2026, time.October, 18, 9, 5, 0, 0, time.UTC)
+
+		usecase.EXPECT().ConsumeMagicLink(mock.Anything, mock.Anything).Return(&SignInResponse{
+			ExpiresAt:            expiresAt,
+			SecondFactorRequired: true,
+			ChallengeToken:       "challenge-token",
+		}, nil).Once()
+
+		err := httpHandler.ConsumeMagicLink(ctx)
+
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"expiresAt":"2026-10-18T09:05:00Z","secondFactorRequired":true,"challengeToken":"challenge-token"},"errors":null}`, rec.Body.String())
+		assert.Empty(t, rec.Result().Cookies())
+	})
+
+	t.Run("returns usecase errors", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/auth/magic-link/consume", strings.NewReader(`{"token":"magic-link-token"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+
+		usecase.EXPECT().ConsumeMagicLink(mock.Anything, mock.Anything).Return(nil, consts.ErrInvalidMagicLinkToken).Once()
+
+		err := httpHandler.ConsumeMagicLink(ctx)
+
+		require.ErrorIs(t, err, consts.ErrInvalidMagicLinkToken)
+		assert.Empty(t, rec.Result().Cookies())
+	})
+}
+
 func TestHttpHandler_SignOut(t *testing.T) {
 	t.Run("deletes the session and clears the cookie", func(t *testing.T) {
 		e := echo.New()
@@ -148,7 +303,7 @@ func TestHttpHandler_SignOut(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 
 		usecase.EXPECT().SignOut(mock.Anything, SignOutRequest{Token: "session-token"}).Return(nil).Once()
 
@@ -164,6 +319,24 @@ func TestHttpHandler_SignOut(t *testing.T) {
 		assert.Contains(t, setCookie, "Max-Age=0")
 	})
 
+	t.Run("prefers the bearer token over the cookie", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodPost, "/sign-out", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer bearer-token")
+		req.AddCookie(&http.Cookie{Name: consts.CookieUserSession, Value: "session-token"})
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+
+		usecase.EXPECT().SignOut(mock.Anything, SignOutRequest{Token: "bearer-token"}).Return(nil).Once()
+
+		err := httpHandler.SignOut(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
 	t.Run("returns missing cookie errors", func(t *testing.T) {
 		e := echo.New()
 		req := httptest.NewRequest(http.MethodPost, "/sign-out", nil)
@@ -184,7 +357,7 @@ func TestHttpHandler_SignOut(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 		expectedErr := errors.New("sign out")
 
 		usecase.EXPECT().SignOut(mock.Anything, SignOutRequest{Token: "session-token"}).Return(expectedErr).Once()
@@ -196,6 +369,38 @@ func TestHttpHandler_SignOut(t *testing.T) {
 	})
 }
 
+func TestHttpHandler_RevokeSession(t *testing.T) {
+	t.Run("revokes the session from the path", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/sessions/019e925f-3f42-76a0-8518-cb8e51c0b8e2", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: "019e925f-3f42-76a0-8518-cb8e51c0b8e2"}})
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+
+		usecase.EXPECT().RevokeSession(mock.Anything, RevokeSessionRequest{Id: uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2")}).Return(nil).Once()
+
+		err := httpHandler.RevokeSession(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+	})
+
+	t.Run("returns session not found for malformed ids", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/sessions/not-a-uuid", nil)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		ctx.SetPathValues(echo.PathValues{{Name: "id", Value: "not-a-uuid"}})
+		httpHandler := &HttpHandler{usecase: NewMockIUsecase(t)}
+
+		err := httpHandler.RevokeSession(ctx)
+
+		require.ErrorIs(t, err, consts.ErrUserSessionNotFound)
+	})
+}
+
 func TestHttpHandler_Me(t *testing.T) {
 	t.Run("returns the current user response", func(t *testing.T) {
 		e := echo.New()
@@ -203,7 +408,7 @@ func TestHttpHandler_Me(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 		res := &MeResponse{}
 		res.User.Id = uuid.MustParse("019e925f-3f42-76a0-8518-cb8e51c0b8e2")
 		res.User.Name = "Ada Lovelace"
@@ -215,7 +420,7 @@ func TestHttpHandler_Me(t *testing.T) {
 
 		require.NoError(t, err)
 		assert.Equal(t, http.StatusOK, rec.Code)
-		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"user":{"id":"019e925f-3f42-76a0-8518-cb8e51c0b8e2","name":"Ada Lovelace","emailAddress":"ada@example.com"}},"errors":null}`, rec.Body.String())
+		assert.JSONEq(t, `{"ok":true,"meta":null,"data":{"user":{"id":"019e925f-3f42-76a0-8518-cb8e51c0b8e2","name":"Ada Lovelace","emailAddress":"ada@example.com"},"impersonated":false},"errors":null}`, rec.Body.String())
 	})
 
 	t.Run("returns usecase errors", func(t *testing.T) {
@@ -224,7 +429,7 @@ func TestHttpHandler_Me(t *testing.T) {
 		rec := httptest.NewRecorder()
 		ctx := e.NewContext(req, rec)
 		usecase := NewMockIUsecase(t)
-		httpHandler := &HttpHandler{usecase: usecase}
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
 		expectedErr := errors.New("me")
 
 		usecase.EXPECT().Me(mock.Anything).Return(nil, expectedErr).Once()
@@ -234,3 +439,44 @@ func TestHttpHandler_Me(t *testing.T) {
 		require.ErrorIs(t, err, expectedErr)
 	})
 }
+
+func TestHttpHandler_DeleteAccount(t *testing.T) {
+	t.Run("deletes the account and clears the session cookie", func(t *testing.T) {
+		e := echo.New()
+		req := httptest.NewRequest(http.MethodDelete, "/account", strings.NewReader(`{"currentPassword":"correct horse battery staple"}`))
+		req.Header.Set(echo.HeaderContentType, echo.MIMEApplicationJSON)
+		rec := httptest.NewRecorder()
+		ctx := e.NewContext(req, rec)
+		usecase := NewMockIUsecase(t)
+		httpHandler := &HttpHandler{usecase: usecase, cookie: newTestCookie(t)}
+
+		usecase.EXPECT().DeleteAccount(mock.Anything, DeleteAccountRequest{CurrentPassword: "correct horse battery staple"}).Return(nil).Once()
+
+		err := httpHandler.DeleteAccount(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, http.StatusNoContent, rec.Code)
+
+		cookies := rec.Result().Cookies()
+		require.Len(t, cookies, 1)
+		assert.Equal(t, consts.CookieUserSession, cookies[0].Name)
+		assert.Empty(t, cookies[0].Value)
+		assert.Negative(t, cookies[0].MaxAge)
+	})
+}
+
+func newTestCookie(t *testing.T) *cookie.Cookie {
+	t.Helper()
+
+	cfg := &config.Config{}
+	cfg.Http.Cookie.Secure = true
+	cfg.Http.Cookie.SameSite = "lax"
+
+	i := do.New()
+	do.ProvideValue(i, cfg)
+
+	ck, err := cookie.NewCookie(i)
+	require.NoError(t, err)
+
+	return ck
+}
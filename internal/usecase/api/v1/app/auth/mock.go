@@ -39,55 +39,55 @@ func (_m *MockIGrpcHandler) EXPECT() *MockIGrpcHandler_Expecter {
 	return &MockIGrpcHandler_Expecter{mock: &_m.Mock}
 }
 
-// Me provides a mock function for the type MockIGrpcHandler
-func (_mock *MockIGrpcHandler) Me(context1 context.Context, meRequest *auth.MeRequest) (*auth.MeResponse, error) {
-	ret := _mock.Called(context1, meRequest)
+// ChangeEmailAddress provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) ChangeEmailAddress(context1 context.Context, changeEmailAddressRequest *auth.ChangeEmailAddressRequest) (*auth.ChangeEmailAddressResponse, error) {
+	ret := _mock.Called(context1, changeEmailAddressRequest)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Me")
+		panic("no return value specified for ChangeEmailAddress")
 	}
 
-	var r0 *auth.MeResponse
+	var r0 *auth.ChangeEmailAddressResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.MeRequest) (*auth.MeResponse, error)); ok {
-		return returnFunc(context1, meRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ChangeEmailAddressRequest) (*auth.ChangeEmailAddressResponse, error)); ok {
+		return returnFunc(context1, changeEmailAddressRequest)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.MeRequest) *auth.MeResponse); ok {
-		r0 = returnFunc(context1, meRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ChangeEmailAddressRequest) *auth.ChangeEmailAddressResponse); ok {
+		r0 = returnFunc(context1, changeEmailAddressRequest)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.MeResponse)
+			r0 = ret.Get(0).(*auth.ChangeEmailAddressResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.MeRequest) error); ok {
-		r1 = returnFunc(context1, meRequest)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.ChangeEmailAddressRequest) error); ok {
+		r1 = returnFunc(context1, changeEmailAddressRequest)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIGrpcHandler_Me_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Me'
-type MockIGrpcHandler_Me_Call struct {
+// MockIGrpcHandler_ChangeEmailAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeEmailAddress'
+type MockIGrpcHandler_ChangeEmailAddress_Call struct {
 	*mock.Call
 }
 
-// Me is a helper method to define mock.On call
+// ChangeEmailAddress is a helper method to define mock.On call
 //   - context1 context.Context
-//   - meRequest *auth.MeRequest
-func (_e *MockIGrpcHandler_Expecter) Me(context1 interface{}, meRequest interface{}) *MockIGrpcHandler_Me_Call {
-	return &MockIGrpcHandler_Me_Call{Call: _e.mock.On("Me", context1, meRequest)}
+//   - changeEmailAddressRequest *auth.ChangeEmailAddressRequest
+func (_e *MockIGrpcHandler_Expecter) ChangeEmailAddress(context1 interface{}, changeEmailAddressRequest interface{}) *MockIGrpcHandler_ChangeEmailAddress_Call {
+	return &MockIGrpcHandler_ChangeEmailAddress_Call{Call: _e.mock.On("ChangeEmailAddress", context1, changeEmailAddressRequest)}
 }
 
-func (_c *MockIGrpcHandler_Me_Call) Run(run func(context1 context.Context, meRequest *auth.MeRequest)) *MockIGrpcHandler_Me_Call {
+func (_c *MockIGrpcHandler_ChangeEmailAddress_Call) Run(run func(context1 context.Context, changeEmailAddressRequest *auth.ChangeEmailAddressRequest)) *MockIGrpcHandler_ChangeEmailAddress_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.MeRequest
+		var arg1 *auth.ChangeEmailAddressRequest
 		if args[1] != nil {
-			arg1 = args[1].(*auth.MeRequest)
+			arg1 = args[1].(*auth.ChangeEmailAddressRequest)
 		}
 		run(
 			arg0,
@@ -97,65 +97,65 @@ func (_c *MockIGrpcHandler_Me_Call) Run(run func(context1 context.Context, meReq
 	return _c
 }
 
-func (_c *MockIGrpcHandler_Me_Call) Return(meResponse *auth.MeResponse, err error) *MockIGrpcHandler_Me_Call {
-	_c.Call.Return(meResponse, err)
+func (_c *MockIGrpcHandler_ChangeEmailAddress_Call) Return(changeEmailAddressResponse *auth.ChangeEmailAddressResponse, err error) *MockIGrpcHandler_ChangeEmailAddress_Call {
+	_c.Call.Return(changeEmailAddressResponse, err)
 	return _c
 }
 
-func (_c *MockIGrpcHandler_Me_Call) RunAndReturn(run func(context1 context.Context, meRequest *auth.MeRequest) (*auth.MeResponse, error)) *MockIGrpcHandler_Me_Call {
+func (_c *MockIGrpcHandler_ChangeEmailAddress_Call) RunAndReturn(run func(context1 context.Context, changeEmailAddressRequest *auth.ChangeEmailAddressRequest) (*auth.ChangeEmailAddressResponse, error)) *MockIGrpcHandler_ChangeEmailAddress_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SignIn provides a mock function for the type MockIGrpcHandler
-func (_mock *MockIGrpcHandler) SignIn(context1 context.Context, signInRequest *auth.SignInRequest) (*auth.SignInResponse, error) {
-	ret := _mock.Called(context1, signInRequest)
+// ChangePassword provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) ChangePassword(context1 context.Context, changePasswordRequest *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error) {
+	ret := _mock.Called(context1, changePasswordRequest)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SignIn")
+		panic("no return value specified for ChangePassword")
 	}
 
-	var r0 *auth.SignInResponse
+	var r0 *auth.ChangePasswordResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignInRequest) (*auth.SignInResponse, error)); ok {
-		return returnFunc(context1, signInRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error)); ok {
+		return returnFunc(context1, changePasswordRequest)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignInRequest) *auth.SignInResponse); ok {
-		r0 = returnFunc(context1, signInRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ChangePasswordRequest) *auth.ChangePasswordResponse); ok {
+		r0 = returnFunc(context1, changePasswordRequest)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.SignInResponse)
+			r0 = ret.Get(0).(*auth.ChangePasswordResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.SignInRequest) error); ok {
-		r1 = returnFunc(context1, signInRequest)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.ChangePasswordRequest) error); ok {
+		r1 = returnFunc(context1, changePasswordRequest)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIGrpcHandler_SignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignIn'
-type MockIGrpcHandler_SignIn_Call struct {
+// MockIGrpcHandler_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockIGrpcHandler_ChangePassword_Call struct {
 	*mock.Call
 }
 
-// SignIn is a helper method to define mock.On call
+// ChangePassword is a helper method to define mock.On call
 //   - context1 context.Context
-//   - signInRequest *auth.SignInRequest
-func (_e *MockIGrpcHandler_Expecter) SignIn(context1 interface{}, signInRequest interface{}) *MockIGrpcHandler_SignIn_Call {
-	return &MockIGrpcHandler_SignIn_Call{Call: _e.mock.On("SignIn", context1, signInRequest)}
+//   - changePasswordRequest *auth.ChangePasswordRequest
+func (_e *MockIGrpcHandler_Expecter) ChangePassword(context1 interface{}, changePasswordRequest interface{}) *MockIGrpcHandler_ChangePassword_Call {
+	return &MockIGrpcHandler_ChangePassword_Call{Call: _e.mock.On("ChangePassword", context1, changePasswordRequest)}
 }
 
-func (_c *MockIGrpcHandler_SignIn_Call) Run(run func(context1 context.Context, signInRequest *auth.SignInRequest)) *MockIGrpcHandler_SignIn_Call {
+func (_c *MockIGrpcHandler_ChangePassword_Call) Run(run func(context1 context.Context, changePasswordRequest *auth.ChangePasswordRequest)) *MockIGrpcHandler_ChangePassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.SignInRequest
+		var arg1 *auth.ChangePasswordRequest
 		if args[1] != nil {
-			arg1 = args[1].(*auth.SignInRequest)
+			arg1 = args[1].(*auth.ChangePasswordRequest)
 		}
 		run(
 			arg0,
@@ -165,65 +165,65 @@ func (_c *MockIGrpcHandler_SignIn_Call) Run(run func(context1 context.Context, s
 	return _c
 }
 
-func (_c *MockIGrpcHandler_SignIn_Call) Return(signInResponse *auth.SignInResponse, err error) *MockIGrpcHandler_SignIn_Call {
-	_c.Call.Return(signInResponse, err)
+func (_c *MockIGrpcHandler_ChangePassword_Call) Return(changePasswordResponse *auth.ChangePasswordResponse, err error) *MockIGrpcHandler_ChangePassword_Call {
+	_c.Call.Return(changePasswordResponse, err)
 	return _c
 }
 
-func (_c *MockIGrpcHandler_SignIn_Call) RunAndReturn(run func(context1 context.Context, signInRequest *auth.SignInRequest) (*auth.SignInResponse, error)) *MockIGrpcHandler_SignIn_Call {
+func (_c *MockIGrpcHandler_ChangePassword_Call) RunAndReturn(run func(context1 context.Context, changePasswordRequest *auth.ChangePasswordRequest) (*auth.ChangePasswordResponse, error)) *MockIGrpcHandler_ChangePassword_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SignOut provides a mock function for the type MockIGrpcHandler
-func (_mock *MockIGrpcHandler) SignOut(context1 context.Context, signOutRequest *auth.SignOutRequest) (*auth.SignOutResponse, error) {
-	ret := _mock.Called(context1, signOutRequest)
+// CompleteOidcSignIn provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) CompleteOidcSignIn(context1 context.Context, completeOidcSignInRequest *auth.CompleteOidcSignInRequest) (*auth.CompleteOidcSignInResponse, error) {
+	ret := _mock.Called(context1, completeOidcSignInRequest)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SignOut")
+		panic("no return value specified for CompleteOidcSignIn")
 	}
 
-	var r0 *auth.SignOutResponse
+	var r0 *auth.CompleteOidcSignInResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignOutRequest) (*auth.SignOutResponse, error)); ok {
-		return returnFunc(context1, signOutRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.CompleteOidcSignInRequest) (*auth.CompleteOidcSignInResponse, error)); ok {
+		return returnFunc(context1, completeOidcSignInRequest)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignOutRequest) *auth.SignOutResponse); ok {
-		r0 = returnFunc(context1, signOutRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.CompleteOidcSignInRequest) *auth.CompleteOidcSignInResponse); ok {
+		r0 = returnFunc(context1, completeOidcSignInRequest)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.SignOutResponse)
+			r0 = ret.Get(0).(*auth.CompleteOidcSignInResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.SignOutRequest) error); ok {
-		r1 = returnFunc(context1, signOutRequest)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.CompleteOidcSignInRequest) error); ok {
+		r1 = returnFunc(context1, completeOidcSignInRequest)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIGrpcHandler_SignOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignOut'
-type MockIGrpcHandler_SignOut_Call struct {
+// MockIGrpcHandler_CompleteOidcSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteOidcSignIn'
+type MockIGrpcHandler_CompleteOidcSignIn_Call struct {
 	*mock.Call
 }
 
-// SignOut is a helper method to define mock.On call
+// CompleteOidcSignIn is a helper method to define mock.On call
 //   - context1 context.Context
-//   - signOutRequest *auth.SignOutRequest
-func (_e *MockIGrpcHandler_Expecter) SignOut(context1 interface{}, signOutRequest interface{}) *MockIGrpcHandler_SignOut_Call {
-	return &MockIGrpcHandler_SignOut_Call{Call: _e.mock.On("SignOut", context1, signOutRequest)}
+//   - completeOidcSignInRequest *auth.CompleteOidcSignInRequest
+func (_e *MockIGrpcHandler_Expecter) CompleteOidcSignIn(context1 interface{}, completeOidcSignInRequest interface{}) *MockIGrpcHandler_CompleteOidcSignIn_Call {
+	return &MockIGrpcHandler_CompleteOidcSignIn_Call{Call: _e.mock.On("CompleteOidcSignIn", context1, completeOidcSignInRequest)}
 }
 
-func (_c *MockIGrpcHandler_SignOut_Call) Run(run func(context1 context.Context, signOutRequest *auth.SignOutRequest)) *MockIGrpcHandler_SignOut_Call {
+func (_c *MockIGrpcHandler_CompleteOidcSignIn_Call) Run(run func(context1 context.Context, completeOidcSignInRequest *auth.CompleteOidcSignInRequest)) *MockIGrpcHandler_CompleteOidcSignIn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.SignOutRequest
+		var arg1 *auth.CompleteOidcSignInRequest
 		if args[1] != nil {
-			arg1 = args[1].(*auth.SignOutRequest)
+			arg1 = args[1].(*auth.CompleteOidcSignInRequest)
 		}
 		run(
 			arg0,
@@ -233,65 +233,65 @@ func (_c *MockIGrpcHandler_SignOut_Call) Run(run func(context1 context.Context,
 	return _c
 }
 
-func (_c *MockIGrpcHandler_SignOut_Call) Return(signOutResponse *auth.SignOutResponse, err error) *MockIGrpcHandler_SignOut_Call {
-	_c.Call.Return(signOutResponse, err)
+func (_c *MockIGrpcHandler_CompleteOidcSignIn_Call) Return(completeOidcSignInResponse *auth.CompleteOidcSignInResponse, err error) *MockIGrpcHandler_CompleteOidcSignIn_Call {
+	_c.Call.Return(completeOidcSignInResponse, err)
 	return _c
 }
 
-func (_c *MockIGrpcHandler_SignOut_Call) RunAndReturn(run func(context1 context.Context, signOutRequest *auth.SignOutRequest) (*auth.SignOutResponse, error)) *MockIGrpcHandler_SignOut_Call {
+func (_c *MockIGrpcHandler_CompleteOidcSignIn_Call) RunAndReturn(run func(context1 context.Context, completeOidcSignInRequest *auth.CompleteOidcSignInRequest) (*auth.CompleteOidcSignInResponse, error)) *MockIGrpcHandler_CompleteOidcSignIn_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SignUp provides a mock function for the type MockIGrpcHandler
-func (_mock *MockIGrpcHandler) SignUp(context1 context.Context, signUpRequest *auth.SignUpRequest) (*auth.SignUpResponse, error) {
-	ret := _mock.Called(context1, signUpRequest)
+// CompleteSignIn provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) CompleteSignIn(context1 context.Context, completeSignInRequest *auth.CompleteSignInRequest) (*auth.CompleteSignInResponse, error) {
+	ret := _mock.Called(context1, completeSignInRequest)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SignUp")
+		panic("no return value specified for CompleteSignIn")
 	}
 
-	var r0 *auth.SignUpResponse
+	var r0 *auth.CompleteSignInResponse
 	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignUpRequest) (*auth.SignUpResponse, error)); ok {
-		return returnFunc(context1, signUpRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.CompleteSignInRequest) (*auth.CompleteSignInResponse, error)); ok {
+		return returnFunc(context1, completeSignInRequest)
 	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignUpRequest) *auth.SignUpResponse); ok {
-		r0 = returnFunc(context1, signUpRequest)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.CompleteSignInRequest) *auth.CompleteSignInResponse); ok {
+		r0 = returnFunc(context1, completeSignInRequest)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*auth.SignUpResponse)
+			r0 = ret.Get(0).(*auth.CompleteSignInResponse)
 		}
 	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.SignUpRequest) error); ok {
-		r1 = returnFunc(context1, signUpRequest)
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.CompleteSignInRequest) error); ok {
+		r1 = returnFunc(context1, completeSignInRequest)
 	} else {
 		r1 = ret.Error(1)
 	}
 	return r0, r1
 }
 
-// MockIGrpcHandler_SignUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignUp'
-type MockIGrpcHandler_SignUp_Call struct {
+// MockIGrpcHandler_CompleteSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteSignIn'
+type MockIGrpcHandler_CompleteSignIn_Call struct {
 	*mock.Call
 }
 
-// SignUp is a helper method to define mock.On call
+// CompleteSignIn is a helper method to define mock.On call
 //   - context1 context.Context
-//   - signUpRequest *auth.SignUpRequest
-func (_e *MockIGrpcHandler_Expecter) SignUp(context1 interface{}, signUpRequest interface{}) *MockIGrpcHandler_SignUp_Call {
-	return &MockIGrpcHandler_SignUp_Call{Call: _e.mock.On("SignUp", context1, signUpRequest)}
+//   - completeSignInRequest *auth.CompleteSignInRequest
+func (_e *MockIGrpcHandler_Expecter) CompleteSignIn(context1 interface{}, completeSignInRequest interface{}) *MockIGrpcHandler_CompleteSignIn_Call {
+	return &MockIGrpcHandler_CompleteSignIn_Call{Call: _e.mock.On("CompleteSignIn", context1, completeSignInRequest)}
 }
 
-func (_c *MockIGrpcHandler_SignUp_Call) Run(run func(context1 context.Context, signUpRequest *auth.SignUpRequest)) *MockIGrpcHandler_SignUp_Call {
+func (_c *MockIGrpcHandler_CompleteSignIn_Call) Run(run func(context1 context.Context, completeSignInRequest *auth.CompleteSignInRequest)) *MockIGrpcHandler_CompleteSignIn_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
-		var arg1 *auth.SignUpRequest
+		var arg1 *auth.CompleteSignInRequest
 		if args[1] != nil {
-			arg1 = args[1].(*auth.SignUpRequest)
+			arg1 = args[1].(*auth.CompleteSignInRequest)
 		}
 		run(
 			arg0,
@@ -301,262 +301,3339 @@ func (_c *MockIGrpcHandler_SignUp_Call) Run(run func(context1 context.Context, s
 	return _c
 }
 
-func (_c *MockIGrpcHandler_SignUp_Call) Return(signUpResponse *auth.SignUpResponse, err error) *MockIGrpcHandler_SignUp_Call {
-	_c.Call.Return(signUpResponse, err)
+func (_c *MockIGrpcHandler_CompleteSignIn_Call) Return(completeSignInResponse *auth.CompleteSignInResponse, err error) *MockIGrpcHandler_CompleteSignIn_Call {
+	_c.Call.Return(completeSignInResponse, err)
 	return _c
 }
 
-func (_c *MockIGrpcHandler_SignUp_Call) RunAndReturn(run func(context1 context.Context, signUpRequest *auth.SignUpRequest) (*auth.SignUpResponse, error)) *MockIGrpcHandler_SignUp_Call {
+func (_c *MockIGrpcHandler_CompleteSignIn_Call) RunAndReturn(run func(context1 context.Context, completeSignInRequest *auth.CompleteSignInRequest) (*auth.CompleteSignInResponse, error)) *MockIGrpcHandler_CompleteSignIn_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// mustEmbedUnimplementedServiceServer provides a mock function for the type MockIGrpcHandler
-func (_mock *MockIGrpcHandler) mustEmbedUnimplementedServiceServer() {
-	_mock.Called()
-	return
+// ConfirmTotp provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) ConfirmTotp(context1 context.Context, confirmTotpRequest *auth.ConfirmTotpRequest) (*auth.ConfirmTotpResponse, error) {
+	ret := _mock.Called(context1, confirmTotpRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmTotp")
+	}
+
+	var r0 *auth.ConfirmTotpResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ConfirmTotpRequest) (*auth.ConfirmTotpResponse, error)); ok {
+		return returnFunc(context1, confirmTotpRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ConfirmTotpRequest) *auth.ConfirmTotpResponse); ok {
+		r0 = returnFunc(context1, confirmTotpRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.ConfirmTotpResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.ConfirmTotpRequest) error); ok {
+		r1 = returnFunc(context1, confirmTotpRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'mustEmbedUnimplementedServiceServer'
-type MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call struct {
+// MockIGrpcHandler_ConfirmTotp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmTotp'
+type MockIGrpcHandler_ConfirmTotp_Call struct {
 	*mock.Call
 }
 
-// mustEmbedUnimplementedServiceServer is a helper method to define mock.On call
-func (_e *MockIGrpcHandler_Expecter) mustEmbedUnimplementedServiceServer() *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
-	return &MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call{Call: _e.mock.On("mustEmbedUnimplementedServiceServer")}
+// ConfirmTotp is a helper method to define mock.On call
+//   - context1 context.Context
+//   - confirmTotpRequest *auth.ConfirmTotpRequest
+func (_e *MockIGrpcHandler_Expecter) ConfirmTotp(context1 interface{}, confirmTotpRequest interface{}) *MockIGrpcHandler_ConfirmTotp_Call {
+	return &MockIGrpcHandler_ConfirmTotp_Call{Call: _e.mock.On("ConfirmTotp", context1, confirmTotpRequest)}
 }
 
-func (_c *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call) Run(run func()) *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
+func (_c *MockIGrpcHandler_ConfirmTotp_Call) Run(run func(context1 context.Context, confirmTotpRequest *auth.ConfirmTotpRequest)) *MockIGrpcHandler_ConfirmTotp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run()
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.ConfirmTotpRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.ConfirmTotpRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
 	})
 	return _c
 }
 
-func (_c *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call) Return() *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
-	_c.Call.Return()
+func (_c *MockIGrpcHandler_ConfirmTotp_Call) Return(confirmTotpResponse *auth.ConfirmTotpResponse, err error) *MockIGrpcHandler_ConfirmTotp_Call {
+	_c.Call.Return(confirmTotpResponse, err)
 	return _c
 }
 
-func (_c *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call) RunAndReturn(run func()) *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
-	_c.Run(run)
+func (_c *MockIGrpcHandler_ConfirmTotp_Call) RunAndReturn(run func(context1 context.Context, confirmTotpRequest *auth.ConfirmTotpRequest) (*auth.ConfirmTotpResponse, error)) *MockIGrpcHandler_ConfirmTotp_Call {
+	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIHttpHandler creates a new instance of MockIHttpHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIHttpHandler(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIHttpHandler {
-	mock := &MockIHttpHandler{}
-	mock.Mock.Test(t)
+// ConsumeMagicLink provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) ConsumeMagicLink(context1 context.Context, consumeMagicLinkRequest *auth.ConsumeMagicLinkRequest) (*auth.ConsumeMagicLinkResponse, error) {
+	ret := _mock.Called(context1, consumeMagicLinkRequest)
 
-	t.Cleanup(func() { mock.AssertExpectations(t) })
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeMagicLink")
+	}
 
-	return mock
+	var r0 *auth.ConsumeMagicLinkResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ConsumeMagicLinkRequest) (*auth.ConsumeMagicLinkResponse, error)); ok {
+		return returnFunc(context1, consumeMagicLinkRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ConsumeMagicLinkRequest) *auth.ConsumeMagicLinkResponse); ok {
+		r0 = returnFunc(context1, consumeMagicLinkRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.ConsumeMagicLinkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.ConsumeMagicLinkRequest) error); ok {
+		r1 = returnFunc(context1, consumeMagicLinkRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIHttpHandler is an autogenerated mock type for the IHttpHandler type
-type MockIHttpHandler struct {
-	mock.Mock
+// MockIGrpcHandler_ConsumeMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeMagicLink'
+type MockIGrpcHandler_ConsumeMagicLink_Call struct {
+	*mock.Call
 }
 
-type MockIHttpHandler_Expecter struct {
-	mock *mock.Mock
+// ConsumeMagicLink is a helper method to define mock.On call
+//   - context1 context.Context
+//   - consumeMagicLinkRequest *auth.ConsumeMagicLinkRequest
+func (_e *MockIGrpcHandler_Expecter) ConsumeMagicLink(context1 interface{}, consumeMagicLinkRequest interface{}) *MockIGrpcHandler_ConsumeMagicLink_Call {
+	return &MockIGrpcHandler_ConsumeMagicLink_Call{Call: _e.mock.On("ConsumeMagicLink", context1, consumeMagicLinkRequest)}
 }
 
-func (_m *MockIHttpHandler) EXPECT() *MockIHttpHandler_Expecter {
-	return &MockIHttpHandler_Expecter{mock: &_m.Mock}
+func (_c *MockIGrpcHandler_ConsumeMagicLink_Call) Run(run func(context1 context.Context, consumeMagicLinkRequest *auth.ConsumeMagicLinkRequest)) *MockIGrpcHandler_ConsumeMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.ConsumeMagicLinkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.ConsumeMagicLinkRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
 }
 
-// Me provides a mock function for the type MockIHttpHandler
-func (_mock *MockIHttpHandler) Me(c *echo.Context) error {
-	ret := _mock.Called(c)
+func (_c *MockIGrpcHandler_ConsumeMagicLink_Call) Return(consumeMagicLinkResponse *auth.ConsumeMagicLinkResponse, err error) *MockIGrpcHandler_ConsumeMagicLink_Call {
+	_c.Call.Return(consumeMagicLinkResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_ConsumeMagicLink_Call) RunAndReturn(run func(context1 context.Context, consumeMagicLinkRequest *auth.ConsumeMagicLinkRequest) (*auth.ConsumeMagicLinkResponse, error)) *MockIGrpcHandler_ConsumeMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) DeleteAccount(context1 context.Context, deleteAccountRequest *auth.DeleteAccountRequest) (*auth.DeleteAccountResponse, error) {
+	ret := _mock.Called(context1, deleteAccountRequest)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Me")
+		panic("no return value specified for DeleteAccount")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
-		r0 = returnFunc(c)
+	var r0 *auth.DeleteAccountResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.DeleteAccountRequest) (*auth.DeleteAccountResponse, error)); ok {
+		return returnFunc(context1, deleteAccountRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.DeleteAccountRequest) *auth.DeleteAccountResponse); ok {
+		r0 = returnFunc(context1, deleteAccountRequest)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.DeleteAccountResponse)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.DeleteAccountRequest) error); ok {
+		r1 = returnFunc(context1, deleteAccountRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIHttpHandler_Me_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Me'
-type MockIHttpHandler_Me_Call struct {
+// MockIGrpcHandler_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type MockIGrpcHandler_DeleteAccount_Call struct {
 	*mock.Call
 }
 
-// Me is a helper method to define mock.On call
-//   - c *echo.Context
-func (_e *MockIHttpHandler_Expecter) Me(c interface{}) *MockIHttpHandler_Me_Call {
-	return &MockIHttpHandler_Me_Call{Call: _e.mock.On("Me", c)}
+// DeleteAccount is a helper method to define mock.On call
+//   - context1 context.Context
+//   - deleteAccountRequest *auth.DeleteAccountRequest
+func (_e *MockIGrpcHandler_Expecter) DeleteAccount(context1 interface{}, deleteAccountRequest interface{}) *MockIGrpcHandler_DeleteAccount_Call {
+	return &MockIGrpcHandler_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", context1, deleteAccountRequest)}
 }
 
-func (_c *MockIHttpHandler_Me_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_Me_Call {
+func (_c *MockIGrpcHandler_DeleteAccount_Call) Run(run func(context1 context.Context, deleteAccountRequest *auth.DeleteAccountRequest)) *MockIGrpcHandler_DeleteAccount_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *echo.Context
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*echo.Context)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.DeleteAccountRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.DeleteAccountRequest)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIHttpHandler_Me_Call) Return(err error) *MockIHttpHandler_Me_Call {
-	_c.Call.Return(err)
+func (_c *MockIGrpcHandler_DeleteAccount_Call) Return(deleteAccountResponse *auth.DeleteAccountResponse, err error) *MockIGrpcHandler_DeleteAccount_Call {
+	_c.Call.Return(deleteAccountResponse, err)
 	return _c
 }
 
-func (_c *MockIHttpHandler_Me_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_Me_Call {
+func (_c *MockIGrpcHandler_DeleteAccount_Call) RunAndReturn(run func(context1 context.Context, deleteAccountRequest *auth.DeleteAccountRequest) (*auth.DeleteAccountResponse, error)) *MockIGrpcHandler_DeleteAccount_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SignIn provides a mock function for the type MockIHttpHandler
-func (_mock *MockIHttpHandler) SignIn(c *echo.Context) error {
-	ret := _mock.Called(c)
+// EnrollTotp provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) EnrollTotp(context1 context.Context, enrollTotpRequest *auth.EnrollTotpRequest) (*auth.EnrollTotpResponse, error) {
+	ret := _mock.Called(context1, enrollTotpRequest)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SignIn")
+		panic("no return value specified for EnrollTotp")
 	}
 
-	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
-		r0 = returnFunc(c)
+	var r0 *auth.EnrollTotpResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.EnrollTotpRequest) (*auth.EnrollTotpResponse, error)); ok {
+		return returnFunc(context1, enrollTotpRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.EnrollTotpRequest) *auth.EnrollTotpResponse); ok {
+		r0 = returnFunc(context1, enrollTotpRequest)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.EnrollTotpResponse)
+		}
 	}
-	return r0
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.EnrollTotpRequest) error); ok {
+		r1 = returnFunc(context1, enrollTotpRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
 }
 
-// MockIHttpHandler_SignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignIn'
-type MockIHttpHandler_SignIn_Call struct {
+// MockIGrpcHandler_EnrollTotp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollTotp'
+type MockIGrpcHandler_EnrollTotp_Call struct {
 	*mock.Call
 }
 
-// SignIn is a helper method to define mock.On call
-//   - c *echo.Context
-func (_e *MockIHttpHandler_Expecter) SignIn(c interface{}) *MockIHttpHandler_SignIn_Call {
-	return &MockIHttpHandler_SignIn_Call{Call: _e.mock.On("SignIn", c)}
+// EnrollTotp is a helper method to define mock.On call
+//   - context1 context.Context
+//   - enrollTotpRequest *auth.EnrollTotpRequest
+func (_e *MockIGrpcHandler_Expecter) EnrollTotp(context1 interface{}, enrollTotpRequest interface{}) *MockIGrpcHandler_EnrollTotp_Call {
+	return &MockIGrpcHandler_EnrollTotp_Call{Call: _e.mock.On("EnrollTotp", context1, enrollTotpRequest)}
 }
 
-func (_c *MockIHttpHandler_SignIn_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_SignIn_Call {
+func (_c *MockIGrpcHandler_EnrollTotp_Call) Run(run func(context1 context.Context, enrollTotpRequest *auth.EnrollTotpRequest)) *MockIGrpcHandler_EnrollTotp_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *echo.Context
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*echo.Context)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.EnrollTotpRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.EnrollTotpRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_EnrollTotp_Call) Return(enrollTotpResponse *auth.EnrollTotpResponse, err error) *MockIGrpcHandler_EnrollTotp_Call {
+	_c.Call.Return(enrollTotpResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_EnrollTotp_Call) RunAndReturn(run func(context1 context.Context, enrollTotpRequest *auth.EnrollTotpRequest) (*auth.EnrollTotpResponse, error)) *MockIGrpcHandler_EnrollTotp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) ListSessions(context1 context.Context, listSessionsRequest *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error) {
+	ret := _mock.Called(context1, listSessionsRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *auth.ListSessionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error)); ok {
+		return returnFunc(context1, listSessionsRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ListSessionsRequest) *auth.ListSessionsResponse); ok {
+		r0 = returnFunc(context1, listSessionsRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.ListSessionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.ListSessionsRequest) error); ok {
+		r1 = returnFunc(context1, listSessionsRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockIGrpcHandler_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - context1 context.Context
+//   - listSessionsRequest *auth.ListSessionsRequest
+func (_e *MockIGrpcHandler_Expecter) ListSessions(context1 interface{}, listSessionsRequest interface{}) *MockIGrpcHandler_ListSessions_Call {
+	return &MockIGrpcHandler_ListSessions_Call{Call: _e.mock.On("ListSessions", context1, listSessionsRequest)}
+}
+
+func (_c *MockIGrpcHandler_ListSessions_Call) Run(run func(context1 context.Context, listSessionsRequest *auth.ListSessionsRequest)) *MockIGrpcHandler_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.ListSessionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.ListSessionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_ListSessions_Call) Return(listSessionsResponse *auth.ListSessionsResponse, err error) *MockIGrpcHandler_ListSessions_Call {
+	_c.Call.Return(listSessionsResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_ListSessions_Call) RunAndReturn(run func(context1 context.Context, listSessionsRequest *auth.ListSessionsRequest) (*auth.ListSessionsResponse, error)) *MockIGrpcHandler_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Me provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) Me(context1 context.Context, meRequest *auth.MeRequest) (*auth.MeResponse, error) {
+	ret := _mock.Called(context1, meRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Me")
+	}
+
+	var r0 *auth.MeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.MeRequest) (*auth.MeResponse, error)); ok {
+		return returnFunc(context1, meRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.MeRequest) *auth.MeResponse); ok {
+		r0 = returnFunc(context1, meRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.MeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.MeRequest) error); ok {
+		r1 = returnFunc(context1, meRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_Me_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Me'
+type MockIGrpcHandler_Me_Call struct {
+	*mock.Call
+}
+
+// Me is a helper method to define mock.On call
+//   - context1 context.Context
+//   - meRequest *auth.MeRequest
+func (_e *MockIGrpcHandler_Expecter) Me(context1 interface{}, meRequest interface{}) *MockIGrpcHandler_Me_Call {
+	return &MockIGrpcHandler_Me_Call{Call: _e.mock.On("Me", context1, meRequest)}
+}
+
+func (_c *MockIGrpcHandler_Me_Call) Run(run func(context1 context.Context, meRequest *auth.MeRequest)) *MockIGrpcHandler_Me_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.MeRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.MeRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_Me_Call) Return(meResponse *auth.MeResponse, err error) *MockIGrpcHandler_Me_Call {
+	_c.Call.Return(meResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_Me_Call) RunAndReturn(run func(context1 context.Context, meRequest *auth.MeRequest) (*auth.MeResponse, error)) *MockIGrpcHandler_Me_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestMagicLink provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) RequestMagicLink(context1 context.Context, requestMagicLinkRequest *auth.RequestMagicLinkRequest) (*auth.RequestMagicLinkResponse, error) {
+	ret := _mock.Called(context1, requestMagicLinkRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestMagicLink")
+	}
+
+	var r0 *auth.RequestMagicLinkResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RequestMagicLinkRequest) (*auth.RequestMagicLinkResponse, error)); ok {
+		return returnFunc(context1, requestMagicLinkRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RequestMagicLinkRequest) *auth.RequestMagicLinkResponse); ok {
+		r0 = returnFunc(context1, requestMagicLinkRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.RequestMagicLinkResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RequestMagicLinkRequest) error); ok {
+		r1 = returnFunc(context1, requestMagicLinkRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_RequestMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestMagicLink'
+type MockIGrpcHandler_RequestMagicLink_Call struct {
+	*mock.Call
+}
+
+// RequestMagicLink is a helper method to define mock.On call
+//   - context1 context.Context
+//   - requestMagicLinkRequest *auth.RequestMagicLinkRequest
+func (_e *MockIGrpcHandler_Expecter) RequestMagicLink(context1 interface{}, requestMagicLinkRequest interface{}) *MockIGrpcHandler_RequestMagicLink_Call {
+	return &MockIGrpcHandler_RequestMagicLink_Call{Call: _e.mock.On("RequestMagicLink", context1, requestMagicLinkRequest)}
+}
+
+func (_c *MockIGrpcHandler_RequestMagicLink_Call) Run(run func(context1 context.Context, requestMagicLinkRequest *auth.RequestMagicLinkRequest)) *MockIGrpcHandler_RequestMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RequestMagicLinkRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RequestMagicLinkRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RequestMagicLink_Call) Return(requestMagicLinkResponse *auth.RequestMagicLinkResponse, err error) *MockIGrpcHandler_RequestMagicLink_Call {
+	_c.Call.Return(requestMagicLinkResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RequestMagicLink_Call) RunAndReturn(run func(context1 context.Context, requestMagicLinkRequest *auth.RequestMagicLinkRequest) (*auth.RequestMagicLinkResponse, error)) *MockIGrpcHandler_RequestMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) RequestPasswordReset(context1 context.Context, requestPasswordResetRequest *auth.RequestPasswordResetRequest) (*auth.RequestPasswordResetResponse, error) {
+	ret := _mock.Called(context1, requestPasswordResetRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 *auth.RequestPasswordResetResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RequestPasswordResetRequest) (*auth.RequestPasswordResetResponse, error)); ok {
+		return returnFunc(context1, requestPasswordResetRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RequestPasswordResetRequest) *auth.RequestPasswordResetResponse); ok {
+		r0 = returnFunc(context1, requestPasswordResetRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.RequestPasswordResetResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RequestPasswordResetRequest) error); ok {
+		r1 = returnFunc(context1, requestPasswordResetRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type MockIGrpcHandler_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - context1 context.Context
+//   - requestPasswordResetRequest *auth.RequestPasswordResetRequest
+func (_e *MockIGrpcHandler_Expecter) RequestPasswordReset(context1 interface{}, requestPasswordResetRequest interface{}) *MockIGrpcHandler_RequestPasswordReset_Call {
+	return &MockIGrpcHandler_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", context1, requestPasswordResetRequest)}
+}
+
+func (_c *MockIGrpcHandler_RequestPasswordReset_Call) Run(run func(context1 context.Context, requestPasswordResetRequest *auth.RequestPasswordResetRequest)) *MockIGrpcHandler_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RequestPasswordResetRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RequestPasswordResetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RequestPasswordReset_Call) Return(requestPasswordResetResponse *auth.RequestPasswordResetResponse, err error) *MockIGrpcHandler_RequestPasswordReset_Call {
+	_c.Call.Return(requestPasswordResetResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RequestPasswordReset_Call) RunAndReturn(run func(context1 context.Context, requestPasswordResetRequest *auth.RequestPasswordResetRequest) (*auth.RequestPasswordResetResponse, error)) *MockIGrpcHandler_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendEmailVerification provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) ResendEmailVerification(context1 context.Context, resendEmailVerificationRequest *auth.ResendEmailVerificationRequest) (*auth.ResendEmailVerificationResponse, error) {
+	ret := _mock.Called(context1, resendEmailVerificationRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendEmailVerification")
+	}
+
+	var r0 *auth.ResendEmailVerificationResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ResendEmailVerificationRequest) (*auth.ResendEmailVerificationResponse, error)); ok {
+		return returnFunc(context1, resendEmailVerificationRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ResendEmailVerificationRequest) *auth.ResendEmailVerificationResponse); ok {
+		r0 = returnFunc(context1, resendEmailVerificationRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.ResendEmailVerificationResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.ResendEmailVerificationRequest) error); ok {
+		r1 = returnFunc(context1, resendEmailVerificationRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_ResendEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendEmailVerification'
+type MockIGrpcHandler_ResendEmailVerification_Call struct {
+	*mock.Call
+}
+
+// ResendEmailVerification is a helper method to define mock.On call
+//   - context1 context.Context
+//   - resendEmailVerificationRequest *auth.ResendEmailVerificationRequest
+func (_e *MockIGrpcHandler_Expecter) ResendEmailVerification(context1 interface{}, resendEmailVerificationRequest interface{}) *MockIGrpcHandler_ResendEmailVerification_Call {
+	return &MockIGrpcHandler_ResendEmailVerification_Call{Call: _e.mock.On("ResendEmailVerification", context1, resendEmailVerificationRequest)}
+}
+
+func (_c *MockIGrpcHandler_ResendEmailVerification_Call) Run(run func(context1 context.Context, resendEmailVerificationRequest *auth.ResendEmailVerificationRequest)) *MockIGrpcHandler_ResendEmailVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.ResendEmailVerificationRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.ResendEmailVerificationRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_ResendEmailVerification_Call) Return(resendEmailVerificationResponse *auth.ResendEmailVerificationResponse, err error) *MockIGrpcHandler_ResendEmailVerification_Call {
+	_c.Call.Return(resendEmailVerificationResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_ResendEmailVerification_Call) RunAndReturn(run func(context1 context.Context, resendEmailVerificationRequest *auth.ResendEmailVerificationRequest) (*auth.ResendEmailVerificationResponse, error)) *MockIGrpcHandler_ResendEmailVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) ResetPassword(context1 context.Context, resetPasswordRequest *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error) {
+	ret := _mock.Called(context1, resetPasswordRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 *auth.ResetPasswordResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error)); ok {
+		return returnFunc(context1, resetPasswordRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.ResetPasswordRequest) *auth.ResetPasswordResponse); ok {
+		r0 = returnFunc(context1, resetPasswordRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.ResetPasswordResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.ResetPasswordRequest) error); ok {
+		r1 = returnFunc(context1, resetPasswordRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type MockIGrpcHandler_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - context1 context.Context
+//   - resetPasswordRequest *auth.ResetPasswordRequest
+func (_e *MockIGrpcHandler_Expecter) ResetPassword(context1 interface{}, resetPasswordRequest interface{}) *MockIGrpcHandler_ResetPassword_Call {
+	return &MockIGrpcHandler_ResetPassword_Call{Call: _e.mock.On("ResetPassword", context1, resetPasswordRequest)}
+}
+
+func (_c *MockIGrpcHandler_ResetPassword_Call) Run(run func(context1 context.Context, resetPasswordRequest *auth.ResetPasswordRequest)) *MockIGrpcHandler_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.ResetPasswordRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.ResetPasswordRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_ResetPassword_Call) Return(resetPasswordResponse *auth.ResetPasswordResponse, err error) *MockIGrpcHandler_ResetPassword_Call {
+	_c.Call.Return(resetPasswordResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_ResetPassword_Call) RunAndReturn(run func(context1 context.Context, resetPasswordRequest *auth.ResetPasswordRequest) (*auth.ResetPasswordResponse, error)) *MockIGrpcHandler_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) RevokeOtherSessions(context1 context.Context, revokeOtherSessionsRequest *auth.RevokeOtherSessionsRequest) (*auth.RevokeOtherSessionsResponse, error) {
+	ret := _mock.Called(context1, revokeOtherSessionsRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 *auth.RevokeOtherSessionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RevokeOtherSessionsRequest) (*auth.RevokeOtherSessionsResponse, error)); ok {
+		return returnFunc(context1, revokeOtherSessionsRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RevokeOtherSessionsRequest) *auth.RevokeOtherSessionsResponse); ok {
+		r0 = returnFunc(context1, revokeOtherSessionsRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.RevokeOtherSessionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RevokeOtherSessionsRequest) error); ok {
+		r1 = returnFunc(context1, revokeOtherSessionsRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type MockIGrpcHandler_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - context1 context.Context
+//   - revokeOtherSessionsRequest *auth.RevokeOtherSessionsRequest
+func (_e *MockIGrpcHandler_Expecter) RevokeOtherSessions(context1 interface{}, revokeOtherSessionsRequest interface{}) *MockIGrpcHandler_RevokeOtherSessions_Call {
+	return &MockIGrpcHandler_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", context1, revokeOtherSessionsRequest)}
+}
+
+func (_c *MockIGrpcHandler_RevokeOtherSessions_Call) Run(run func(context1 context.Context, revokeOtherSessionsRequest *auth.RevokeOtherSessionsRequest)) *MockIGrpcHandler_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RevokeOtherSessionsRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RevokeOtherSessionsRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RevokeOtherSessions_Call) Return(revokeOtherSessionsResponse *auth.RevokeOtherSessionsResponse, err error) *MockIGrpcHandler_RevokeOtherSessions_Call {
+	_c.Call.Return(revokeOtherSessionsResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RevokeOtherSessions_Call) RunAndReturn(run func(context1 context.Context, revokeOtherSessionsRequest *auth.RevokeOtherSessionsRequest) (*auth.RevokeOtherSessionsResponse, error)) *MockIGrpcHandler_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) RevokeSession(context1 context.Context, revokeSessionRequest *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error) {
+	ret := _mock.Called(context1, revokeSessionRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 *auth.RevokeSessionResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error)); ok {
+		return returnFunc(context1, revokeSessionRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.RevokeSessionRequest) *auth.RevokeSessionResponse); ok {
+		r0 = returnFunc(context1, revokeSessionRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.RevokeSessionResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.RevokeSessionRequest) error); ok {
+		r1 = returnFunc(context1, revokeSessionRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockIGrpcHandler_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - context1 context.Context
+//   - revokeSessionRequest *auth.RevokeSessionRequest
+func (_e *MockIGrpcHandler_Expecter) RevokeSession(context1 interface{}, revokeSessionRequest interface{}) *MockIGrpcHandler_RevokeSession_Call {
+	return &MockIGrpcHandler_RevokeSession_Call{Call: _e.mock.On("RevokeSession", context1, revokeSessionRequest)}
+}
+
+func (_c *MockIGrpcHandler_RevokeSession_Call) Run(run func(context1 context.Context, revokeSessionRequest *auth.RevokeSessionRequest)) *MockIGrpcHandler_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.RevokeSessionRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.RevokeSessionRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RevokeSession_Call) Return(revokeSessionResponse *auth.RevokeSessionResponse, err error) *MockIGrpcHandler_RevokeSession_Call {
+	_c.Call.Return(revokeSessionResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_RevokeSession_Call) RunAndReturn(run func(context1 context.Context, revokeSessionRequest *auth.RevokeSessionRequest) (*auth.RevokeSessionResponse, error)) *MockIGrpcHandler_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignIn provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) SignIn(context1 context.Context, signInRequest *auth.SignInRequest) (*auth.SignInResponse, error) {
+	ret := _mock.Called(context1, signInRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignIn")
+	}
+
+	var r0 *auth.SignInResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignInRequest) (*auth.SignInResponse, error)); ok {
+		return returnFunc(context1, signInRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignInRequest) *auth.SignInResponse); ok {
+		r0 = returnFunc(context1, signInRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.SignInResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.SignInRequest) error); ok {
+		r1 = returnFunc(context1, signInRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_SignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignIn'
+type MockIGrpcHandler_SignIn_Call struct {
+	*mock.Call
+}
+
+// SignIn is a helper method to define mock.On call
+//   - context1 context.Context
+//   - signInRequest *auth.SignInRequest
+func (_e *MockIGrpcHandler_Expecter) SignIn(context1 interface{}, signInRequest interface{}) *MockIGrpcHandler_SignIn_Call {
+	return &MockIGrpcHandler_SignIn_Call{Call: _e.mock.On("SignIn", context1, signInRequest)}
+}
+
+func (_c *MockIGrpcHandler_SignIn_Call) Run(run func(context1 context.Context, signInRequest *auth.SignInRequest)) *MockIGrpcHandler_SignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.SignInRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.SignInRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_SignIn_Call) Return(signInResponse *auth.SignInResponse, err error) *MockIGrpcHandler_SignIn_Call {
+	_c.Call.Return(signInResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_SignIn_Call) RunAndReturn(run func(context1 context.Context, signInRequest *auth.SignInRequest) (*auth.SignInResponse, error)) *MockIGrpcHandler_SignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignOut provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) SignOut(context1 context.Context, signOutRequest *auth.SignOutRequest) (*auth.SignOutResponse, error) {
+	ret := _mock.Called(context1, signOutRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignOut")
+	}
+
+	var r0 *auth.SignOutResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignOutRequest) (*auth.SignOutResponse, error)); ok {
+		return returnFunc(context1, signOutRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignOutRequest) *auth.SignOutResponse); ok {
+		r0 = returnFunc(context1, signOutRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.SignOutResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.SignOutRequest) error); ok {
+		r1 = returnFunc(context1, signOutRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_SignOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignOut'
+type MockIGrpcHandler_SignOut_Call struct {
+	*mock.Call
+}
+
+// SignOut is a helper method to define mock.On call
+//   - context1 context.Context
+//   - signOutRequest *auth.SignOutRequest
+func (_e *MockIGrpcHandler_Expecter) SignOut(context1 interface{}, signOutRequest interface{}) *MockIGrpcHandler_SignOut_Call {
+	return &MockIGrpcHandler_SignOut_Call{Call: _e.mock.On("SignOut", context1, signOutRequest)}
+}
+
+func (_c *MockIGrpcHandler_SignOut_Call) Run(run func(context1 context.Context, signOutRequest *auth.SignOutRequest)) *MockIGrpcHandler_SignOut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.SignOutRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.SignOutRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_SignOut_Call) Return(signOutResponse *auth.SignOutResponse, err error) *MockIGrpcHandler_SignOut_Call {
+	_c.Call.Return(signOutResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_SignOut_Call) RunAndReturn(run func(context1 context.Context, signOutRequest *auth.SignOutRequest) (*auth.SignOutResponse, error)) *MockIGrpcHandler_SignOut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignUp provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) SignUp(context1 context.Context, signUpRequest *auth.SignUpRequest) (*auth.SignUpResponse, error) {
+	ret := _mock.Called(context1, signUpRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignUp")
+	}
+
+	var r0 *auth.SignUpResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignUpRequest) (*auth.SignUpResponse, error)); ok {
+		return returnFunc(context1, signUpRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.SignUpRequest) *auth.SignUpResponse); ok {
+		r0 = returnFunc(context1, signUpRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.SignUpResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.SignUpRequest) error); ok {
+		r1 = returnFunc(context1, signUpRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_SignUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignUp'
+type MockIGrpcHandler_SignUp_Call struct {
+	*mock.Call
+}
+
+// SignUp is a helper method to define mock.On call
+//   - context1 context.Context
+//   - signUpRequest *auth.SignUpRequest
+func (_e *MockIGrpcHandler_Expecter) SignUp(context1 interface{}, signUpRequest interface{}) *MockIGrpcHandler_SignUp_Call {
+	return &MockIGrpcHandler_SignUp_Call{Call: _e.mock.On("SignUp", context1, signUpRequest)}
+}
+
+func (_c *MockIGrpcHandler_SignUp_Call) Run(run func(context1 context.Context, signUpRequest *auth.SignUpRequest)) *MockIGrpcHandler_SignUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.SignUpRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.SignUpRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_SignUp_Call) Return(signUpResponse *auth.SignUpResponse, err error) *MockIGrpcHandler_SignUp_Call {
+	_c.Call.Return(signUpResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_SignUp_Call) RunAndReturn(run func(context1 context.Context, signUpRequest *auth.SignUpRequest) (*auth.SignUpResponse, error)) *MockIGrpcHandler_SignUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartOidcSignIn provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) StartOidcSignIn(context1 context.Context, startOidcSignInRequest *auth.StartOidcSignInRequest) (*auth.StartOidcSignInResponse, error) {
+	ret := _mock.Called(context1, startOidcSignInRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartOidcSignIn")
+	}
+
+	var r0 *auth.StartOidcSignInResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.StartOidcSignInRequest) (*auth.StartOidcSignInResponse, error)); ok {
+		return returnFunc(context1, startOidcSignInRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.StartOidcSignInRequest) *auth.StartOidcSignInResponse); ok {
+		r0 = returnFunc(context1, startOidcSignInRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.StartOidcSignInResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.StartOidcSignInRequest) error); ok {
+		r1 = returnFunc(context1, startOidcSignInRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_StartOidcSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartOidcSignIn'
+type MockIGrpcHandler_StartOidcSignIn_Call struct {
+	*mock.Call
+}
+
+// StartOidcSignIn is a helper method to define mock.On call
+//   - context1 context.Context
+//   - startOidcSignInRequest *auth.StartOidcSignInRequest
+func (_e *MockIGrpcHandler_Expecter) StartOidcSignIn(context1 interface{}, startOidcSignInRequest interface{}) *MockIGrpcHandler_StartOidcSignIn_Call {
+	return &MockIGrpcHandler_StartOidcSignIn_Call{Call: _e.mock.On("StartOidcSignIn", context1, startOidcSignInRequest)}
+}
+
+func (_c *MockIGrpcHandler_StartOidcSignIn_Call) Run(run func(context1 context.Context, startOidcSignInRequest *auth.StartOidcSignInRequest)) *MockIGrpcHandler_StartOidcSignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.StartOidcSignInRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.StartOidcSignInRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_StartOidcSignIn_Call) Return(startOidcSignInResponse *auth.StartOidcSignInResponse, err error) *MockIGrpcHandler_StartOidcSignIn_Call {
+	_c.Call.Return(startOidcSignInResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_StartOidcSignIn_Call) RunAndReturn(run func(context1 context.Context, startOidcSignInRequest *auth.StartOidcSignInRequest) (*auth.StartOidcSignInResponse, error)) *MockIGrpcHandler_StartOidcSignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) UpdateProfile(context1 context.Context, updateProfileRequest *auth.UpdateProfileRequest) (*auth.UpdateProfileResponse, error) {
+	ret := _mock.Called(context1, updateProfileRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *auth.UpdateProfileResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.UpdateProfileRequest) (*auth.UpdateProfileResponse, error)); ok {
+		return returnFunc(context1, updateProfileRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.UpdateProfileRequest) *auth.UpdateProfileResponse); ok {
+		r0 = returnFunc(context1, updateProfileRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.UpdateProfileResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.UpdateProfileRequest) error); ok {
+		r1 = returnFunc(context1, updateProfileRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockIGrpcHandler_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - context1 context.Context
+//   - updateProfileRequest *auth.UpdateProfileRequest
+func (_e *MockIGrpcHandler_Expecter) UpdateProfile(context1 interface{}, updateProfileRequest interface{}) *MockIGrpcHandler_UpdateProfile_Call {
+	return &MockIGrpcHandler_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", context1, updateProfileRequest)}
+}
+
+func (_c *MockIGrpcHandler_UpdateProfile_Call) Run(run func(context1 context.Context, updateProfileRequest *auth.UpdateProfileRequest)) *MockIGrpcHandler_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.UpdateProfileRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.UpdateProfileRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_UpdateProfile_Call) Return(updateProfileResponse *auth.UpdateProfileResponse, err error) *MockIGrpcHandler_UpdateProfile_Call {
+	_c.Call.Return(updateProfileResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_UpdateProfile_Call) RunAndReturn(run func(context1 context.Context, updateProfileRequest *auth.UpdateProfileRequest) (*auth.UpdateProfileResponse, error)) *MockIGrpcHandler_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmailAddress provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) VerifyEmailAddress(context1 context.Context, verifyEmailAddressRequest *auth.VerifyEmailAddressRequest) (*auth.VerifyEmailAddressResponse, error) {
+	ret := _mock.Called(context1, verifyEmailAddressRequest)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmailAddress")
+	}
+
+	var r0 *auth.VerifyEmailAddressResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.VerifyEmailAddressRequest) (*auth.VerifyEmailAddressResponse, error)); ok {
+		return returnFunc(context1, verifyEmailAddressRequest)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, *auth.VerifyEmailAddressRequest) *auth.VerifyEmailAddressResponse); ok {
+		r0 = returnFunc(context1, verifyEmailAddressRequest)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*auth.VerifyEmailAddressResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, *auth.VerifyEmailAddressRequest) error); ok {
+		r1 = returnFunc(context1, verifyEmailAddressRequest)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIGrpcHandler_VerifyEmailAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmailAddress'
+type MockIGrpcHandler_VerifyEmailAddress_Call struct {
+	*mock.Call
+}
+
+// VerifyEmailAddress is a helper method to define mock.On call
+//   - context1 context.Context
+//   - verifyEmailAddressRequest *auth.VerifyEmailAddressRequest
+func (_e *MockIGrpcHandler_Expecter) VerifyEmailAddress(context1 interface{}, verifyEmailAddressRequest interface{}) *MockIGrpcHandler_VerifyEmailAddress_Call {
+	return &MockIGrpcHandler_VerifyEmailAddress_Call{Call: _e.mock.On("VerifyEmailAddress", context1, verifyEmailAddressRequest)}
+}
+
+func (_c *MockIGrpcHandler_VerifyEmailAddress_Call) Run(run func(context1 context.Context, verifyEmailAddressRequest *auth.VerifyEmailAddressRequest)) *MockIGrpcHandler_VerifyEmailAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 *auth.VerifyEmailAddressRequest
+		if args[1] != nil {
+			arg1 = args[1].(*auth.VerifyEmailAddressRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_VerifyEmailAddress_Call) Return(verifyEmailAddressResponse *auth.VerifyEmailAddressResponse, err error) *MockIGrpcHandler_VerifyEmailAddress_Call {
+	_c.Call.Return(verifyEmailAddressResponse, err)
+	return _c
+}
+
+func (_c *MockIGrpcHandler_VerifyEmailAddress_Call) RunAndReturn(run func(context1 context.Context, verifyEmailAddressRequest *auth.VerifyEmailAddressRequest) (*auth.VerifyEmailAddressResponse, error)) *MockIGrpcHandler_VerifyEmailAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// mustEmbedUnimplementedServiceServer provides a mock function for the type MockIGrpcHandler
+func (_mock *MockIGrpcHandler) mustEmbedUnimplementedServiceServer() {
+	_mock.Called()
+	return
+}
+
+// MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'mustEmbedUnimplementedServiceServer'
+type MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call struct {
+	*mock.Call
+}
+
+// mustEmbedUnimplementedServiceServer is a helper method to define mock.On call
+func (_e *MockIGrpcHandler_Expecter) mustEmbedUnimplementedServiceServer() *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
+	return &MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call{Call: _e.mock.On("mustEmbedUnimplementedServiceServer")}
+}
+
+func (_c *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call) Run(run func()) *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run()
+	})
+	return _c
+}
+
+func (_c *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call) Return() *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
+	_c.Call.Return()
+	return _c
+}
+
+func (_c *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call) RunAndReturn(run func()) *MockIGrpcHandler_mustEmbedUnimplementedServiceServer_Call {
+	_c.Run(run)
+	return _c
+}
+
+// NewMockIHttpHandler creates a new instance of MockIHttpHandler. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIHttpHandler(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIHttpHandler {
+	mock := &MockIHttpHandler{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIHttpHandler is an autogenerated mock type for the IHttpHandler type
+type MockIHttpHandler struct {
+	mock.Mock
+}
+
+type MockIHttpHandler_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIHttpHandler) EXPECT() *MockIHttpHandler_Expecter {
+	return &MockIHttpHandler_Expecter{mock: &_m.Mock}
+}
+
+// ChangeEmailAddress provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) ChangeEmailAddress(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeEmailAddress")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_ChangeEmailAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeEmailAddress'
+type MockIHttpHandler_ChangeEmailAddress_Call struct {
+	*mock.Call
+}
+
+// ChangeEmailAddress is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) ChangeEmailAddress(c interface{}) *MockIHttpHandler_ChangeEmailAddress_Call {
+	return &MockIHttpHandler_ChangeEmailAddress_Call{Call: _e.mock.On("ChangeEmailAddress", c)}
+}
+
+func (_c *MockIHttpHandler_ChangeEmailAddress_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_ChangeEmailAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_ChangeEmailAddress_Call) Return(err error) *MockIHttpHandler_ChangeEmailAddress_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_ChangeEmailAddress_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_ChangeEmailAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangePassword provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) ChangePassword(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockIHttpHandler_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) ChangePassword(c interface{}) *MockIHttpHandler_ChangePassword_Call {
+	return &MockIHttpHandler_ChangePassword_Call{Call: _e.mock.On("ChangePassword", c)}
+}
+
+func (_c *MockIHttpHandler_ChangePassword_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_ChangePassword_Call) Return(err error) *MockIHttpHandler_ChangePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_ChangePassword_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteOidcSignIn provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) CompleteOidcSignIn(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteOidcSignIn")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_CompleteOidcSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteOidcSignIn'
+type MockIHttpHandler_CompleteOidcSignIn_Call struct {
+	*mock.Call
+}
+
+// CompleteOidcSignIn is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) CompleteOidcSignIn(c interface{}) *MockIHttpHandler_CompleteOidcSignIn_Call {
+	return &MockIHttpHandler_CompleteOidcSignIn_Call{Call: _e.mock.On("CompleteOidcSignIn", c)}
+}
+
+func (_c *MockIHttpHandler_CompleteOidcSignIn_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_CompleteOidcSignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_CompleteOidcSignIn_Call) Return(err error) *MockIHttpHandler_CompleteOidcSignIn_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_CompleteOidcSignIn_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_CompleteOidcSignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteSignIn provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) CompleteSignIn(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteSignIn")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_CompleteSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteSignIn'
+type MockIHttpHandler_CompleteSignIn_Call struct {
+	*mock.Call
+}
+
+// CompleteSignIn is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) CompleteSignIn(c interface{}) *MockIHttpHandler_CompleteSignIn_Call {
+	return &MockIHttpHandler_CompleteSignIn_Call{Call: _e.mock.On("CompleteSignIn", c)}
+}
+
+func (_c *MockIHttpHandler_CompleteSignIn_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_CompleteSignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_CompleteSignIn_Call) Return(err error) *MockIHttpHandler_CompleteSignIn_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_CompleteSignIn_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_CompleteSignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmTotp provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) ConfirmTotp(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmTotp")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_ConfirmTotp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmTotp'
+type MockIHttpHandler_ConfirmTotp_Call struct {
+	*mock.Call
+}
+
+// ConfirmTotp is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) ConfirmTotp(c interface{}) *MockIHttpHandler_ConfirmTotp_Call {
+	return &MockIHttpHandler_ConfirmTotp_Call{Call: _e.mock.On("ConfirmTotp", c)}
+}
+
+func (_c *MockIHttpHandler_ConfirmTotp_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_ConfirmTotp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_ConfirmTotp_Call) Return(err error) *MockIHttpHandler_ConfirmTotp_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_ConfirmTotp_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_ConfirmTotp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeMagicLink provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) ConsumeMagicLink(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeMagicLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_ConsumeMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeMagicLink'
+type MockIHttpHandler_ConsumeMagicLink_Call struct {
+	*mock.Call
+}
+
+// ConsumeMagicLink is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) ConsumeMagicLink(c interface{}) *MockIHttpHandler_ConsumeMagicLink_Call {
+	return &MockIHttpHandler_ConsumeMagicLink_Call{Call: _e.mock.On("ConsumeMagicLink", c)}
+}
+
+func (_c *MockIHttpHandler_ConsumeMagicLink_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_ConsumeMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_ConsumeMagicLink_Call) Return(err error) *MockIHttpHandler_ConsumeMagicLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_ConsumeMagicLink_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_ConsumeMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) DeleteAccount(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type MockIHttpHandler_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) DeleteAccount(c interface{}) *MockIHttpHandler_DeleteAccount_Call {
+	return &MockIHttpHandler_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", c)}
+}
+
+func (_c *MockIHttpHandler_DeleteAccount_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_DeleteAccount_Call) Return(err error) *MockIHttpHandler_DeleteAccount_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_DeleteAccount_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollTotp provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) EnrollTotp(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollTotp")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_EnrollTotp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollTotp'
+type MockIHttpHandler_EnrollTotp_Call struct {
+	*mock.Call
+}
+
+// EnrollTotp is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) EnrollTotp(c interface{}) *MockIHttpHandler_EnrollTotp_Call {
+	return &MockIHttpHandler_EnrollTotp_Call{Call: _e.mock.On("EnrollTotp", c)}
+}
+
+func (_c *MockIHttpHandler_EnrollTotp_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_EnrollTotp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_EnrollTotp_Call) Return(err error) *MockIHttpHandler_EnrollTotp_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_EnrollTotp_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_EnrollTotp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) ListSessions(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockIHttpHandler_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) ListSessions(c interface{}) *MockIHttpHandler_ListSessions_Call {
+	return &MockIHttpHandler_ListSessions_Call{Call: _e.mock.On("ListSessions", c)}
+}
+
+func (_c *MockIHttpHandler_ListSessions_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_ListSessions_Call) Return(err error) *MockIHttpHandler_ListSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_ListSessions_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Me provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) Me(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Me")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_Me_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Me'
+type MockIHttpHandler_Me_Call struct {
+	*mock.Call
+}
+
+// Me is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) Me(c interface{}) *MockIHttpHandler_Me_Call {
+	return &MockIHttpHandler_Me_Call{Call: _e.mock.On("Me", c)}
+}
+
+func (_c *MockIHttpHandler_Me_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_Me_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_Me_Call) Return(err error) *MockIHttpHandler_Me_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_Me_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_Me_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestMagicLink provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) RequestMagicLink(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestMagicLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_RequestMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestMagicLink'
+type MockIHttpHandler_RequestMagicLink_Call struct {
+	*mock.Call
+}
+
+// RequestMagicLink is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) RequestMagicLink(c interface{}) *MockIHttpHandler_RequestMagicLink_Call {
+	return &MockIHttpHandler_RequestMagicLink_Call{Call: _e.mock.On("RequestMagicLink", c)}
+}
+
+func (_c *MockIHttpHandler_RequestMagicLink_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_RequestMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_RequestMagicLink_Call) Return(err error) *MockIHttpHandler_RequestMagicLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_RequestMagicLink_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_RequestMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) RequestPasswordReset(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type MockIHttpHandler_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) RequestPasswordReset(c interface{}) *MockIHttpHandler_RequestPasswordReset_Call {
+	return &MockIHttpHandler_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", c)}
+}
+
+func (_c *MockIHttpHandler_RequestPasswordReset_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_RequestPasswordReset_Call) Return(err error) *MockIHttpHandler_RequestPasswordReset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_RequestPasswordReset_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendEmailVerification provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) ResendEmailVerification(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendEmailVerification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_ResendEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendEmailVerification'
+type MockIHttpHandler_ResendEmailVerification_Call struct {
+	*mock.Call
+}
+
+// ResendEmailVerification is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) ResendEmailVerification(c interface{}) *MockIHttpHandler_ResendEmailVerification_Call {
+	return &MockIHttpHandler_ResendEmailVerification_Call{Call: _e.mock.On("ResendEmailVerification", c)}
+}
+
+func (_c *MockIHttpHandler_ResendEmailVerification_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_ResendEmailVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_ResendEmailVerification_Call) Return(err error) *MockIHttpHandler_ResendEmailVerification_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_ResendEmailVerification_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_ResendEmailVerification_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResetPassword provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) ResetPassword(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResetPassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type MockIHttpHandler_ResetPassword_Call struct {
+	*mock.Call
+}
+
+// ResetPassword is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) ResetPassword(c interface{}) *MockIHttpHandler_ResetPassword_Call {
+	return &MockIHttpHandler_ResetPassword_Call{Call: _e.mock.On("ResetPassword", c)}
+}
+
+func (_c *MockIHttpHandler_ResetPassword_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_ResetPassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_ResetPassword_Call) Return(err error) *MockIHttpHandler_ResetPassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_ResetPassword_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_ResetPassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeOtherSessions provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) RevokeOtherSessions(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeOtherSessions")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type MockIHttpHandler_RevokeOtherSessions_Call struct {
+	*mock.Call
+}
+
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) RevokeOtherSessions(c interface{}) *MockIHttpHandler_RevokeOtherSessions_Call {
+	return &MockIHttpHandler_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", c)}
+}
+
+func (_c *MockIHttpHandler_RevokeOtherSessions_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_RevokeOtherSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_RevokeOtherSessions_Call) Return(err error) *MockIHttpHandler_RevokeOtherSessions_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_RevokeOtherSessions_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_RevokeOtherSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RevokeSession provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) RevokeSession(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RevokeSession")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockIHttpHandler_RevokeSession_Call struct {
+	*mock.Call
+}
+
+// RevokeSession is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) RevokeSession(c interface{}) *MockIHttpHandler_RevokeSession_Call {
+	return &MockIHttpHandler_RevokeSession_Call{Call: _e.mock.On("RevokeSession", c)}
+}
+
+func (_c *MockIHttpHandler_RevokeSession_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_RevokeSession_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_RevokeSession_Call) Return(err error) *MockIHttpHandler_RevokeSession_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_RevokeSession_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_RevokeSession_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignIn provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) SignIn(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignIn")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_SignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignIn'
+type MockIHttpHandler_SignIn_Call struct {
+	*mock.Call
+}
+
+// SignIn is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) SignIn(c interface{}) *MockIHttpHandler_SignIn_Call {
+	return &MockIHttpHandler_SignIn_Call{Call: _e.mock.On("SignIn", c)}
+}
+
+func (_c *MockIHttpHandler_SignIn_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_SignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_SignIn_Call) Return(err error) *MockIHttpHandler_SignIn_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_SignIn_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_SignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignOut provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) SignOut(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignOut")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_SignOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignOut'
+type MockIHttpHandler_SignOut_Call struct {
+	*mock.Call
+}
+
+// SignOut is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) SignOut(c interface{}) *MockIHttpHandler_SignOut_Call {
+	return &MockIHttpHandler_SignOut_Call{Call: _e.mock.On("SignOut", c)}
+}
+
+func (_c *MockIHttpHandler_SignOut_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_SignOut_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_SignOut_Call) Return(err error) *MockIHttpHandler_SignOut_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_SignOut_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_SignOut_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SignUp provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) SignUp(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SignUp")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_SignUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignUp'
+type MockIHttpHandler_SignUp_Call struct {
+	*mock.Call
+}
+
+// SignUp is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) SignUp(c interface{}) *MockIHttpHandler_SignUp_Call {
+	return &MockIHttpHandler_SignUp_Call{Call: _e.mock.On("SignUp", c)}
+}
+
+func (_c *MockIHttpHandler_SignUp_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_SignUp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_SignUp_Call) Return(err error) *MockIHttpHandler_SignUp_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_SignUp_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_SignUp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// StartOidcSignIn provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) StartOidcSignIn(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartOidcSignIn")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_StartOidcSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartOidcSignIn'
+type MockIHttpHandler_StartOidcSignIn_Call struct {
+	*mock.Call
+}
+
+// StartOidcSignIn is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) StartOidcSignIn(c interface{}) *MockIHttpHandler_StartOidcSignIn_Call {
+	return &MockIHttpHandler_StartOidcSignIn_Call{Call: _e.mock.On("StartOidcSignIn", c)}
+}
+
+func (_c *MockIHttpHandler_StartOidcSignIn_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_StartOidcSignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_StartOidcSignIn_Call) Return(err error) *MockIHttpHandler_StartOidcSignIn_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_StartOidcSignIn_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_StartOidcSignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) UpdateProfile(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockIHttpHandler_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) UpdateProfile(c interface{}) *MockIHttpHandler_UpdateProfile_Call {
+	return &MockIHttpHandler_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", c)}
+}
+
+func (_c *MockIHttpHandler_UpdateProfile_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_UpdateProfile_Call) Return(err error) *MockIHttpHandler_UpdateProfile_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_UpdateProfile_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmailAddress provides a mock function for the type MockIHttpHandler
+func (_mock *MockIHttpHandler) VerifyEmailAddress(c *echo.Context) error {
+	ret := _mock.Called(c)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmailAddress")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
+		r0 = returnFunc(c)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIHttpHandler_VerifyEmailAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmailAddress'
+type MockIHttpHandler_VerifyEmailAddress_Call struct {
+	*mock.Call
+}
+
+// VerifyEmailAddress is a helper method to define mock.On call
+//   - c *echo.Context
+func (_e *MockIHttpHandler_Expecter) VerifyEmailAddress(c interface{}) *MockIHttpHandler_VerifyEmailAddress_Call {
+	return &MockIHttpHandler_VerifyEmailAddress_Call{Call: _e.mock.On("VerifyEmailAddress", c)}
+}
+
+func (_c *MockIHttpHandler_VerifyEmailAddress_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_VerifyEmailAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 *echo.Context
+		if args[0] != nil {
+			arg0 = args[0].(*echo.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIHttpHandler_VerifyEmailAddress_Call) Return(err error) *MockIHttpHandler_VerifyEmailAddress_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIHttpHandler_VerifyEmailAddress_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_VerifyEmailAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// NewMockIUsecase creates a new instance of MockIUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewMockIUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *MockIUsecase {
+	mock := &MockIUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
+
+// MockIUsecase is an autogenerated mock type for the IUsecase type
+type MockIUsecase struct {
+	mock.Mock
+}
+
+type MockIUsecase_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *MockIUsecase) EXPECT() *MockIUsecase_Expecter {
+	return &MockIUsecase_Expecter{mock: &_m.Mock}
+}
+
+// ChangeEmailAddress provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) ChangeEmailAddress(ctx context.Context, req ChangeEmailAddressRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangeEmailAddress")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ChangeEmailAddressRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUsecase_ChangeEmailAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangeEmailAddress'
+type MockIUsecase_ChangeEmailAddress_Call struct {
+	*mock.Call
+}
+
+// ChangeEmailAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req ChangeEmailAddressRequest
+func (_e *MockIUsecase_Expecter) ChangeEmailAddress(ctx interface{}, req interface{}) *MockIUsecase_ChangeEmailAddress_Call {
+	return &MockIUsecase_ChangeEmailAddress_Call{Call: _e.mock.On("ChangeEmailAddress", ctx, req)}
+}
+
+func (_c *MockIUsecase_ChangeEmailAddress_Call) Run(run func(ctx context.Context, req ChangeEmailAddressRequest)) *MockIUsecase_ChangeEmailAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ChangeEmailAddressRequest
+		if args[1] != nil {
+			arg1 = args[1].(ChangeEmailAddressRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_ChangeEmailAddress_Call) Return(err error) *MockIUsecase_ChangeEmailAddress_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUsecase_ChangeEmailAddress_Call) RunAndReturn(run func(ctx context.Context, req ChangeEmailAddressRequest) error) *MockIUsecase_ChangeEmailAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ChangePassword provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) ChangePassword(ctx context.Context, req ChangePasswordRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ChangePassword")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ChangePasswordRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUsecase_ChangePassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ChangePassword'
+type MockIUsecase_ChangePassword_Call struct {
+	*mock.Call
+}
+
+// ChangePassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req ChangePasswordRequest
+func (_e *MockIUsecase_Expecter) ChangePassword(ctx interface{}, req interface{}) *MockIUsecase_ChangePassword_Call {
+	return &MockIUsecase_ChangePassword_Call{Call: _e.mock.On("ChangePassword", ctx, req)}
+}
+
+func (_c *MockIUsecase_ChangePassword_Call) Run(run func(ctx context.Context, req ChangePasswordRequest)) *MockIUsecase_ChangePassword_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ChangePasswordRequest
+		if args[1] != nil {
+			arg1 = args[1].(ChangePasswordRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_ChangePassword_Call) Return(err error) *MockIUsecase_ChangePassword_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUsecase_ChangePassword_Call) RunAndReturn(run func(ctx context.Context, req ChangePasswordRequest) error) *MockIUsecase_ChangePassword_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteOidcSignIn provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) CompleteOidcSignIn(ctx context.Context, req CompleteOidcSignInRequest) (*SignInResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteOidcSignIn")
+	}
+
+	var r0 *SignInResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CompleteOidcSignInRequest) (*SignInResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CompleteOidcSignInRequest) *SignInResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SignInResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CompleteOidcSignInRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_CompleteOidcSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteOidcSignIn'
+type MockIUsecase_CompleteOidcSignIn_Call struct {
+	*mock.Call
+}
+
+// CompleteOidcSignIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req CompleteOidcSignInRequest
+func (_e *MockIUsecase_Expecter) CompleteOidcSignIn(ctx interface{}, req interface{}) *MockIUsecase_CompleteOidcSignIn_Call {
+	return &MockIUsecase_CompleteOidcSignIn_Call{Call: _e.mock.On("CompleteOidcSignIn", ctx, req)}
+}
+
+func (_c *MockIUsecase_CompleteOidcSignIn_Call) Run(run func(ctx context.Context, req CompleteOidcSignInRequest)) *MockIUsecase_CompleteOidcSignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CompleteOidcSignInRequest
+		if args[1] != nil {
+			arg1 = args[1].(CompleteOidcSignInRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_CompleteOidcSignIn_Call) Return(signInResponse *SignInResponse, err error) *MockIUsecase_CompleteOidcSignIn_Call {
+	_c.Call.Return(signInResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_CompleteOidcSignIn_Call) RunAndReturn(run func(ctx context.Context, req CompleteOidcSignInRequest) (*SignInResponse, error)) *MockIUsecase_CompleteOidcSignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteSignIn provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) CompleteSignIn(ctx context.Context, req CompleteSignInRequest) (*SignInResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteSignIn")
+	}
+
+	var r0 *SignInResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CompleteSignInRequest) (*SignInResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, CompleteSignInRequest) *SignInResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SignInResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, CompleteSignInRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_CompleteSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteSignIn'
+type MockIUsecase_CompleteSignIn_Call struct {
+	*mock.Call
+}
+
+// CompleteSignIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req CompleteSignInRequest
+func (_e *MockIUsecase_Expecter) CompleteSignIn(ctx interface{}, req interface{}) *MockIUsecase_CompleteSignIn_Call {
+	return &MockIUsecase_CompleteSignIn_Call{Call: _e.mock.On("CompleteSignIn", ctx, req)}
+}
+
+func (_c *MockIUsecase_CompleteSignIn_Call) Run(run func(ctx context.Context, req CompleteSignInRequest)) *MockIUsecase_CompleteSignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 CompleteSignInRequest
+		if args[1] != nil {
+			arg1 = args[1].(CompleteSignInRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_CompleteSignIn_Call) Return(signInResponse *SignInResponse, err error) *MockIUsecase_CompleteSignIn_Call {
+	_c.Call.Return(signInResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_CompleteSignIn_Call) RunAndReturn(run func(ctx context.Context, req CompleteSignInRequest) (*SignInResponse, error)) *MockIUsecase_CompleteSignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConfirmTotp provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) ConfirmTotp(ctx context.Context, req ConfirmTotpRequest) (*ConfirmTotpResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConfirmTotp")
+	}
+
+	var r0 *ConfirmTotpResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConfirmTotpRequest) (*ConfirmTotpResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConfirmTotpRequest) *ConfirmTotpResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ConfirmTotpResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ConfirmTotpRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_ConfirmTotp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConfirmTotp'
+type MockIUsecase_ConfirmTotp_Call struct {
+	*mock.Call
+}
+
+// ConfirmTotp is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req ConfirmTotpRequest
+func (_e *MockIUsecase_Expecter) ConfirmTotp(ctx interface{}, req interface{}) *MockIUsecase_ConfirmTotp_Call {
+	return &MockIUsecase_ConfirmTotp_Call{Call: _e.mock.On("ConfirmTotp", ctx, req)}
+}
+
+func (_c *MockIUsecase_ConfirmTotp_Call) Run(run func(ctx context.Context, req ConfirmTotpRequest)) *MockIUsecase_ConfirmTotp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ConfirmTotpRequest
+		if args[1] != nil {
+			arg1 = args[1].(ConfirmTotpRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_ConfirmTotp_Call) Return(confirmTotpResponse *ConfirmTotpResponse, err error) *MockIUsecase_ConfirmTotp_Call {
+	_c.Call.Return(confirmTotpResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_ConfirmTotp_Call) RunAndReturn(run func(ctx context.Context, req ConfirmTotpRequest) (*ConfirmTotpResponse, error)) *MockIUsecase_ConfirmTotp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ConsumeMagicLink provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) ConsumeMagicLink(ctx context.Context, req ConsumeMagicLinkRequest) (*SignInResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ConsumeMagicLink")
+	}
+
+	var r0 *SignInResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConsumeMagicLinkRequest) (*SignInResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ConsumeMagicLinkRequest) *SignInResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*SignInResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, ConsumeMagicLinkRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_ConsumeMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ConsumeMagicLink'
+type MockIUsecase_ConsumeMagicLink_Call struct {
+	*mock.Call
+}
+
+// ConsumeMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req ConsumeMagicLinkRequest
+func (_e *MockIUsecase_Expecter) ConsumeMagicLink(ctx interface{}, req interface{}) *MockIUsecase_ConsumeMagicLink_Call {
+	return &MockIUsecase_ConsumeMagicLink_Call{Call: _e.mock.On("ConsumeMagicLink", ctx, req)}
+}
+
+func (_c *MockIUsecase_ConsumeMagicLink_Call) Run(run func(ctx context.Context, req ConsumeMagicLinkRequest)) *MockIUsecase_ConsumeMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ConsumeMagicLinkRequest
+		if args[1] != nil {
+			arg1 = args[1].(ConsumeMagicLinkRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_ConsumeMagicLink_Call) Return(signInResponse *SignInResponse, err error) *MockIUsecase_ConsumeMagicLink_Call {
+	_c.Call.Return(signInResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_ConsumeMagicLink_Call) RunAndReturn(run func(ctx context.Context, req ConsumeMagicLinkRequest) (*SignInResponse, error)) *MockIUsecase_ConsumeMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteAccount provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) DeleteAccount(ctx context.Context, req DeleteAccountRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteAccount")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, DeleteAccountRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUsecase_DeleteAccount_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteAccount'
+type MockIUsecase_DeleteAccount_Call struct {
+	*mock.Call
+}
+
+// DeleteAccount is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req DeleteAccountRequest
+func (_e *MockIUsecase_Expecter) DeleteAccount(ctx interface{}, req interface{}) *MockIUsecase_DeleteAccount_Call {
+	return &MockIUsecase_DeleteAccount_Call{Call: _e.mock.On("DeleteAccount", ctx, req)}
+}
+
+func (_c *MockIUsecase_DeleteAccount_Call) Run(run func(ctx context.Context, req DeleteAccountRequest)) *MockIUsecase_DeleteAccount_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 DeleteAccountRequest
+		if args[1] != nil {
+			arg1 = args[1].(DeleteAccountRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_DeleteAccount_Call) Return(err error) *MockIUsecase_DeleteAccount_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUsecase_DeleteAccount_Call) RunAndReturn(run func(ctx context.Context, req DeleteAccountRequest) error) *MockIUsecase_DeleteAccount_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// EnrollTotp provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) EnrollTotp(ctx context.Context) (*EnrollTotpResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for EnrollTotp")
+	}
+
+	var r0 *EnrollTotpResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*EnrollTotpResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *EnrollTotpResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*EnrollTotpResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_EnrollTotp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'EnrollTotp'
+type MockIUsecase_EnrollTotp_Call struct {
+	*mock.Call
+}
+
+// EnrollTotp is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUsecase_Expecter) EnrollTotp(ctx interface{}) *MockIUsecase_EnrollTotp_Call {
+	return &MockIUsecase_EnrollTotp_Call{Call: _e.mock.On("EnrollTotp", ctx)}
+}
+
+func (_c *MockIUsecase_EnrollTotp_Call) Run(run func(ctx context.Context)) *MockIUsecase_EnrollTotp_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_EnrollTotp_Call) Return(enrollTotpResponse *EnrollTotpResponse, err error) *MockIUsecase_EnrollTotp_Call {
+	_c.Call.Return(enrollTotpResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_EnrollTotp_Call) RunAndReturn(run func(ctx context.Context) (*EnrollTotpResponse, error)) *MockIUsecase_EnrollTotp_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListSessions provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) ListSessions(ctx context.Context) (*ListSessionsResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListSessions")
+	}
+
+	var r0 *ListSessionsResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*ListSessionsResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *ListSessionsResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*ListSessionsResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_ListSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListSessions'
+type MockIUsecase_ListSessions_Call struct {
+	*mock.Call
+}
+
+// ListSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUsecase_Expecter) ListSessions(ctx interface{}) *MockIUsecase_ListSessions_Call {
+	return &MockIUsecase_ListSessions_Call{Call: _e.mock.On("ListSessions", ctx)}
+}
+
+func (_c *MockIUsecase_ListSessions_Call) Run(run func(ctx context.Context)) *MockIUsecase_ListSessions_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_ListSessions_Call) Return(listSessionsResponse *ListSessionsResponse, err error) *MockIUsecase_ListSessions_Call {
+	_c.Call.Return(listSessionsResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_ListSessions_Call) RunAndReturn(run func(ctx context.Context) (*ListSessionsResponse, error)) *MockIUsecase_ListSessions_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// Me provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) Me(ctx context.Context) (*MeResponse, error) {
+	ret := _mock.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Me")
+	}
+
+	var r0 *MeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context) (*MeResponse, error)); ok {
+		return returnFunc(ctx)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context) *MeResponse); ok {
+		r0 = returnFunc(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = returnFunc(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_Me_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Me'
+type MockIUsecase_Me_Call struct {
+	*mock.Call
+}
+
+// Me is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUsecase_Expecter) Me(ctx interface{}) *MockIUsecase_Me_Call {
+	return &MockIUsecase_Me_Call{Call: _e.mock.On("Me", ctx)}
+}
+
+func (_c *MockIUsecase_Me_Call) Run(run func(ctx context.Context)) *MockIUsecase_Me_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		run(
+			arg0,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_Me_Call) Return(meResponse *MeResponse, err error) *MockIUsecase_Me_Call {
+	_c.Call.Return(meResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_Me_Call) RunAndReturn(run func(ctx context.Context) (*MeResponse, error)) *MockIUsecase_Me_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestMagicLink provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) RequestMagicLink(ctx context.Context, req RequestMagicLinkRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestMagicLink")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RequestMagicLinkRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUsecase_RequestMagicLink_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestMagicLink'
+type MockIUsecase_RequestMagicLink_Call struct {
+	*mock.Call
+}
+
+// RequestMagicLink is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req RequestMagicLinkRequest
+func (_e *MockIUsecase_Expecter) RequestMagicLink(ctx interface{}, req interface{}) *MockIUsecase_RequestMagicLink_Call {
+	return &MockIUsecase_RequestMagicLink_Call{Call: _e.mock.On("RequestMagicLink", ctx, req)}
+}
+
+func (_c *MockIUsecase_RequestMagicLink_Call) Run(run func(ctx context.Context, req RequestMagicLinkRequest)) *MockIUsecase_RequestMagicLink_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RequestMagicLinkRequest
+		if args[1] != nil {
+			arg1 = args[1].(RequestMagicLinkRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_RequestMagicLink_Call) Return(err error) *MockIUsecase_RequestMagicLink_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUsecase_RequestMagicLink_Call) RunAndReturn(run func(ctx context.Context, req RequestMagicLinkRequest) error) *MockIUsecase_RequestMagicLink_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RequestPasswordReset provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) RequestPasswordReset(ctx context.Context, req RequestPasswordResetRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RequestPasswordReset")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RequestPasswordResetRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUsecase_RequestPasswordReset_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RequestPasswordReset'
+type MockIUsecase_RequestPasswordReset_Call struct {
+	*mock.Call
+}
+
+// RequestPasswordReset is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req RequestPasswordResetRequest
+func (_e *MockIUsecase_Expecter) RequestPasswordReset(ctx interface{}, req interface{}) *MockIUsecase_RequestPasswordReset_Call {
+	return &MockIUsecase_RequestPasswordReset_Call{Call: _e.mock.On("RequestPasswordReset", ctx, req)}
+}
+
+func (_c *MockIUsecase_RequestPasswordReset_Call) Run(run func(ctx context.Context, req RequestPasswordResetRequest)) *MockIUsecase_RequestPasswordReset_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 RequestPasswordResetRequest
+		if args[1] != nil {
+			arg1 = args[1].(RequestPasswordResetRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_RequestPasswordReset_Call) Return(err error) *MockIUsecase_RequestPasswordReset_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUsecase_RequestPasswordReset_Call) RunAndReturn(run func(ctx context.Context, req RequestPasswordResetRequest) error) *MockIUsecase_RequestPasswordReset_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ResendEmailVerification provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) ResendEmailVerification(ctx context.Context, req ResendEmailVerificationRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResendEmailVerification")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ResendEmailVerificationRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUsecase_ResendEmailVerification_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResendEmailVerification'
+type MockIUsecase_ResendEmailVerification_Call struct {
+	*mock.Call
+}
+
+// ResendEmailVerification is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req ResendEmailVerificationRequest
+func (_e *MockIUsecase_Expecter) ResendEmailVerification(ctx interface{}, req interface{}) *MockIUsecase_ResendEmailVerification_Call {
+	return &MockIUsecase_ResendEmailVerification_Call{Call: _e.mock.On("ResendEmailVerification", ctx, req)}
+}
+
+func (_c *MockIUsecase_ResendEmailVerification_Call) Run(run func(ctx context.Context, req ResendEmailVerificationRequest)) *MockIUsecase_ResendEmailVerification_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ResendEmailVerificationRequest
+		if args[1] != nil {
+			arg1 = args[1].(ResendEmailVerificationRequest)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIHttpHandler_SignIn_Call) Return(err error) *MockIHttpHandler_SignIn_Call {
+func (_c *MockIUsecase_ResendEmailVerification_Call) Return(err error) *MockIUsecase_ResendEmailVerification_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIHttpHandler_SignIn_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_SignIn_Call {
+func (_c *MockIUsecase_ResendEmailVerification_Call) RunAndReturn(run func(ctx context.Context, req ResendEmailVerificationRequest) error) *MockIUsecase_ResendEmailVerification_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SignOut provides a mock function for the type MockIHttpHandler
-func (_mock *MockIHttpHandler) SignOut(c *echo.Context) error {
-	ret := _mock.Called(c)
+// ResetPassword provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SignOut")
+		panic("no return value specified for ResetPassword")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
-		r0 = returnFunc(c)
+	if returnFunc, ok := ret.Get(0).(func(context.Context, ResetPasswordRequest) error); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIHttpHandler_SignOut_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignOut'
-type MockIHttpHandler_SignOut_Call struct {
+// MockIUsecase_ResetPassword_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ResetPassword'
+type MockIUsecase_ResetPassword_Call struct {
 	*mock.Call
 }
 
-// SignOut is a helper method to define mock.On call
-//   - c *echo.Context
-func (_e *MockIHttpHandler_Expecter) SignOut(c interface{}) *MockIHttpHandler_SignOut_Call {
-	return &MockIHttpHandler_SignOut_Call{Call: _e.mock.On("SignOut", c)}
+// ResetPassword is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req ResetPasswordRequest
+func (_e *MockIUsecase_Expecter) ResetPassword(ctx interface{}, req interface{}) *MockIUsecase_ResetPassword_Call {
+	return &MockIUsecase_ResetPassword_Call{Call: _e.mock.On("ResetPassword", ctx, req)}
 }
 
-func (_c *MockIHttpHandler_SignOut_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_SignOut_Call {
+func (_c *MockIUsecase_ResetPassword_Call) Run(run func(ctx context.Context, req ResetPasswordRequest)) *MockIUsecase_ResetPassword_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *echo.Context
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*echo.Context)
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 ResetPasswordRequest
+		if args[1] != nil {
+			arg1 = args[1].(ResetPasswordRequest)
 		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIHttpHandler_SignOut_Call) Return(err error) *MockIHttpHandler_SignOut_Call {
+func (_c *MockIUsecase_ResetPassword_Call) Return(err error) *MockIUsecase_ResetPassword_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIHttpHandler_SignOut_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_SignOut_Call {
+func (_c *MockIUsecase_ResetPassword_Call) RunAndReturn(run func(ctx context.Context, req ResetPasswordRequest) error) *MockIUsecase_ResetPassword_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// SignUp provides a mock function for the type MockIHttpHandler
-func (_mock *MockIHttpHandler) SignUp(c *echo.Context) error {
-	ret := _mock.Called(c)
+// RevokeOtherSessions provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) RevokeOtherSessions(ctx context.Context) error {
+	ret := _mock.Called(ctx)
 
 	if len(ret) == 0 {
-		panic("no return value specified for SignUp")
+		panic("no return value specified for RevokeOtherSessions")
 	}
 
 	var r0 error
-	if returnFunc, ok := ret.Get(0).(func(*echo.Context) error); ok {
-		r0 = returnFunc(c)
+	if returnFunc, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = returnFunc(ctx)
 	} else {
 		r0 = ret.Error(0)
 	}
 	return r0
 }
 
-// MockIHttpHandler_SignUp_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SignUp'
-type MockIHttpHandler_SignUp_Call struct {
+// MockIUsecase_RevokeOtherSessions_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeOtherSessions'
+type MockIUsecase_RevokeOtherSessions_Call struct {
 	*mock.Call
 }
 
-// SignUp is a helper method to define mock.On call
-//   - c *echo.Context
-func (_e *MockIHttpHandler_Expecter) SignUp(c interface{}) *MockIHttpHandler_SignUp_Call {
-	return &MockIHttpHandler_SignUp_Call{Call: _e.mock.On("SignUp", c)}
+// RevokeOtherSessions is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *MockIUsecase_Expecter) RevokeOtherSessions(ctx interface{}) *MockIUsecase_RevokeOtherSessions_Call {
+	return &MockIUsecase_RevokeOtherSessions_Call{Call: _e.mock.On("RevokeOtherSessions", ctx)}
 }
 
-func (_c *MockIHttpHandler_SignUp_Call) Run(run func(c *echo.Context)) *MockIHttpHandler_SignUp_Call {
+func (_c *MockIUsecase_RevokeOtherSessions_Call) Run(run func(ctx context.Context)) *MockIUsecase_RevokeOtherSessions_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		var arg0 *echo.Context
+		var arg0 context.Context
 		if args[0] != nil {
-			arg0 = args[0].(*echo.Context)
+			arg0 = args[0].(context.Context)
 		}
 		run(
 			arg0,
@@ -565,101 +3642,69 @@ func (_c *MockIHttpHandler_SignUp_Call) Run(run func(c *echo.Context)) *MockIHtt
 	return _c
 }
 
-func (_c *MockIHttpHandler_SignUp_Call) Return(err error) *MockIHttpHandler_SignUp_Call {
+func (_c *MockIUsecase_RevokeOtherSessions_Call) Return(err error) *MockIUsecase_RevokeOtherSessions_Call {
 	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIHttpHandler_SignUp_Call) RunAndReturn(run func(c *echo.Context) error) *MockIHttpHandler_SignUp_Call {
+func (_c *MockIUsecase_RevokeOtherSessions_Call) RunAndReturn(run func(ctx context.Context) error) *MockIUsecase_RevokeOtherSessions_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// NewMockIUsecase creates a new instance of MockIUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
-// The first argument is typically a *testing.T value.
-func NewMockIUsecase(t interface {
-	mock.TestingT
-	Cleanup(func())
-}) *MockIUsecase {
-	mock := &MockIUsecase{}
-	mock.Mock.Test(t)
-
-	t.Cleanup(func() { mock.AssertExpectations(t) })
-
-	return mock
-}
-
-// MockIUsecase is an autogenerated mock type for the IUsecase type
-type MockIUsecase struct {
-	mock.Mock
-}
-
-type MockIUsecase_Expecter struct {
-	mock *mock.Mock
-}
-
-func (_m *MockIUsecase) EXPECT() *MockIUsecase_Expecter {
-	return &MockIUsecase_Expecter{mock: &_m.Mock}
-}
-
-// Me provides a mock function for the type MockIUsecase
-func (_mock *MockIUsecase) Me(ctx context.Context) (*MeResponse, error) {
-	ret := _mock.Called(ctx)
+// RevokeSession provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) RevokeSession(ctx context.Context, req RevokeSessionRequest) error {
+	ret := _mock.Called(ctx, req)
 
 	if len(ret) == 0 {
-		panic("no return value specified for Me")
+		panic("no return value specified for RevokeSession")
 	}
 
-	var r0 *MeResponse
-	var r1 error
-	if returnFunc, ok := ret.Get(0).(func(context.Context) (*MeResponse, error)); ok {
-		return returnFunc(ctx)
-	}
-	if returnFunc, ok := ret.Get(0).(func(context.Context) *MeResponse); ok {
-		r0 = returnFunc(ctx)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*MeResponse)
-		}
-	}
-	if returnFunc, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = returnFunc(ctx)
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, RevokeSessionRequest) error); ok {
+		r0 = returnFunc(ctx, req)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
-	return r0, r1
+	return r0
 }
 
-// MockIUsecase_Me_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'Me'
-type MockIUsecase_Me_Call struct {
+// MockIUsecase_RevokeSession_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RevokeSession'
+type MockIUsecase_RevokeSession_Call struct {
 	*mock.Call
 }
 
-// Me is a helper method to define mock.On call
+// RevokeSession is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *MockIUsecase_Expecter) Me(ctx interface{}) *MockIUsecase_Me_Call {
-	return &MockIUsecase_Me_Call{Call: _e.mock.On("Me", ctx)}
+//   - req RevokeSessionRequest
+func (_e *MockIUsecase_Expecter) RevokeSession(ctx interface{}, req interface{}) *MockIUsecase_RevokeSession_Call {
+	return &MockIUsecase_RevokeSession_Call{Call: _e.mock.On("RevokeSession", ctx, req)}
 }
 
-func (_c *MockIUsecase_Me_Call) Run(run func(ctx context.Context)) *MockIUsecase_Me_Call {
+func (_c *MockIUsecase_RevokeSession_Call) Run(run func(ctx context.Context, req RevokeSessionRequest)) *MockIUsecase_RevokeSession_Call {
 	_c.Call.Run(func(args mock.Arguments) {
 		var arg0 context.Context
 		if args[0] != nil {
 			arg0 = args[0].(context.Context)
 		}
+		var arg1 RevokeSessionRequest
+		if args[1] != nil {
+			arg1 = args[1].(RevokeSessionRequest)
+		}
 		run(
 			arg0,
+			arg1,
 		)
 	})
 	return _c
 }
 
-func (_c *MockIUsecase_Me_Call) Return(meResponse *MeResponse, err error) *MockIUsecase_Me_Call {
-	_c.Call.Return(meResponse, err)
+func (_c *MockIUsecase_RevokeSession_Call) Return(err error) *MockIUsecase_RevokeSession_Call {
+	_c.Call.Return(err)
 	return _c
 }
 
-func (_c *MockIUsecase_Me_Call) RunAndReturn(run func(ctx context.Context) (*MeResponse, error)) *MockIUsecase_Me_Call {
+func (_c *MockIUsecase_RevokeSession_Call) RunAndReturn(run func(ctx context.Context, req RevokeSessionRequest) error) *MockIUsecase_RevokeSession_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -856,3 +3901,196 @@ func (_c *MockIUsecase_SignUp_Call) RunAndReturn(run func(ctx context.Context, r
 	_c.Call.Return(run)
 	return _c
 }
+
+// StartOidcSignIn provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) StartOidcSignIn(ctx context.Context, req StartOidcSignInRequest) (*StartOidcSignInResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for StartOidcSignIn")
+	}
+
+	var r0 *StartOidcSignInResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, StartOidcSignInRequest) (*StartOidcSignInResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, StartOidcSignInRequest) *StartOidcSignInResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*StartOidcSignInResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, StartOidcSignInRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_StartOidcSignIn_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'StartOidcSignIn'
+type MockIUsecase_StartOidcSignIn_Call struct {
+	*mock.Call
+}
+
+// StartOidcSignIn is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req StartOidcSignInRequest
+func (_e *MockIUsecase_Expecter) StartOidcSignIn(ctx interface{}, req interface{}) *MockIUsecase_StartOidcSignIn_Call {
+	return &MockIUsecase_StartOidcSignIn_Call{Call: _e.mock.On("StartOidcSignIn", ctx, req)}
+}
+
+func (_c *MockIUsecase_StartOidcSignIn_Call) Run(run func(ctx context.Context, req StartOidcSignInRequest)) *MockIUsecase_StartOidcSignIn_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 StartOidcSignInRequest
+		if args[1] != nil {
+			arg1 = args[1].(StartOidcSignInRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_StartOidcSignIn_Call) Return(startOidcSignInResponse *StartOidcSignInResponse, err error) *MockIUsecase_StartOidcSignIn_Call {
+	_c.Call.Return(startOidcSignInResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_StartOidcSignIn_Call) RunAndReturn(run func(ctx context.Context, req StartOidcSignInRequest) (*StartOidcSignInResponse, error)) *MockIUsecase_StartOidcSignIn_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UpdateProfile provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) UpdateProfile(ctx context.Context, req UpdateProfileRequest) (*MeResponse, error) {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateProfile")
+	}
+
+	var r0 *MeResponse
+	var r1 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpdateProfileRequest) (*MeResponse, error)); ok {
+		return returnFunc(ctx, req)
+	}
+	if returnFunc, ok := ret.Get(0).(func(context.Context, UpdateProfileRequest) *MeResponse); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*MeResponse)
+		}
+	}
+	if returnFunc, ok := ret.Get(1).(func(context.Context, UpdateProfileRequest) error); ok {
+		r1 = returnFunc(ctx, req)
+	} else {
+		r1 = ret.Error(1)
+	}
+	return r0, r1
+}
+
+// MockIUsecase_UpdateProfile_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateProfile'
+type MockIUsecase_UpdateProfile_Call struct {
+	*mock.Call
+}
+
+// UpdateProfile is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req UpdateProfileRequest
+func (_e *MockIUsecase_Expecter) UpdateProfile(ctx interface{}, req interface{}) *MockIUsecase_UpdateProfile_Call {
+	return &MockIUsecase_UpdateProfile_Call{Call: _e.mock.On("UpdateProfile", ctx, req)}
+}
+
+func (_c *MockIUsecase_UpdateProfile_Call) Run(run func(ctx context.Context, req UpdateProfileRequest)) *MockIUsecase_UpdateProfile_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 UpdateProfileRequest
+		if args[1] != nil {
+			arg1 = args[1].(UpdateProfileRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_UpdateProfile_Call) Return(meResponse *MeResponse, err error) *MockIUsecase_UpdateProfile_Call {
+	_c.Call.Return(meResponse, err)
+	return _c
+}
+
+func (_c *MockIUsecase_UpdateProfile_Call) RunAndReturn(run func(ctx context.Context, req UpdateProfileRequest) (*MeResponse, error)) *MockIUsecase_UpdateProfile_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// VerifyEmailAddress provides a mock function for the type MockIUsecase
+func (_mock *MockIUsecase) VerifyEmailAddress(ctx context.Context, req VerifyEmailAddressRequest) error {
+	ret := _mock.Called(ctx, req)
+
+	if len(ret) == 0 {
+		panic("no return value specified for VerifyEmailAddress")
+	}
+
+	var r0 error
+	if returnFunc, ok := ret.Get(0).(func(context.Context, VerifyEmailAddressRequest) error); ok {
+		r0 = returnFunc(ctx, req)
+	} else {
+		r0 = ret.Error(0)
+	}
+	return r0
+}
+
+// MockIUsecase_VerifyEmailAddress_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'VerifyEmailAddress'
+type MockIUsecase_VerifyEmailAddress_Call struct {
+	*mock.Call
+}
+
+// VerifyEmailAddress is a helper method to define mock.On call
+//   - ctx context.Context
+//   - req VerifyEmailAddressRequest
+func (_e *MockIUsecase_Expecter) VerifyEmailAddress(ctx interface{}, req interface{}) *MockIUsecase_VerifyEmailAddress_Call {
+	return &MockIUsecase_VerifyEmailAddress_Call{Call: _e.mock.On("VerifyEmailAddress", ctx, req)}
+}
+
+func (_c *MockIUsecase_VerifyEmailAddress_Call) Run(run func(ctx context.Context, req VerifyEmailAddressRequest)) *MockIUsecase_VerifyEmailAddress_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		var arg0 context.Context
+		if args[0] != nil {
+			arg0 = args[0].(context.Context)
+		}
+		var arg1 VerifyEmailAddressRequest
+		if args[1] != nil {
+			arg1 = args[1].(VerifyEmailAddressRequest)
+		}
+		run(
+			arg0,
+			arg1,
+		)
+	})
+	return _c
+}
+
+func (_c *MockIUsecase_VerifyEmailAddress_Call) Return(err error) *MockIUsecase_VerifyEmailAddress_Call {
+	_c.Call.Return(err)
+	return _c
+}
+
+func (_c *MockIUsecase_VerifyEmailAddress_Call) RunAndReturn(run func(ctx context.Context, req VerifyEmailAddressRequest) error) *MockIUsecase_VerifyEmailAddress_Call {
+	_c.Call.Return(run)
+	return _c
+}
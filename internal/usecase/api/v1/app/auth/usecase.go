@@ -1,16 +1,39 @@
 package auth
 
 import (
+	"cmp"
 	"context"
+	"crypto/subtle"
 	"database/sql"
+	"net/url"
+	"strings"
+	"time"
 
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/audit"
 	"github.com/anonychun/bibit/internal/bootstrap"
+	clientOidc "github.com/anonychun/bibit/internal/client/oidc"
+	clientRiver "github.com/anonychun/bibit/internal/client/river"
+	"github.com/anonychun/bibit/internal/config"
 	"github.com/anonychun/bibit/internal/consts"
 	"github.com/anonychun/bibit/internal/current"
 	"github.com/anonychun/bibit/internal/entity"
+	jobSendEmail "github.com/anonychun/bibit/internal/job/send_email"
+	"github.com/anonychun/bibit/internal/mailer"
 	"github.com/anonychun/bibit/internal/repository"
+	repositoryApiKey "github.com/anonychun/bibit/internal/repository/api_key"
+	repositoryEmailVerificationToken "github.com/anonychun/bibit/internal/repository/email_verification_token"
+	repositoryFailedSignInAttempt "github.com/anonychun/bibit/internal/repository/failed_sign_in_attempt"
+	repositoryIdentity "github.com/anonychun/bibit/internal/repository/identity"
+	repositoryMagicLinkToken "github.com/anonychun/bibit/internal/repository/magic_link_token"
+	repositoryOidcState "github.com/anonychun/bibit/internal/repository/oidc_state"
+	repositoryPasswordResetToken "github.com/anonychun/bibit/internal/repository/password_reset_token"
+	repositorySignInChallenge "github.com/anonychun/bibit/internal/repository/sign_in_challenge"
+	repositorySignInLockout "github.com/anonychun/bibit/internal/repository/sign_in_lockout"
 	repositoryUser "github.com/anonychun/bibit/internal/repository/user"
+	repositoryUserRecoveryCode "github.com/anonychun/bibit/internal/repository/user_recovery_code"
 	repositoryUserSession "github.com/anonychun/bibit/internal/repository/user_session"
+	"github.com/anonychun/bibit/internal/util"
 	"github.com/anonychun/bibit/internal/validation"
 	"github.com/samber/do/v2"
 )
@@ -22,23 +45,76 @@ func init() {
 type IUsecase interface {
 	SignUp(ctx context.Context, req SignUpRequest) (*SignUpResponse, error)
 	SignIn(ctx context.Context, req SignInRequest) (*SignInResponse, error)
+	CompleteSignIn(ctx context.Context, req CompleteSignInRequest) (*SignInResponse, error)
+	StartOidcSignIn(ctx context.Context, req StartOidcSignInRequest) (*StartOidcSignInResponse, error)
+	CompleteOidcSignIn(ctx context.Context, req CompleteOidcSignInRequest) (*SignInResponse, error)
 	SignOut(ctx context.Context, req SignOutRequest) error
+	RequestPasswordReset(ctx context.Context, req RequestPasswordResetRequest) error
+	ResetPassword(ctx context.Context, req ResetPasswordRequest) error
+	RequestMagicLink(ctx context.Context, req RequestMagicLinkRequest) error
+	ConsumeMagicLink(ctx context.Context, req ConsumeMagicLinkRequest) (*SignInResponse, error)
+	VerifyEmailAddress(ctx context.Context, req VerifyEmailAddressRequest) error
+	ResendEmailVerification(ctx context.Context, req ResendEmailVerificationRequest) error
+	EnrollTotp(ctx context.Context) (*EnrollTotpResponse, error)
+	ConfirmTotp(ctx context.Context, req ConfirmTotpRequest) (*ConfirmTotpResponse, error)
+	ListSessions(ctx context.Context) (*ListSessionsResponse, error)
+	RevokeSession(ctx context.Context, req RevokeSessionRequest) error
+	RevokeOtherSessions(ctx context.Context) error
 	Me(ctx context.Context) (*MeResponse, error)
+	UpdateProfile(ctx context.Context, req UpdateProfileRequest) (*MeResponse, error)
+	ChangePassword(ctx context.Context, req ChangePasswordRequest) error
+	ChangeEmailAddress(ctx context.Context, req ChangeEmailAddressRequest) error
+	DeleteAccount(ctx context.Context, req DeleteAccountRequest) error
 }
 
 type Usecase struct {
-	validator             validation.IValidator
-	userRepository        repositoryUser.IRepository
-	userSessionRepository repositoryUserSession.IRepository
+	config                           *config.Config
+	validator                        validation.IValidator
+	riverClient                      clientRiver.IClient
+	oidcClient                       clientOidc.IClient
+	auditRecorder                    audit.IRecorder
+	userRepository                   repositoryUser.IRepository
+	userSessionRepository            repositoryUserSession.IRepository
+	passwordResetTokenRepository     repositoryPasswordResetToken.IRepository
+	magicLinkTokenRepository         repositoryMagicLinkToken.IRepository
+	emailVerificationTokenRepository repositoryEmailVerificationToken.IRepository
+	userRecoveryCodeRepository       repositoryUserRecoveryCode.IRepository
+	signInChallengeRepository        repositorySignInChallenge.IRepository
+	failedSignInAttemptRepository    repositoryFailedSignInAttempt.IRepository
+	signInLockoutRepository          repositorySignInLockout.IRepository
+	identityRepository               repositoryIdentity.IRepository
+	oidcStateRepository              repositoryOidcState.IRepository
+	apiKeyRepository                 repositoryApiKey.IRepository
 }
 
+const recoveryCodeCount = 10
+
 var _ IUsecase = (*Usecase)(nil)
 
 func NewUsecase(i do.Injector) (*Usecase, error) {
+	cfg := do.MustInvoke[*config.Config](i)
+	if cfg.App.SecretKey == "" {
+		return nil, jobSendEmail.ErrMissingSecretKey
+	}
+
 	return &Usecase{
-		validator:             do.MustInvoke[*validation.Validator](i),
-		userRepository:        do.MustInvoke[*repositoryUser.Repository](i),
-		userSessionRepository: do.MustInvoke[*repositoryUserSession.Repository](i),
+		config:                           cfg,
+		validator:                        do.MustInvoke[*validation.Validator](i),
+		riverClient:                      do.MustInvoke[*clientRiver.Client](i),
+		oidcClient:                       do.MustInvoke[*clientOidc.Client](i),
+		auditRecorder:                    do.MustInvoke[*audit.Recorder](i),
+		userRepository:                   do.MustInvoke[*repositoryUser.Repository](i),
+		userSessionRepository:            do.MustInvoke[*repositoryUserSession.Repository](i),
+		passwordResetTokenRepository:     do.MustInvoke[*repositoryPasswordResetToken.Repository](i),
+		magicLinkTokenRepository:         do.MustInvoke[*repositoryMagicLinkToken.Repository](i),
+		emailVerificationTokenRepository: do.MustInvoke[*repositoryEmailVerificationToken.Repository](i),
+		userRecoveryCodeRepository:       do.MustInvoke[*repositoryUserRecoveryCode.Repository](i),
+		signInChallengeRepository:        do.MustInvoke[*repositorySignInChallenge.Repository](i),
+		failedSignInAttemptRepository:    do.MustInvoke[*repositoryFailedSignInAttempt.Repository](i),
+		signInLockoutRepository:          do.MustInvoke[*repositorySignInLockout.Repository](i),
+		identityRepository:               do.MustInvoke[*repositoryIdentity.Repository](i),
+		oidcStateRepository:              do.MustInvoke[*repositoryOidcState.Repository](i),
+		apiKeyRepository:                 do.MustInvoke[*repositoryApiKey.Repository](i),
 	}, nil
 }
 
@@ -62,7 +138,7 @@ func (u *Usecase) SignUp(ctx context.Context, req SignUpRequest) (*SignUpRespons
 		EmailAddress: req.EmailAddress,
 	}
 
-	err = user.HashPassword(req.Password)
+	err = user.HashPassword(req.Password, u.config.PasswordHashParams())
 	if err != nil {
 		return nil, err
 	}
@@ -74,12 +150,29 @@ func (u *Usecase) SignUp(ctx context.Context, req SignUpRequest) (*SignUpRespons
 			return err
 		}
 
+		err = u.auditRecorder.Record(ctx, audit.Event{
+			Action:     audit.ActionSignUp,
+			ActorId:    user.Id,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Changes:    audit.Diff(nil, map[string]any{"name": user.Name, "emailAddress": user.EmailAddress}),
+		})
+		if err != nil {
+			return err
+		}
+
+		err = u.sendEmailVerification(ctx, user)
+		if err != nil {
+			return err
+		}
+
 		userSession := &entity.UserSession{
 			UserId:    user.Id,
 			IpAddress: req.IpAddress,
 			UserAgent: req.UserAgent,
 		}
 		userSession.GenerateToken()
+		userSession.SetExpiration(u.config.Auth.Session.MaxLifetime)
 
 		err = u.userSessionRepository.Create(ctx, userSession)
 		if err != nil {
@@ -87,6 +180,7 @@ func (u *Usecase) SignUp(ctx context.Context, req SignUpRequest) (*SignUpRespons
 		}
 
 		res.Token = userSession.Token
+		res.ExpiresAt = userSession.ExpiresAt
 		return nil
 	})
 	if err != nil {
@@ -102,52 +196,1254 @@ func (u *Usecase) SignIn(ctx context.Context, req SignInRequest) (*SignInRespons
 		return nil, validationErr
 	}
 
+	lockoutEmailAddress := normalizeEmailAddress(req.EmailAddress)
+	err := u.ensureNotLockedOut(ctx, lockoutEmailAddress, req.IpAddress)
+	if err != nil {
+		return nil, err
+	}
+
 	user, err := u.userRepository.FindByEmailAddress(ctx, req.EmailAddress)
 	if err == sql.ErrNoRows {
-		return nil, consts.ErrInvalidCredentials
+		return nil, u.recordFailedSignIn(ctx, lockoutEmailAddress, req.IpAddress, consts.ErrInvalidCredentials)
 	} else if err != nil {
 		return nil, err
 	}
 
 	err = user.ComparePassword(req.Password)
 	if err != nil {
-		return nil, consts.ErrInvalidCredentials
+		return nil, u.recordFailedSignIn(ctx, lockoutEmailAddress, req.IpAddress, consts.ErrInvalidCredentials)
 	}
 
-	userSession := &entity.UserSession{
-		UserId:    user.Id,
-		IpAddress: req.IpAddress,
-		UserAgent: req.UserAgent,
-	}
-	userSession.GenerateToken()
+	var res *SignInResponse
+	err = repository.Transaction(ctx, func(ctx context.Context) error {
+		err := u.failedSignInAttemptRepository.DeleteByEmailAddress(ctx, lockoutEmailAddress)
+		if err != nil {
+			return err
+		}
+
+		if user.PasswordNeedsRehash(u.config.PasswordHashParams()) {
+			err = user.HashPassword(req.Password, u.config.PasswordHashParams())
+			if err != nil {
+				return err
+			}
 
-	err = u.userSessionRepository.Create(ctx, userSession)
+			err = u.userRepository.UpdatePasswordDigestById(ctx, user.Id, user.PasswordDigest)
+			if err != nil {
+				return err
+			}
+		}
+
+		res, err = u.signInUser(ctx, user, req.IpAddress, req.UserAgent)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &SignInResponse{Token: userSession.Token}, nil
+	return res, nil
 }
 
-func (u *Usecase) SignOut(ctx context.Context, req SignOutRequest) error {
-	err := u.userSessionRepository.DeleteByToken(ctx, req.Token)
+func (u *Usecase) CompleteSignIn(ctx context.Context, req CompleteSignInRequest) (*SignInResponse, error) {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	signInChallenge, err := u.signInChallengeRepository.FindByToken(ctx, req.ChallengeToken)
+	if err == sql.ErrNoRows {
+		return nil, consts.ErrInvalidSignInChallenge
+	} else if err != nil {
+		return nil, err
+	}
+
+	if signInChallenge.IsExpired() {
+		return nil, consts.ErrInvalidSignInChallenge
+	}
+
+	// The attempt is counted outside the transaction below so that failed
+	// codes still use it up.
+	isAttemptAllowed, err := u.signInChallengeRepository.IncrementAttemptsById(ctx, signInChallenge.Id, u.config.Auth.Totp.MaxAttempts)
+	if err != nil {
+		return nil, err
+	}
+
+	if !isAttemptAllowed {
+		err = u.signInChallengeRepository.DeleteById(ctx, signInChallenge.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, consts.ErrInvalidSignInChallenge
+	}
+
+	user, err := u.userRepository.FindById(ctx, signInChallenge.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	lockoutEmailAddress := normalizeEmailAddress(user.EmailAddress)
+	err = u.ensureNotLockedOut(ctx, lockoutEmailAddress, req.IpAddress)
 	if err != nil {
+		return nil, err
+	}
+
+	res := &SignInResponse{}
+	err = repository.Transaction(ctx, func(ctx context.Context) error {
+		isVerified, err := u.verifySecondFactor(ctx, user, req.Code)
+		if err != nil {
+			return err
+		}
+
+		if !isVerified {
+			return consts.ErrInvalidTotpCode
+		}
+
+		err = u.signInChallengeRepository.DeleteById(ctx, signInChallenge.Id)
+		if err != nil {
+			return err
+		}
+
+		res, err = u.createUserSession(ctx, user, req.IpAddress, req.UserAgent)
 		return err
+	})
+	if err == consts.ErrInvalidTotpCode {
+		return nil, u.recordFailedSignIn(ctx, lockoutEmailAddress, req.IpAddress, err)
+	} else if err != nil {
+		return nil, err
 	}
 
-	return nil
+	return res, nil
 }
 
-func (u *Usecase) Me(ctx context.Context) (*MeResponse, error) {
-	user := current.User(ctx)
-	if user == nil {
-		return nil, consts.ErrUnauthorized
+func (u *Usecase) StartOidcSignIn(ctx context.Context, req StartOidcSignInRequest) (*StartOidcSignInResponse, error) {
+	redirectUri, err := u.oidcRedirectUri(req.Provider)
+	if err != nil {
+		return nil, err
 	}
 
-	res := &MeResponse{}
-	res.User.Id = user.Id
-	res.User.Name = user.Name
-	res.User.EmailAddress = user.EmailAddress
+	oidcState := &entity.OidcState{Provider: req.Provider}
+	oidcState.Generate(u.config.Auth.Oidc.StateLifetime)
+
+	authorizationUrl, err := u.oidcClient.AuthorizationUrl(ctx, req.Provider, clientOidc.AuthorizationRequest{
+		RedirectUri:   redirectUri,
+		State:         oidcState.State,
+		Nonce:         oidcState.Nonce,
+		CodeChallenge: oidcState.CodeChallenge(),
+	})
+	if err == clientOidc.ErrUnknownProvider {
+		return nil, consts.ErrOidcProviderNotFound
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = u.oidcStateRepository.Create(ctx, oidcState)
+	if err != nil {
+		return nil, err
+	}
+
+	return &StartOidcSignInResponse{
+		AuthorizationUrl: authorizationUrl,
+		StateDigest:      oidcState.StateDigest,
+		ExpiresAt:        oidcState.ExpiresAt,
+	}, nil
+}
+
+func (u *Usecase) CompleteOidcSignIn(ctx context.Context, req CompleteOidcSignInRequest) (*SignInResponse, error) {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	oidcState, err := u.oidcStateRepository.FindByState(ctx, req.State)
+	if err == sql.ErrNoRows {
+		return nil, consts.ErrInvalidOidcState
+	} else if err != nil {
+		return nil, err
+	}
+
+	err = u.oidcStateRepository.DeleteById(ctx, oidcState.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	// The state digest comes from the client that started the sign in, so a
+	// callback carrying someone else's state is rejected.
+	isBound := subtle.ConstantTimeCompare([]byte(req.StateDigest), []byte(oidcState.StateDigest)) == 1
+	if !isBound || oidcState.Provider != req.Provider || oidcState.IsExpired() {
+		return nil, consts.ErrInvalidOidcState
+	}
+
+	redirectUri, err := u.oidcRedirectUri(req.Provider)
+	if err != nil {
+		return nil, err
+	}
+
+	claims, err := u.oidcClient.Exchange(ctx, req.Provider, clientOidc.ExchangeRequest{
+		RedirectUri:  redirectUri,
+		Code:         req.Code,
+		CodeVerifier: oidcState.CodeVerifier,
+		Nonce:        oidcState.Nonce,
+	})
+	if err != nil {
+		return nil, consts.ErrOidcSignInFailed
+	}
+
+	var user *entity.User
+	err = repository.Transaction(ctx, func(ctx context.Context) error {
+		user, err = u.findOrCreateOidcUser(ctx, req.Provider, claims)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var res *SignInResponse
+	err = repository.Transaction(ctx, func(ctx context.Context) error {
+		res, err = u.signInUser(ctx, user, req.IpAddress, req.UserAgent)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
 
 	return res, nil
 }
+
+func (u *Usecase) SignOut(ctx context.Context, req SignOutRequest) error {
+	event := audit.Event{Action: audit.ActionSignOut}
+	userSession := current.UserSession(ctx)
+	if userSession != nil {
+		event.TargetType = audit.TargetUserSession
+		event.TargetId = userSession.Id
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		err := u.userSessionRepository.DeleteByToken(ctx, req.Token)
+		if err != nil {
+			return err
+		}
+
+		return u.auditRecorder.Record(ctx, event)
+	})
+}
+
+func (u *Usecase) RequestPasswordReset(ctx context.Context, req RequestPasswordResetRequest) error {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	user, err := u.userRepository.FindByEmailAddress(ctx, req.EmailAddress)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		passwordResetToken := &entity.PasswordResetToken{UserId: user.Id}
+		passwordResetToken.GenerateToken(u.config.Auth.PasswordReset.TokenLifetime)
+
+		err := u.passwordResetTokenRepository.Create(ctx, passwordResetToken)
+		if err != nil {
+			return err
+		}
+
+		resetUrl, err := u.appUrl("reset-password", passwordResetToken.Token)
+		if err != nil {
+			return err
+		}
+
+		return u.sendEmail(ctx, mailer.Email{
+			To:       user.EmailAddress,
+			Subject:  "Reset your password",
+			Template: "password_reset",
+			Data:     map[string]any{"Name": user.Name, "Url": resetUrl},
+		})
+	})
+}
+
+func (u *Usecase) ResetPassword(ctx context.Context, req ResetPasswordRequest) error {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		passwordResetToken, err := u.passwordResetTokenRepository.FindByTokenForUpdate(ctx, req.Token)
+		if err == sql.ErrNoRows {
+			return consts.ErrInvalidPasswordResetToken
+		} else if err != nil {
+			return err
+		}
+
+		if !passwordResetToken.IsUsable() {
+			return consts.ErrInvalidPasswordResetToken
+		}
+
+		user, err := u.userRepository.FindById(ctx, passwordResetToken.UserId)
+		if err != nil {
+			return err
+		}
+
+		err = user.HashPassword(req.Password, u.config.PasswordHashParams())
+		if err != nil {
+			return err
+		}
+
+		err = u.userRepository.UpdatePasswordDigestById(ctx, user.Id, user.PasswordDigest)
+		if err != nil {
+			return err
+		}
+
+		err = u.passwordResetTokenRepository.UpdateUsedAtByUserId(ctx, user.Id, time.Now())
+		if err != nil {
+			return err
+		}
+
+		err = u.userSessionRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		return u.auditRecorder.Record(ctx, audit.Event{
+			Action:     audit.ActionAllSessionsRevoked,
+			ActorId:    user.Id,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"reason": "password_reset"},
+		})
+	})
+}
+
+func (u *Usecase) RequestMagicLink(ctx context.Context, req RequestMagicLinkRequest) error {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	user, err := u.userRepository.FindByEmailAddress(ctx, req.EmailAddress)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	latestToken, err := u.magicLinkTokenRepository.FindLatestByUserId(ctx, user.Id)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	// Throttled requests succeed silently, like requests for unknown email
+	// addresses.
+	if latestToken != nil && time.Since(latestToken.CreatedAt) < u.config.Auth.MagicLink.ResendInterval {
+		return nil
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		magicLinkToken := &entity.MagicLinkToken{UserId: user.Id}
+		magicLinkToken.GenerateToken(u.config.Auth.MagicLink.TokenLifetime)
+
+		err := u.magicLinkTokenRepository.Create(ctx, magicLinkToken)
+		if err != nil {
+			return err
+		}
+
+		signInUrl, err := u.appUrl("magic-link", magicLinkToken.Token)
+		if err != nil {
+			return err
+		}
+
+		return u.sendEmail(ctx, mailer.Email{
+			To:       user.EmailAddress,
+			Subject:  "Your sign in link",
+			Template: "magic_link",
+			Data:     map[string]any{"Name": user.Name, "Url": signInUrl},
+		})
+	})
+}
+
+// ConsumeMagicLink locks the token so that concurrent requests with the same
+// link cannot both sign in, and retires every other outstanding link of the
+// user along with it.
+func (u *Usecase) ConsumeMagicLink(ctx context.Context, req ConsumeMagicLinkRequest) (*SignInResponse, error) {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	err := u.ensureNotLockedOut(ctx, "", req.IpAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &SignInResponse{}
+	err = repository.Transaction(ctx, func(ctx context.Context) error {
+		magicLinkToken, err := u.magicLinkTokenRepository.FindByTokenForUpdate(ctx, req.Token)
+		if err == sql.ErrNoRows {
+			return consts.ErrInvalidMagicLinkToken
+		} else if err != nil {
+			return err
+		}
+
+		if !magicLinkToken.IsUsable() {
+			return consts.ErrInvalidMagicLinkToken
+		}
+
+		user, err := u.userRepository.FindById(ctx, magicLinkToken.UserId)
+		if err == sql.ErrNoRows {
+			return consts.ErrInvalidMagicLinkToken
+		} else if err != nil {
+			return err
+		}
+
+		err = u.ensureNotLockedOut(ctx, normalizeEmailAddress(user.EmailAddress), "")
+		if err != nil {
+			return err
+		}
+
+		err = u.magicLinkTokenRepository.UpdateUsedAtByUserId(ctx, user.Id, time.Now())
+		if err != nil {
+			return err
+		}
+
+		res, err = u.signInUser(ctx, user, req.IpAddress, req.UserAgent)
+		return err
+	})
+	if err == consts.ErrInvalidMagicLinkToken {
+		return nil, u.recordFailedSignIn(ctx, "", req.IpAddress, err)
+	} else if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (u *Usecase) VerifyEmailAddress(ctx context.Context, req VerifyEmailAddressRequest) error {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		emailVerificationToken, err := u.emailVerificationTokenRepository.FindByTokenForUpdate(ctx, req.Token)
+		if err == sql.ErrNoRows {
+			return consts.ErrInvalidEmailVerificationToken
+		} else if err != nil {
+			return err
+		}
+
+		if !emailVerificationToken.IsUsable() {
+			return consts.ErrInvalidEmailVerificationToken
+		}
+
+		now := time.Now()
+		err = u.userRepository.UpdateEmailVerifiedAtById(ctx, emailVerificationToken.UserId, now)
+		if err != nil {
+			return err
+		}
+
+		return u.emailVerificationTokenRepository.UpdateUsedAtByUserId(ctx, emailVerificationToken.UserId, now)
+	})
+}
+
+func (u *Usecase) ResendEmailVerification(ctx context.Context, req ResendEmailVerificationRequest) error {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	user, err := u.userRepository.FindByEmailAddress(ctx, req.EmailAddress)
+	if err == sql.ErrNoRows {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if user.IsEmailVerified() {
+		return nil
+	}
+
+	latestToken, err := u.emailVerificationTokenRepository.FindLatestByUserId(ctx, user.Id)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
+	// Throttled requests succeed silently as well, otherwise the response
+	// would tell unverified accounts apart.
+	if latestToken != nil && time.Since(latestToken.CreatedAt) < u.config.Auth.EmailVerification.ResendInterval {
+		return nil
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		return u.sendEmailVerification(ctx, user)
+	})
+}
+
+func (u *Usecase) EnrollTotp(ctx context.Context) (*EnrollTotpResponse, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if current.Impersonator(ctx) != nil {
+		return nil, consts.ErrImpersonationRestricted
+	}
+
+	if user.IsTotpEnabled() {
+		return nil, consts.ErrTotpAlreadyEnabled
+	}
+
+	secret := util.GenerateTotpSecret()
+	err := u.userRepository.UpdateTotpSecretById(ctx, user.Id, secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &EnrollTotpResponse{
+		Secret: secret,
+		Uri:    util.TotpUri(u.config.Auth.Totp.Issuer, user.EmailAddress, secret),
+	}, nil
+}
+
+func (u *Usecase) ConfirmTotp(ctx context.Context, req ConfirmTotpRequest) (*ConfirmTotpResponse, error) {
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	if current.Impersonator(ctx) != nil {
+		return nil, consts.ErrImpersonationRestricted
+	}
+
+	if user.IsTotpEnabled() {
+		return nil, consts.ErrTotpAlreadyEnabled
+	}
+
+	if user.TotpSecret == "" {
+		return nil, consts.ErrTotpNotEnrolled
+	}
+
+	totpStep, isValid := util.MatchTotpCode(user.TotpSecret, req.Code, time.Now())
+	if !isValid {
+		return nil, consts.ErrInvalidTotpCode
+	}
+
+	res := &ConfirmTotpResponse{}
+	userRecoveryCodes := make([]*entity.UserRecoveryCode, recoveryCodeCount)
+	for i := range userRecoveryCodes {
+		userRecoveryCodes[i] = &entity.UserRecoveryCode{UserId: user.Id}
+		userRecoveryCodes[i].GenerateCode()
+		res.RecoveryCodes = append(res.RecoveryCodes, userRecoveryCodes[i].Code)
+	}
+
+	err := repository.Transaction(ctx, func(ctx context.Context) error {
+		isStepUnused, err := u.userRepository.UpdateTotpLastUsedStepById(ctx, user.Id, totpStep)
+		if err != nil {
+			return err
+		}
+
+		if !isStepUnused {
+			return consts.ErrInvalidTotpCode
+		}
+
+		err = u.userRepository.UpdateTotpEnabledAtById(ctx, user.Id, time.Now())
+		if err != nil {
+			return err
+		}
+
+		err = u.userRecoveryCodeRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		return u.userRecoveryCodeRepository.CreateMany(ctx, userRecoveryCodes)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return res, nil
+}
+
+func (u *Usecase) ListSessions(ctx context.Context) (*ListSessionsResponse, error) {
+	user := current.User(ctx)
+	currentUserSession := current.UserSession(ctx)
+	if user == nil || currentUserSession == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	userSessions, err := u.userSessionRepository.FindAllByUserId(ctx, user.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &ListSessionsResponse{Sessions: make([]UserSessionResponse, 0, len(userSessions))}
+	for _, userSession := range userSessions {
+		if userSession.IsExpired(u.config.Auth.Session.IdleTimeout) {
+			continue
+		}
+
+		res.Sessions = append(res.Sessions, UserSessionResponse{
+			Id:         userSession.Id,
+			IpAddress:  userSession.IpAddress,
+			UserAgent:  userSession.UserAgent,
+			LastSeenAt: userSession.LastSeenAt,
+			ExpiresAt:  userSession.ExpiresAt,
+			CreatedAt:  userSession.CreatedAt,
+			Current:    userSession.Id == currentUserSession.Id,
+		})
+	}
+
+	return res, nil
+}
+
+func (u *Usecase) RevokeSession(ctx context.Context, req RevokeSessionRequest) error {
+	user := current.User(ctx)
+	if user == nil {
+		return consts.ErrUnauthorized
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		isDeleted, err := u.userSessionRepository.DeleteByIdAndUserId(ctx, req.Id, user.Id)
+		if err != nil {
+			return err
+		}
+
+		if !isDeleted {
+			return consts.ErrUserSessionNotFound
+		}
+
+		return u.auditRecorder.Record(ctx, audit.Event{
+			Action:     audit.ActionSessionRevoked,
+			TargetType: audit.TargetUserSession,
+			TargetId:   req.Id,
+		})
+	})
+}
+
+func (u *Usecase) RevokeOtherSessions(ctx context.Context) error {
+	user := current.User(ctx)
+	currentUserSession := current.UserSession(ctx)
+	if user == nil || currentUserSession == nil {
+		return consts.ErrUnauthorized
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		err := u.userSessionRepository.DeleteByUserIdExceptId(ctx, user.Id, currentUserSession.Id)
+		if err != nil {
+			return err
+		}
+
+		return u.auditRecorder.Record(ctx, audit.Event{
+			Action:     audit.ActionOtherSessionsRevoked,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"exceptUserSessionId": currentUserSession.Id},
+		})
+	})
+}
+
+func (u *Usecase) Me(ctx context.Context) (*MeResponse, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	res := &MeResponse{}
+	res.User.Id = user.Id
+	res.User.Name = user.Name
+	res.User.EmailAddress = user.EmailAddress
+
+	impersonator := current.Impersonator(ctx)
+	if impersonator != nil {
+		res.Impersonated = true
+		res.Impersonator = &ImpersonatorResponse{
+			Id:           impersonator.Id,
+			Name:         impersonator.Name,
+			EmailAddress: impersonator.EmailAddress,
+		}
+	}
+
+	return res, nil
+}
+
+func (u *Usecase) UpdateProfile(ctx context.Context, req UpdateProfileRequest) (*MeResponse, error) {
+	user := current.User(ctx)
+	if user == nil {
+		return nil, consts.ErrUnauthorized
+	}
+
+	validationErr := u.validator.Struct(&req)
+	if validationErr.IsFail() {
+		return nil, validationErr
+	}
+
+	err := u.userRepository.UpdateNameById(ctx, user.Id, req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	user.Name = req.Name
+	return u.Me(ctx)
+}
+
+func (u *Usecase) ChangePassword(ctx context.Context, req ChangePasswordRequest) error {
+	user := current.User(ctx)
+	currentUserSession := current.UserSession(ctx)
+	if user == nil || currentUserSession == nil {
+		return consts.ErrUnauthorized
+	}
+
+	if current.Impersonator(ctx) != nil {
+		return consts.ErrImpersonationRestricted
+	}
+
+	validationErr := u.validator.Struct(&req)
+	u.confirmCurrentPassword(validationErr, user, currentUserSession, req.CurrentPassword)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	err := user.HashPassword(req.NewPassword, u.config.PasswordHashParams())
+	if err != nil {
+		return err
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		err := u.userRepository.UpdatePasswordDigestById(ctx, user.Id, user.PasswordDigest)
+		if err != nil {
+			return err
+		}
+
+		err = u.userSessionRepository.DeleteByUserIdExceptId(ctx, user.Id, currentUserSession.Id)
+		if err != nil {
+			return err
+		}
+
+		return u.auditRecorder.Record(ctx, audit.Event{
+			Action:     audit.ActionOtherSessionsRevoked,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"exceptUserSessionId": currentUserSession.Id, "reason": "password_changed"},
+		})
+	})
+}
+
+// ChangeEmailAddress retires every link mailed to the previous address, so
+// that none of them can verify or sign in to the new one, and signs out the
+// other sessions.
+func (u *Usecase) ChangeEmailAddress(ctx context.Context, req ChangeEmailAddressRequest) error {
+	user := current.User(ctx)
+	currentUserSession := current.UserSession(ctx)
+	if user == nil || currentUserSession == nil {
+		return consts.ErrUnauthorized
+	}
+
+	if current.Impersonator(ctx) != nil {
+		return consts.ErrImpersonationRestricted
+	}
+
+	validationErr := u.validator.Struct(&req)
+	isEmailAddressExists, err := u.userRepository.ExistsByEmailAddress(ctx, req.EmailAddress)
+	if err != nil {
+		return err
+	}
+
+	if isEmailAddressExists {
+		validationErr.AddError("emailAddress", consts.ErrEmailAddressAlreadyRegistered)
+	}
+
+	u.confirmCurrentPassword(validationErr, user, currentUserSession, req.CurrentPassword)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		err := u.userRepository.UpdateEmailAddressById(ctx, user.Id, req.EmailAddress)
+		if err != nil {
+			return err
+		}
+
+		now := time.Now()
+		err = u.emailVerificationTokenRepository.UpdateUsedAtByUserId(ctx, user.Id, now)
+		if err != nil {
+			return err
+		}
+
+		err = u.passwordResetTokenRepository.UpdateUsedAtByUserId(ctx, user.Id, now)
+		if err != nil {
+			return err
+		}
+
+		err = u.magicLinkTokenRepository.UpdateUsedAtByUserId(ctx, user.Id, now)
+		if err != nil {
+			return err
+		}
+
+		err = u.userSessionRepository.DeleteByUserIdExceptId(ctx, user.Id, currentUserSession.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.auditRecorder.Record(ctx, audit.Event{
+			Action:     audit.ActionOtherSessionsRevoked,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"exceptUserSessionId": currentUserSession.Id, "reason": "email_address_changed"},
+		})
+		if err != nil {
+			return err
+		}
+
+		user.EmailAddress = req.EmailAddress
+		user.EmailVerifiedAt = time.Time{}
+		return u.sendEmailVerification(ctx, user)
+	})
+}
+
+// DeleteAccount removes every credential of the user along with the account,
+// including links that are still waiting in their inbox.
+func (u *Usecase) DeleteAccount(ctx context.Context, req DeleteAccountRequest) error {
+	user := current.User(ctx)
+	if user == nil {
+		return consts.ErrUnauthorized
+	}
+
+	if current.Impersonator(ctx) != nil {
+		return consts.ErrImpersonationRestricted
+	}
+
+	validationErr := u.validator.Struct(&req)
+	u.confirmCurrentPassword(validationErr, user, current.UserSession(ctx), req.CurrentPassword)
+	if validationErr.IsFail() {
+		return validationErr
+	}
+
+	return repository.Transaction(ctx, func(ctx context.Context) error {
+		err := u.userSessionRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.auditRecorder.Record(ctx, audit.Event{
+			Action:     audit.ActionAllSessionsRevoked,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"reason": "account_deleted"},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = u.apiKeyRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.passwordResetTokenRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.emailVerificationTokenRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.magicLinkTokenRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.userRecoveryCodeRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		err = u.identityRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return err
+		}
+
+		return u.userRepository.DeleteById(ctx, user.Id)
+	})
+}
+
+func (u *Usecase) sendEmailVerification(ctx context.Context, user *entity.User) error {
+	emailVerificationToken := &entity.EmailVerificationToken{UserId: user.Id}
+	emailVerificationToken.GenerateToken(u.config.Auth.EmailVerification.TokenLifetime)
+
+	err := u.emailVerificationTokenRepository.Create(ctx, emailVerificationToken)
+	if err != nil {
+		return err
+	}
+
+	verifyUrl, err := u.appUrl("verify-email", emailVerificationToken.Token)
+	if err != nil {
+		return err
+	}
+
+	return u.sendEmail(ctx, mailer.Email{
+		To:       user.EmailAddress,
+		Subject:  "Verify your email address",
+		Template: "email_verification",
+		Data:     map[string]any{"Name": user.Name, "Url": verifyUrl},
+	})
+}
+
+func (u *Usecase) sendEmail(ctx context.Context, email mailer.Email) error {
+	args, err := jobSendEmail.NewArgs(u.config, email)
+	if err != nil {
+		return err
+	}
+
+	return u.riverClient.Insert(ctx, args, nil)
+}
+
+func (u *Usecase) appUrl(path string, token string) (string, error) {
+	appUrl, err := url.Parse(u.config.App.Url)
+	if err != nil {
+		return "", err
+	}
+
+	appUrl = appUrl.JoinPath(path)
+	appUrl.RawQuery = url.Values{"token": {token}}.Encode()
+	return appUrl.String(), nil
+}
+
+// signInUser finishes a first-factor sign in, either with a session or with a
+// challenge when the user has TOTP enabled.
+func (u *Usecase) signInUser(ctx context.Context, user *entity.User, ipAddress, userAgent string) (*SignInResponse, error) {
+	if u.config.Auth.EmailVerification.RequiredOnSignIn && !user.IsEmailVerified() {
+		return nil, consts.ErrEmailAddressNotVerified
+	}
+
+	if user.IsTotpEnabled() {
+		signInChallenge := &entity.SignInChallenge{UserId: user.Id}
+		signInChallenge.GenerateToken(u.config.Auth.Totp.ChallengeLifetime)
+
+		err := u.signInChallengeRepository.Create(ctx, signInChallenge)
+		if err != nil {
+			return nil, err
+		}
+
+		return &SignInResponse{
+			ExpiresAt:            signInChallenge.ExpiresAt,
+			SecondFactorRequired: true,
+			ChallengeToken:       signInChallenge.Token,
+		}, nil
+	}
+
+	return u.createUserSession(ctx, user, ipAddress, userAgent)
+}
+
+func (u *Usecase) createUserSession(ctx context.Context, user *entity.User, ipAddress, userAgent string) (*SignInResponse, error) {
+	userSession := &entity.UserSession{
+		UserId:    user.Id,
+		IpAddress: ipAddress,
+		UserAgent: userAgent,
+	}
+	userSession.GenerateToken()
+	userSession.SetExpiration(u.config.Auth.Session.MaxLifetime)
+
+	err := u.userSessionRepository.Create(ctx, userSession)
+	if err != nil {
+		return nil, err
+	}
+
+	err = u.auditRecorder.Record(ctx, audit.Event{
+		Action:     audit.ActionSignInSucceeded,
+		ActorId:    user.Id,
+		TargetType: audit.TargetUserSession,
+		TargetId:   userSession.Id,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &SignInResponse{Token: userSession.Token, ExpiresAt: userSession.ExpiresAt}, nil
+}
+
+// verifySecondFactor accepts either a current TOTP code or one of the user's
+// unused recovery codes, consuming the latter. A TOTP code is only accepted
+// once, together with the codes of earlier time steps.
+func (u *Usecase) verifySecondFactor(ctx context.Context, user *entity.User, code string) (bool, error) {
+	totpStep, isValid := util.MatchTotpCode(user.TotpSecret, code, time.Now())
+	if isValid {
+		return u.userRepository.UpdateTotpLastUsedStepById(ctx, user.Id, totpStep)
+	}
+
+	return u.userRecoveryCodeRepository.UpdateUsedAtByUserIdAndCode(ctx, user.Id, strings.ToLower(strings.TrimSpace(code)), time.Now())
+}
+
+// ensureNotLockedOut rejects the attempt while either the account or the IP
+// address is locked out. Empty subjects are not checked.
+func (u *Usecase) ensureNotLockedOut(ctx context.Context, emailAddress, ipAddress string) error {
+	if emailAddress != "" {
+		isLockedOut, err := u.isLockedOut(ctx, entity.SignInLockoutScopeAccount, emailAddress)
+		if err != nil {
+			return err
+		}
+
+		if isLockedOut {
+			return consts.ErrSignInLockedOut
+		}
+	}
+
+	if ipAddress != "" {
+		isLockedOut, err := u.isLockedOut(ctx, entity.SignInLockoutScopeIp, ipAddress)
+		if err != nil {
+			return err
+		}
+
+		if isLockedOut {
+			return consts.ErrSignInLockedOut
+		}
+	}
+
+	return nil
+}
+
+func (u *Usecase) isLockedOut(ctx context.Context, scope, subject string) (bool, error) {
+	signInLockout, err := u.signInLockoutRepository.FindLatestByScopeAndSubject(ctx, scope, subject)
+	if err == sql.ErrNoRows {
+		return false, nil
+	} else if err != nil {
+		return false, err
+	}
+
+	return signInLockout.IsActive(), nil
+}
+
+// recordFailedSignIn stores the failed attempt and locks out the account or
+// IP address once either crosses its threshold. The subjects stay locked
+// until the transaction ends so that concurrent failures are counted one
+// after another. It returns failure unless recording itself fails.
+func (u *Usecase) recordFailedSignIn(ctx context.Context, emailAddress, ipAddress string, failure error) error {
+	err := repository.Transaction(ctx, func(ctx context.Context) error {
+		if emailAddress != "" {
+			err := u.signInLockoutRepository.LockByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, emailAddress)
+			if err != nil {
+				return err
+			}
+		}
+
+		if ipAddress != "" {
+			err := u.signInLockoutRepository.LockByScopeAndSubject(ctx, entity.SignInLockoutScopeIp, ipAddress)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := u.failedSignInAttemptRepository.Create(ctx, &entity.FailedSignInAttempt{
+			EmailAddress: emailAddress,
+			IpAddress:    ipAddress,
+		})
+		if err != nil {
+			return err
+		}
+
+		err = u.auditRecorder.Record(ctx, audit.Event{
+			Action:   audit.ActionSignInFailed,
+			Metadata: map[string]any{"emailAddress": emailAddress},
+		})
+		if err != nil {
+			return err
+		}
+
+		since := time.Now().Add(-u.config.Auth.Lockout.Window)
+
+		if emailAddress != "" {
+			accountFailures, err := u.failedSignInAttemptRepository.CountByEmailAddressSince(ctx, emailAddress, since)
+			if err != nil {
+				return err
+			}
+
+			if accountFailures >= u.config.Auth.Lockout.MaxFailedAttemptsPerAccount {
+				err = u.lockOut(ctx, entity.SignInLockoutScopeAccount, emailAddress, accountFailures)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if ipAddress != "" {
+			ipFailures, err := u.failedSignInAttemptRepository.CountByIpAddressSince(ctx, ipAddress, since)
+			if err != nil {
+				return err
+			}
+
+			if ipFailures >= u.config.Auth.Lockout.MaxFailedAttemptsPerIp {
+				err = u.lockOut(ctx, entity.SignInLockoutScopeIp, ipAddress, ipFailures)
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return failure
+}
+
+// normalizeEmailAddress is the form of an email address used as the lockout
+// subject, so that changing its case does not reset the count.
+func normalizeEmailAddress(emailAddress string) string {
+	return strings.ToLower(strings.TrimSpace(emailAddress))
+}
+
+// lockOut doubles the lockout duration for every earlier lockout of the same
+// subject within MaxDuration, up to MaxDuration. A subject that is already
+// locked out is left alone, since the failure that locked it out may have
+// raced with this one.
+func (u *Usecase) lockOut(ctx context.Context, scope, subject string, failedAttempts int) error {
+	isLockedOut, err := u.isLockedOut(ctx, scope, subject)
+	if err != nil {
+		return err
+	}
+
+	if isLockedOut {
+		return nil
+	}
+
+	now := time.Now()
+	previousLockouts, err := u.signInLockoutRepository.CountByScopeAndSubjectSince(ctx, scope, subject, now.Add(-u.config.Auth.Lockout.MaxDuration))
+	if err != nil {
+		return err
+	}
+
+	duration := u.config.Auth.Lockout.Duration
+	for range previousLockouts {
+		duration *= 2
+		if duration >= u.config.Auth.Lockout.MaxDuration {
+			break
+		}
+	}
+
+	return u.signInLockoutRepository.Create(ctx, &entity.SignInLockout{
+		Scope:          scope,
+		Subject:        subject,
+		FailedAttempts: failedAttempts,
+		LockedUntil:    now.Add(min(duration, u.config.Auth.Lockout.MaxDuration)),
+	})
+}
+
+func (u *Usecase) oidcRedirectUri(provider string) (string, error) {
+	appUrl, err := url.Parse(u.config.App.Url)
+	if err != nil {
+		return "", err
+	}
+
+	return appUrl.JoinPath("auth", "oidc", provider, "callback").String(), nil
+}
+
+// findOrCreateOidcUser resolves the user behind an identity provider subject.
+// Unknown subjects are linked to the account with the same email address,
+// which the provider must have verified, or get a new passwordless account.
+func (u *Usecase) findOrCreateOidcUser(ctx context.Context, provider string, claims *clientOidc.Claims) (*entity.User, error) {
+	identity, err := u.identityRepository.FindByProviderAndSubject(ctx, provider, claims.Subject)
+	if err == nil {
+		return u.userRepository.FindById(ctx, identity.UserId)
+	} else if err != sql.ErrNoRows {
+		return nil, err
+	}
+
+	if claims.EmailAddress == "" || !claims.EmailVerified {
+		return nil, consts.ErrOidcEmailAddressNotVerified
+	}
+
+	now := time.Now()
+	user, err := u.userRepository.FindByEmailAddress(ctx, claims.EmailAddress)
+	if err == sql.ErrNoRows {
+		user = &entity.User{
+			Name:            cmp.Or(claims.Name, claims.EmailAddress),
+			EmailAddress:    claims.EmailAddress,
+			EmailVerifiedAt: now,
+		}
+
+		err = u.userRepository.Create(ctx, user)
+		if err != nil {
+			return nil, err
+		}
+	} else if err != nil {
+		return nil, err
+	} else if !user.IsEmailVerified() {
+		// Whoever registered this unverified account may not own the email
+		// address, so none of their credentials must survive linking.
+		err = u.userRepository.UpdatePasswordDigestById(ctx, user.Id, "")
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.userSessionRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.apiKeyRepository.RevokeAllByUserId(ctx, user.Id, now)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.userRepository.ResetTotpById(ctx, user.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.userRecoveryCodeRepository.DeleteByUserId(ctx, user.Id)
+		if err != nil {
+			return nil, err
+		}
+
+		err = u.userRepository.UpdateEmailVerifiedAtById(ctx, user.Id, now)
+		if err != nil {
+			return nil, err
+		}
+
+		user.PasswordDigest = ""
+		user.TotpSecret = ""
+		user.TotpEnabledAt = time.Time{}
+		user.TotpLastUsedStep = 0
+		user.EmailVerifiedAt = now
+	}
+
+	err = u.identityRepository.Create(ctx, &entity.Identity{
+		UserId:       user.Id,
+		Provider:     provider,
+		Subject:      claims.Subject,
+		EmailAddress: claims.EmailAddress,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return user, nil
+}
+
+// confirmCurrentPassword guards account changes against hijacked sessions.
+// Accounts created through an identity provider have no password to confirm,
+// so they have to sign in again instead, for example with their provider or a
+// sign in link, and make the change within Reauthentication.MaxAge.
+func (u *Usecase) confirmCurrentPassword(validationErr api.ValidationError, user *entity.User, userSession *entity.UserSession, password string) {
+	if user.HasPassword() {
+		if user.ComparePassword(password) != nil {
+			validationErr.AddError("currentPassword", consts.ErrIncorrectPassword)
+		}
+
+		return
+	}
+
+	if userSession == nil || time.Since(userSession.CreatedAt) > u.config.Auth.Reauthentication.MaxAge {
+		validationErr.AddError("currentPassword", consts.ErrReauthenticationRequired)
+	}
+}
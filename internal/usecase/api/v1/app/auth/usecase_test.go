@@ -4,24 +4,62 @@ import (
 	"context"
 	"database/sql"
 	"errors"
+	"reflect"
 	"strings"
 	"testing"
+	"time"
+	"unsafe"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/anonychun/bibit/internal/api"
+	"github.com/anonychun/bibit/internal/audit"
+	"github.com/anonychun/bibit/internal/bootstrap"
+	clientOidc "github.com/anonychun/bibit/internal/client/oidc"
+	clientRiver "github.com/anonychun/bibit/internal/client/river"
+	"github.com/anonychun/bibit/internal/config"
 	"github.com/anonychun/bibit/internal/consts"
 	"github.com/anonychun/bibit/internal/current"
+	dbSql "github.com/anonychun/bibit/internal/db/sql"
 	"github.com/anonychun/bibit/internal/entity"
+	jobSendEmail "github.com/anonychun/bibit/internal/job/send_email"
+	repositoryApiKey "github.com/anonychun/bibit/internal/repository/api_key"
+	repositoryEmailVerificationToken "github.com/anonychun/bibit/internal/repository/email_verification_token"
+	repositoryFailedSignInAttempt "github.com/anonychun/bibit/internal/repository/failed_sign_in_attempt"
+	repositoryIdentity "github.com/anonychun/bibit/internal/repository/identity"
+	repositoryMagicLinkToken "github.com/anonychun/bibit/internal/repository/magic_link_token"
+	repositoryOidcState "github.com/anonychun/bibit/internal/repository/oidc_state"
+	repositoryPasswordResetToken "github.com/anonychun/bibit/internal/repository/password_reset_token"
+	repositorySignInChallenge "github.com/anonychun/bibit/internal/repository/sign_in_challenge"
+	repositorySignInLockout "github.com/anonychun/bibit/internal/repository/sign_in_lockout"
 	repositoryUser "github.com/anonychun/bibit/internal/repository/user"
+	repositoryUserRecoveryCode "github.com/anonychun/bibit/internal/repository/user_recovery_code"
 	repositoryUserSession "github.com/anonychun/bibit/internal/repository/user_session"
+	"github.com/anonychun/bibit/internal/util"
 	"github.com/anonychun/bibit/internal/validation"
 	"github.com/google/uuid"
-	"github.com/oklog/ulid/v2"
+	"github.com/samber/do/v2"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"github.com/uptrace/bun"
+	"github.com/uptrace/bun/dialect/pgdialect"
 	"golang.org/x/crypto/bcrypt"
 )
 
+var testPasswordHashParams = util.Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestNewUsecase(t *testing.T) {
+	t.Run("fails without a secret key to seal emails with", func(t *testing.T) {
+		i := do.New()
+		do.ProvideValue(i, &config.Config{})
+
+		usecase, err := NewUsecase(i)
+
+		require.ErrorIs(t, err, jobSendEmail.ErrMissingSecretKey)
+		assert.Nil(t, usecase)
+	})
+}
+
 func TestUsecase_SignUp(t *testing.T) {
 	t.Run("returns validation errors from the validator", func(t *testing.T) {
 		ctx := context.Background()
@@ -101,29 +139,6 @@ func TestUsecase_SignUp(t *testing.T) {
 		require.ErrorIs(t, err, expectedErr)
 		assert.Nil(t, res)
 	})
-
-	t.Run("returns an error when password hashing fails", func(t *testing.T) {
-		ctx := context.Background()
-		req := SignUpRequest{
-			Name:         "Ada Lovelace",
-			EmailAddress: "ada@example.com",
-			Password:     strings.Repeat("x", 73),
-		}
-		validator := validation.NewMockIValidator(t)
-		userRepository := repositoryUser.NewMockIRepository(t)
-		usecase := &Usecase{
-			validator:      validator,
-			userRepository: userRepository,
-		}
-
-		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
-		userRepository.EXPECT().ExistsByEmailAddress(ctx, req.EmailAddress).Return(false, nil).Once()
-
-		res, err := usecase.SignUp(ctx, req)
-
-		require.ErrorIs(t, err, bcrypt.ErrPasswordTooLong)
-		assert.Nil(t, res)
-	})
 }
 
 func TestUsecase_SignIn(t *testing.T) {
@@ -141,25 +156,46 @@ func TestUsecase_SignIn(t *testing.T) {
 			Name:         "Ada Lovelace",
 			EmailAddress: req.EmailAddress,
 		}
-		require.NoError(t, user.HashPassword(req.Password))
+		require.NoError(t, user.HashPassword(req.Password, testPasswordHashParams))
 
+		cfg := &config.Config{}
+		cfg.Auth.Password.Argon2id = config.Argon2id(testPasswordHashParams)
+		cfg.Auth.Session.MaxLifetime = 24 * time.Hour
 		validator := validation.NewMockIValidator(t)
 		userRepository := repositoryUser.NewMockIRepository(t)
 		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
 		usecase := &Usecase{
-			validator:             validator,
-			userRepository:        userRepository,
-			userSessionRepository: userSessionRepository,
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			userSessionRepository:         userSessionRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
 		}
 
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
 		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeIp, req.IpAddress).Return(nil, sql.ErrNoRows).Once()
 		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		failedSignInAttemptRepository.EXPECT().DeleteByEmailAddress(mock.Anything, req.EmailAddress).Return(nil).Once()
 
 		var createdSession *entity.UserSession
-		userSessionRepository.EXPECT().Create(ctx, mock.AnythingOfType("*entity.UserSession")).Run(func(ctx context.Context, actual *entity.UserSession) {
+		userSessionRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.UserSession")).Run(func(ctx context.Context, actual *entity.UserSession) {
 			createdSession = actual
 		}).Return(nil).Once()
 
+		auditRecorder.EXPECT().Record(mock.Anything, mock.MatchedBy(func(event audit.Event) bool {
+			return event.Action == audit.ActionSignInSucceeded && event.ActorId == userID && event.TargetId == createdSession.Id
+		})).Return(nil).Once()
+
 		res, err := usecase.SignIn(ctx, req)
 
 		require.NoError(t, err)
@@ -169,9 +205,61 @@ func TestUsecase_SignIn(t *testing.T) {
 		assert.Equal(t, req.IpAddress, createdSession.IpAddress)
 		assert.Equal(t, req.UserAgent, createdSession.UserAgent)
 		assert.Equal(t, createdSession.Token, res.Token)
+		assert.Equal(t, util.DigestToken(res.Token), createdSession.TokenDigest)
+		assert.Equal(t, createdSession.ExpiresAt, res.ExpiresAt)
+		assert.Equal(t, createdSession.LastSeenAt.Add(cfg.Auth.Session.MaxLifetime), createdSession.ExpiresAt)
+	})
+
+	t.Run("rehashes legacy bcrypt digests with the configured argon2id parameters", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignInRequest{IpAddress: "127.0.0.1", EmailAddress: "ada@example.com", Password: "correct horse battery staple"}
+		digest, err := bcrypt.GenerateFromPassword([]byte(req.Password), bcrypt.MinCost)
+		require.NoError(t, err)
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}, EmailAddress: req.EmailAddress, PasswordDigest: string(digest)}
+
+		cfg := &config.Config{}
+		cfg.Auth.Password.Argon2id = config.Argon2id(testPasswordHashParams)
+		cfg.Auth.Session.MaxLifetime = 24 * time.Hour
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
+		usecase := &Usecase{
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			userSessionRepository:         userSessionRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Twice()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		failedSignInAttemptRepository.EXPECT().DeleteByEmailAddress(mock.Anything, req.EmailAddress).Return(nil).Once()
+
+		var updatedDigest string
+		userRepository.EXPECT().UpdatePasswordDigestById(mock.Anything, user.Id, mock.AnythingOfType("string")).Run(func(ctx context.Context, id uuid.UUID, passwordDigest string) {
+			updatedDigest = passwordDigest
+		}).Return(nil).Once()
+		userSessionRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.UserSession")).Return(nil).Once()
+
+		auditRecorder.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := usecase.SignIn(ctx, req)
 
-		_, err = ulid.ParseStrict(res.Token)
 		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.True(t, strings.HasPrefix(updatedDigest, "$argon2id$"))
+		assert.NoError(t, util.ComparePassword(updatedDigest, req.Password))
+		assert.False(t, util.PasswordNeedsRehash(updatedDigest, testPasswordHashParams))
 	})
 
 	t.Run("returns validation errors before checking credentials", func(t *testing.T) {
@@ -193,18 +281,80 @@ func TestUsecase_SignIn(t *testing.T) {
 		assert.Equal(t, validationErr, actualValidationErr)
 	})
 
-	t.Run("returns invalid credentials when the user does not exist", func(t *testing.T) {
+	t.Run("returns locked out without checking credentials while the account is locked", func(t *testing.T) {
 		ctx := context.Background()
 		req := SignInRequest{EmailAddress: "ada@example.com", Password: "correct horse battery staple"}
 		validator := validation.NewMockIValidator(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:               validator,
+			signInLockoutRepository: signInLockoutRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).
+			Return(&entity.SignInLockout{LockedUntil: time.Now().Add(time.Minute)}, nil).Once()
+
+		res, err := usecase.SignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrSignInLockedOut)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns locked out while the ip address is locked", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignInRequest{IpAddress: "127.0.0.1", EmailAddress: "ada@example.com", Password: "correct horse battery staple"}
+		validator := validation.NewMockIValidator(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:               validator,
+			signInLockoutRepository: signInLockoutRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).
+			Return(&entity.SignInLockout{LockedUntil: time.Now().Add(-time.Minute)}, nil).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeIp, req.IpAddress).
+			Return(&entity.SignInLockout{LockedUntil: time.Now().Add(time.Minute)}, nil).Once()
+
+		res, err := usecase.SignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrSignInLockedOut)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns invalid credentials and records the attempt when the user does not exist", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignInRequest{EmailAddress: " Ada@Example.com ", Password: "correct horse battery staple"}
+		cfg := &config.Config{}
+		cfg.Auth.Lockout.MaxFailedAttemptsPerAccount = 5
+		cfg.Auth.Lockout.Window = 15 * time.Minute
+		validator := validation.NewMockIValidator(t)
 		userRepository := repositoryUser.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
 		usecase := &Usecase{
-			validator:      validator,
-			userRepository: userRepository,
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
 		}
 
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
 		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, "ada@example.com").Return(nil, sql.ErrNoRows).Once()
 		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+		signInLockoutRepository.EXPECT().LockByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeAccount, "ada@example.com").Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().Create(mock.Anything, &entity.FailedSignInAttempt{EmailAddress: "ada@example.com"}).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByEmailAddressSince(mock.Anything, "ada@example.com", mock.AnythingOfType("time.Time")).Return(1, nil).Once()
+
+		auditRecorder.EXPECT().Record(mock.Anything, audit.Event{Action: audit.ActionSignInFailed, Metadata: map[string]any{"emailAddress": "ada@example.com"}}).Return(nil).Once()
 
 		res, err := usecase.SignIn(ctx, req)
 
@@ -218,12 +368,15 @@ func TestUsecase_SignIn(t *testing.T) {
 		expectedErr := errors.New("find user")
 		validator := validation.NewMockIValidator(t)
 		userRepository := repositoryUser.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
 		usecase := &Usecase{
-			validator:      validator,
-			userRepository: userRepository,
+			validator:               validator,
+			userRepository:          userRepository,
+			signInLockoutRepository: signInLockoutRepository,
 		}
 
 		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
 		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, expectedErr).Once()
 
 		res, err := usecase.SignIn(ctx, req)
@@ -233,20 +386,141 @@ func TestUsecase_SignIn(t *testing.T) {
 	})
 
 	t.Run("returns invalid credentials when the password is wrong", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignInRequest{IpAddress: "127.0.0.1", EmailAddress: "ada@example.com", Password: "wrong password"}
+		user := &entity.User{EmailAddress: req.EmailAddress}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
+
+		cfg := &config.Config{}
+		cfg.Auth.Lockout.MaxFailedAttemptsPerAccount = 5
+		cfg.Auth.Lockout.MaxFailedAttemptsPerIp = 20
+		cfg.Auth.Lockout.Window = 15 * time.Minute
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
+		usecase := &Usecase{
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Twice()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		signInLockoutRepository.EXPECT().LockByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil).Once()
+		signInLockoutRepository.EXPECT().LockByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeIp, req.IpAddress).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().Create(mock.Anything, &entity.FailedSignInAttempt{EmailAddress: req.EmailAddress, IpAddress: req.IpAddress}).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByEmailAddressSince(mock.Anything, req.EmailAddress, mock.AnythingOfType("time.Time")).Return(2, nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByIpAddressSince(mock.Anything, req.IpAddress, mock.AnythingOfType("time.Time")).Return(2, nil).Once()
+
+		auditRecorder.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := usecase.SignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidCredentials)
+		assert.Nil(t, res)
+	})
+
+	t.Run("locks out the account with a doubled duration after repeated lockouts", func(t *testing.T) {
 		ctx := context.Background()
 		req := SignInRequest{EmailAddress: "ada@example.com", Password: "wrong password"}
 		user := &entity.User{EmailAddress: req.EmailAddress}
-		require.NoError(t, user.HashPassword("correct horse battery staple"))
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
 
+		cfg := &config.Config{}
+		cfg.Auth.Lockout.MaxFailedAttemptsPerAccount = 5
+		cfg.Auth.Lockout.Window = 15 * time.Minute
+		cfg.Auth.Lockout.Duration = 15 * time.Minute
+		cfg.Auth.Lockout.MaxDuration = 24 * time.Hour
 		validator := validation.NewMockIValidator(t)
 		userRepository := repositoryUser.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
 		usecase := &Usecase{
-			validator:      validator,
-			userRepository: userRepository,
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
 		}
 
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		var createdLockout *entity.SignInLockout
 		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil, sql.ErrNoRows).Twice()
 		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		signInLockoutRepository.EXPECT().LockByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.FailedSignInAttempt")).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByEmailAddressSince(mock.Anything, req.EmailAddress, mock.AnythingOfType("time.Time")).Return(5, nil).Once()
+		signInLockoutRepository.EXPECT().CountByScopeAndSubjectSince(mock.Anything, entity.SignInLockoutScopeAccount, req.EmailAddress, mock.AnythingOfType("time.Time")).Return(2, nil).Once()
+		signInLockoutRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.SignInLockout")).
+			Run(func(ctx context.Context, signInLockout *entity.SignInLockout) {
+				createdLockout = signInLockout
+			}).
+			Return(nil).Once()
+
+		auditRecorder.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Once()
+
+		startedAt := time.Now()
+		res, err := usecase.SignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidCredentials)
+		assert.Nil(t, res)
+		require.NotNil(t, createdLockout)
+		assert.Equal(t, entity.SignInLockoutScopeAccount, createdLockout.Scope)
+		assert.Equal(t, req.EmailAddress, createdLockout.Subject)
+		assert.Equal(t, 5, createdLockout.FailedAttempts)
+		assert.WithinDuration(t, startedAt.Add(time.Hour), createdLockout.LockedUntil, time.Second)
+	})
+
+	t.Run("does not lock out the account again when a concurrent failure already did", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignInRequest{EmailAddress: "ada@example.com", Password: "wrong password"}
+		cfg := &config.Config{}
+		cfg.Auth.Lockout.MaxFailedAttemptsPerAccount = 5
+		cfg.Auth.Lockout.Window = 15 * time.Minute
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
+		usecase := &Usecase{
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+		signInLockoutRepository.EXPECT().LockByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.FailedSignInAttempt")).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByEmailAddressSince(mock.Anything, req.EmailAddress, mock.AnythingOfType("time.Time")).Return(6, nil).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeAccount, req.EmailAddress).
+			Return(&entity.SignInLockout{LockedUntil: time.Now().Add(time.Minute)}, nil).Once()
+
+		auditRecorder.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Once()
 
 		res, err := usecase.SignIn(ctx, req)
 
@@ -254,25 +528,129 @@ func TestUsecase_SignIn(t *testing.T) {
 		assert.Nil(t, res)
 	})
 
+	t.Run("returns email address not verified when verification is required on sign in", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignInRequest{EmailAddress: "ada@example.com", Password: "correct horse battery staple"}
+		user := &entity.User{EmailAddress: req.EmailAddress}
+		require.NoError(t, user.HashPassword(req.Password, testPasswordHashParams))
+
+		cfg := &config.Config{}
+		cfg.Auth.Password.Argon2id = config.Argon2id(testPasswordHashParams)
+		cfg.Auth.EmailVerification.RequiredOnSignIn = true
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		failedSignInAttemptRepository.EXPECT().DeleteByEmailAddress(mock.Anything, req.EmailAddress).Return(nil).Once()
+
+		res, err := usecase.SignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrEmailAddressNotVerified)
+		assert.Nil(t, res)
+	})
+
+	t.Run("issues a sign in challenge instead of a session when totp is enabled", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignInRequest{EmailAddress: "ada@example.com", Password: "correct horse battery staple"}
+		user := &entity.User{
+			Base:          entity.Base{Id: uuid.New()},
+			EmailAddress:  req.EmailAddress,
+			TotpSecret:    util.GenerateTotpSecret(),
+			TotpEnabledAt: time.Now(),
+		}
+		require.NoError(t, user.HashPassword(req.Password, testPasswordHashParams))
+
+		cfg := &config.Config{}
+		cfg.Auth.Password.Argon2id = config.Argon2id(testPasswordHashParams)
+		cfg.Auth.Totp.ChallengeLifetime = 5 * time.Minute
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		signInChallengeRepository := repositorySignInChallenge.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			signInChallengeRepository:     signInChallengeRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		var createdChallenge *entity.SignInChallenge
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		failedSignInAttemptRepository.EXPECT().DeleteByEmailAddress(mock.Anything, req.EmailAddress).Return(nil).Once()
+		signInChallengeRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.SignInChallenge")).
+			Run(func(ctx context.Context, signInChallenge *entity.SignInChallenge) {
+				createdChallenge = signInChallenge
+			}).
+			Return(nil).Once()
+
+		res, err := usecase.SignIn(ctx, req)
+
+		require.NoError(t, err)
+		require.NotNil(t, createdChallenge)
+		assert.Equal(t, user.Id, createdChallenge.UserId)
+		assert.True(t, res.SecondFactorRequired)
+		assert.Empty(t, res.Token)
+		assert.Equal(t, createdChallenge.Token, res.ChallengeToken)
+		assert.Equal(t, createdChallenge.ExpiresAt, res.ExpiresAt)
+	})
+
 	t.Run("returns an error when session creation fails", func(t *testing.T) {
 		ctx := context.Background()
 		req := SignInRequest{EmailAddress: "ada@example.com", Password: "correct horse battery staple"}
 		expectedErr := errors.New("create user session")
 		user := &entity.User{Base: entity.Base{Id: uuid.New()}, EmailAddress: req.EmailAddress}
-		require.NoError(t, user.HashPassword(req.Password))
+		require.NoError(t, user.HashPassword(req.Password, testPasswordHashParams))
 
+		cfg := &config.Config{}
+		cfg.Auth.Password.Argon2id = config.Argon2id(testPasswordHashParams)
+		cfg.Auth.Session.MaxLifetime = 24 * time.Hour
 		validator := validation.NewMockIValidator(t)
 		userRepository := repositoryUser.NewMockIRepository(t)
 		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
 		usecase := &Usecase{
-			validator:             validator,
-			userRepository:        userRepository,
-			userSessionRepository: userSessionRepository,
+			config:                        cfg,
+			validator:                     validator,
+			userRepository:                userRepository,
+			userSessionRepository:         userSessionRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			signInLockoutRepository:       signInLockoutRepository,
 		}
 
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
 		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
 		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
-		userSessionRepository.EXPECT().Create(ctx, mock.AnythingOfType("*entity.UserSession")).Return(expectedErr).Once()
+		failedSignInAttemptRepository.EXPECT().DeleteByEmailAddress(mock.Anything, req.EmailAddress).Return(nil).Once()
+		userSessionRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.UserSession")).Return(expectedErr).Once()
 
 		res, err := usecase.SignIn(ctx, req)
 
@@ -281,60 +659,1317 @@ func TestUsecase_SignIn(t *testing.T) {
 	})
 }
 
-func TestUsecase_SignOut(t *testing.T) {
-	t.Run("deletes the session token", func(t *testing.T) {
+func TestUsecase_CompleteSignIn(t *testing.T) {
+	t.Run("returns invalid sign in challenge for unknown tokens", func(t *testing.T) {
 		ctx := context.Background()
-		req := SignOutRequest{Token: "session-token"}
-		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
-		usecase := &Usecase{userSessionRepository: userSessionRepository}
+		req := CompleteSignInRequest{ChallengeToken: "challenge-token", Code: "123456"}
+		validator := validation.NewMockIValidator(t)
+		signInChallengeRepository := repositorySignInChallenge.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:                 validator,
+			signInChallengeRepository: signInChallengeRepository,
+		}
 
-		userSessionRepository.EXPECT().DeleteByToken(ctx, req.Token).Return(nil).Once()
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInChallengeRepository.EXPECT().FindByToken(ctx, req.ChallengeToken).Return(nil, sql.ErrNoRows).Once()
 
-		err := usecase.SignOut(ctx, req)
+		res, err := usecase.CompleteSignIn(ctx, req)
 
-		require.NoError(t, err)
+		require.ErrorIs(t, err, consts.ErrInvalidSignInChallenge)
+		assert.Nil(t, res)
 	})
 
-	t.Run("returns an error when deleting the session fails", func(t *testing.T) {
+	t.Run("returns invalid sign in challenge for expired challenges", func(t *testing.T) {
 		ctx := context.Background()
-		req := SignOutRequest{Token: "session-token"}
-		expectedErr := errors.New("delete session")
-		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
-		usecase := &Usecase{userSessionRepository: userSessionRepository}
+		req := CompleteSignInRequest{ChallengeToken: "challenge-token", Code: "123456"}
+		validator := validation.NewMockIValidator(t)
+		signInChallengeRepository := repositorySignInChallenge.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:                 validator,
+			signInChallengeRepository: signInChallengeRepository,
+		}
 
-		userSessionRepository.EXPECT().DeleteByToken(ctx, req.Token).Return(expectedErr).Once()
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInChallengeRepository.EXPECT().FindByToken(ctx, req.ChallengeToken).
+			Return(&entity.SignInChallenge{ExpiresAt: time.Now().Add(-time.Second)}, nil).Once()
 
-		err := usecase.SignOut(ctx, req)
+		res, err := usecase.CompleteSignIn(ctx, req)
 
-		require.ErrorIs(t, err, expectedErr)
+		require.ErrorIs(t, err, consts.ErrInvalidSignInChallenge)
+		assert.Nil(t, res)
+	})
+
+	t.Run("deletes the challenge once its attempts are used up", func(t *testing.T) {
+		ctx := context.Background()
+		req := CompleteSignInRequest{ChallengeToken: "challenge-token", Code: "123456"}
+		signInChallenge := &entity.SignInChallenge{Base: entity.Base{Id: uuid.New()}, ExpiresAt: time.Now().Add(time.Minute)}
+		cfg := &config.Config{}
+		cfg.Auth.Totp.MaxAttempts = 5
+		validator := validation.NewMockIValidator(t)
+		signInChallengeRepository := repositorySignInChallenge.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                    cfg,
+			validator:                 validator,
+			signInChallengeRepository: signInChallengeRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInChallengeRepository.EXPECT().FindByToken(ctx, req.ChallengeToken).Return(signInChallenge, nil).Once()
+		signInChallengeRepository.EXPECT().IncrementAttemptsById(ctx, signInChallenge.Id, 5).Return(false, nil).Once()
+		signInChallengeRepository.EXPECT().DeleteById(ctx, signInChallenge.Id).Return(nil).Once()
+
+		res, err := usecase.CompleteSignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidSignInChallenge)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns locked out while the account is locked", func(t *testing.T) {
+		ctx := context.Background()
+		req := CompleteSignInRequest{ChallengeToken: "challenge-token", Code: "123456"}
+		signInChallenge := &entity.SignInChallenge{Base: entity.Base{Id: uuid.New()}, UserId: uuid.New(), ExpiresAt: time.Now().Add(time.Minute)}
+		user := &entity.User{Base: entity.Base{Id: signInChallenge.UserId}, EmailAddress: "Ada@example.com"}
+		cfg := &config.Config{}
+		cfg.Auth.Totp.MaxAttempts = 5
+		validator := validation.NewMockIValidator(t)
+		signInChallengeRepository := repositorySignInChallenge.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                    cfg,
+			validator:                 validator,
+			signInChallengeRepository: signInChallengeRepository,
+			signInLockoutRepository:   signInLockoutRepository,
+			userRepository:            userRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInChallengeRepository.EXPECT().FindByToken(ctx, req.ChallengeToken).Return(signInChallenge, nil).Once()
+		signInChallengeRepository.EXPECT().IncrementAttemptsById(ctx, signInChallenge.Id, 5).Return(true, nil).Once()
+		userRepository.EXPECT().FindById(ctx, signInChallenge.UserId).Return(user, nil).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeAccount, "ada@example.com").
+			Return(&entity.SignInLockout{LockedUntil: time.Now().Add(time.Minute)}, nil).Once()
+
+		res, err := usecase.CompleteSignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrSignInLockedOut)
+		assert.Nil(t, res)
+	})
+
+	t.Run("records a failed sign in for invalid codes", func(t *testing.T) {
+		ctx := context.Background()
+		req := CompleteSignInRequest{IpAddress: "127.0.0.1", ChallengeToken: "challenge-token", Code: "000000"}
+		signInChallenge := &entity.SignInChallenge{Base: entity.Base{Id: uuid.New()}, UserId: uuid.New(), ExpiresAt: time.Now().Add(time.Minute)}
+		user := &entity.User{Base: entity.Base{Id: signInChallenge.UserId}, EmailAddress: "ada@example.com", TotpSecret: util.GenerateTotpSecret()}
+		cfg := &config.Config{}
+		cfg.Auth.Totp.MaxAttempts = 5
+		cfg.Auth.Lockout.MaxFailedAttemptsPerAccount = 5
+		cfg.Auth.Lockout.MaxFailedAttemptsPerIp = 20
+		cfg.Auth.Lockout.Window = 15 * time.Minute
+		validator := validation.NewMockIValidator(t)
+		signInChallengeRepository := repositorySignInChallenge.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userRecoveryCodeRepository := repositoryUserRecoveryCode.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
+		usecase := &Usecase{
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			signInChallengeRepository:     signInChallengeRepository,
+			signInLockoutRepository:       signInLockoutRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+			userRepository:                userRepository,
+			userRecoveryCodeRepository:    userRecoveryCodeRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInChallengeRepository.EXPECT().FindByToken(ctx, req.ChallengeToken).Return(signInChallenge, nil).Once()
+		signInChallengeRepository.EXPECT().IncrementAttemptsById(ctx, signInChallenge.Id, 5).Return(true, nil).Once()
+		userRepository.EXPECT().FindById(ctx, signInChallenge.UserId).Return(user, nil).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, mock.Anything, mock.Anything).Return(nil, sql.ErrNoRows).Twice()
+		userRecoveryCodeRepository.EXPECT().UpdateUsedAtByUserIdAndCode(mock.Anything, user.Id, req.Code, mock.AnythingOfType("time.Time")).Return(false, nil).Once()
+		signInLockoutRepository.EXPECT().LockByScopeAndSubject(mock.Anything, mock.Anything, mock.Anything).Return(nil).Twice()
+		failedSignInAttemptRepository.EXPECT().Create(mock.Anything, &entity.FailedSignInAttempt{EmailAddress: user.EmailAddress, IpAddress: req.IpAddress}).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByEmailAddressSince(mock.Anything, user.EmailAddress, mock.AnythingOfType("time.Time")).Return(1, nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByIpAddressSince(mock.Anything, req.IpAddress, mock.AnythingOfType("time.Time")).Return(1, nil).Once()
+
+		auditRecorder.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := usecase.CompleteSignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidTotpCode)
+		assert.Nil(t, res)
 	})
 }
 
-func TestUsecase_Me(t *testing.T) {
-	t.Run("returns the current user", func(t *testing.T) {
-		userID := uuid.New()
-		ctx := current.SetUser(context.Background(), &entity.User{
-			Base:         entity.Base{Id: userID},
-			Name:         "Ada Lovelace",
-			EmailAddress: "ada@example.com",
-		})
-		usecase := &Usecase{}
+func TestUsecase_StartOidcSignIn(t *testing.T) {
+	t.Run("stores the state and returns the provider authorization url", func(t *testing.T) {
+		ctx := context.Background()
+		cfg := &config.Config{}
+		cfg.App.Url = "http://localhost:3000"
+		cfg.Auth.Oidc.StateLifetime = 10 * time.Minute
+		oidcClient := clientOidc.NewMockIClient(t)
+		oidcStateRepository := repositoryOidcState.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:              cfg,
+			oidcClient:          oidcClient,
+			oidcStateRepository: oidcStateRepository,
+		}
 
-		res, err := usecase.Me(ctx)
+		var authorizationReq clientOidc.AuthorizationRequest
+		oidcClient.EXPECT().AuthorizationUrl(ctx, "google", mock.AnythingOfType("oidc.AuthorizationRequest")).
+			Run(func(ctx context.Context, provider string, req clientOidc.AuthorizationRequest) {
+				authorizationReq = req
+			}).
+			Return("https://accounts.example.com/authorize?state=abc", nil).Once()
+
+		var createdState *entity.OidcState
+		oidcStateRepository.EXPECT().Create(ctx, mock.AnythingOfType("*entity.OidcState")).
+			Run(func(ctx context.Context, oidcState *entity.OidcState) {
+				createdState = oidcState
+			}).
+			Return(nil).Once()
+
+		res, err := usecase.StartOidcSignIn(ctx, StartOidcSignInRequest{Provider: "google"})
 
 		require.NoError(t, err)
-		require.NotNil(t, res)
-		assert.Equal(t, userID, res.User.Id)
-		assert.Equal(t, "Ada Lovelace", res.User.Name)
-		assert.Equal(t, "ada@example.com", res.User.EmailAddress)
+		assert.Equal(t, "https://accounts.example.com/authorize?state=abc", res.AuthorizationUrl)
+		require.NotNil(t, createdState)
+		assert.Equal(t, "google", createdState.Provider)
+		assert.Equal(t, createdState.StateDigest, res.StateDigest)
+		assert.Equal(t, createdState.ExpiresAt, res.ExpiresAt)
+		assert.Equal(t, "http://localhost:3000/auth/oidc/google/callback", authorizationReq.RedirectUri)
+		assert.Equal(t, createdState.State, authorizationReq.State)
+		assert.Equal(t, createdState.Nonce, authorizationReq.Nonce)
+		assert.Equal(t, createdState.CodeChallenge(), authorizationReq.CodeChallenge)
 	})
 
-	t.Run("returns unauthorized when there is no current user", func(t *testing.T) {
-		usecase := &Usecase{}
+	t.Run("returns provider not found for unconfigured providers", func(t *testing.T) {
+		ctx := context.Background()
+		cfg := &config.Config{}
+		cfg.App.Url = "http://localhost:3000"
+		oidcClient := clientOidc.NewMockIClient(t)
+		usecase := &Usecase{
+			config:     cfg,
+			oidcClient: oidcClient,
+		}
 
-		res, err := usecase.Me(context.Background())
+		oidcClient.EXPECT().AuthorizationUrl(ctx, "unknown", mock.Anything).Return("", clientOidc.ErrUnknownProvider).Once()
 
-		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		res, err := usecase.StartOidcSignIn(ctx, StartOidcSignInRequest{Provider: "unknown"})
+
+		require.ErrorIs(t, err, consts.ErrOidcProviderNotFound)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_CompleteOidcSignIn(t *testing.T) {
+	t.Run("returns invalid oidc state for unknown states", func(t *testing.T) {
+		ctx := context.Background()
+		req := CompleteOidcSignInRequest{Provider: "google", Code: "code", State: "state"}
+		validator := validation.NewMockIValidator(t)
+		oidcStateRepository := repositoryOidcState.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:           validator,
+			oidcStateRepository: oidcStateRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		oidcStateRepository.EXPECT().FindByState(ctx, req.State).Return(nil, sql.ErrNoRows).Once()
+
+		res, err := usecase.CompleteOidcSignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidOidcState)
 		assert.Nil(t, res)
 	})
+
+	t.Run("returns invalid oidc state when the state was issued for another provider", func(t *testing.T) {
+		ctx := context.Background()
+		oidcState := &entity.OidcState{Base: entity.Base{Id: uuid.New()}, Provider: "gitlab"}
+		oidcState.Generate(time.Minute)
+		req := CompleteOidcSignInRequest{Provider: "google", StateDigest: oidcState.StateDigest, Code: "code", State: oidcState.State}
+		validator := validation.NewMockIValidator(t)
+		oidcStateRepository := repositoryOidcState.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:           validator,
+			oidcStateRepository: oidcStateRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		oidcStateRepository.EXPECT().FindByState(ctx, req.State).Return(oidcState, nil).Once()
+		oidcStateRepository.EXPECT().DeleteById(ctx, oidcState.Id).Return(nil).Once()
+
+		res, err := usecase.CompleteOidcSignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidOidcState)
+		assert.Nil(t, res)
+	})
+
+	t.Run("consumes the state and returns invalid oidc state when another client started the sign in", func(t *testing.T) {
+		ctx := context.Background()
+		oidcState := &entity.OidcState{Base: entity.Base{Id: uuid.New()}, Provider: "google"}
+		oidcState.Generate(time.Minute)
+		req := CompleteOidcSignInRequest{Provider: "google", StateDigest: util.DigestToken("other-state"), Code: "code", State: oidcState.State}
+		validator := validation.NewMockIValidator(t)
+		oidcStateRepository := repositoryOidcState.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:           validator,
+			oidcStateRepository: oidcStateRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		oidcStateRepository.EXPECT().FindByState(ctx, req.State).Return(oidcState, nil).Once()
+		oidcStateRepository.EXPECT().DeleteById(ctx, oidcState.Id).Return(nil).Once()
+
+		res, err := usecase.CompleteOidcSignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidOidcState)
+		assert.Nil(t, res)
+	})
+
+	t.Run("consumes the state and returns sign in failed when the exchange fails", func(t *testing.T) {
+		ctx := context.Background()
+		oidcState := &entity.OidcState{Base: entity.Base{Id: uuid.New()}, Provider: "google"}
+		oidcState.Generate(time.Minute)
+		req := CompleteOidcSignInRequest{Provider: "google", StateDigest: oidcState.StateDigest, Code: "code", State: oidcState.State}
+
+		cfg := &config.Config{}
+		cfg.App.Url = "http://localhost:3000"
+		validator := validation.NewMockIValidator(t)
+		oidcClient := clientOidc.NewMockIClient(t)
+		oidcStateRepository := repositoryOidcState.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:              cfg,
+			validator:           validator,
+			oidcClient:          oidcClient,
+			oidcStateRepository: oidcStateRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		oidcStateRepository.EXPECT().FindByState(ctx, req.State).Return(oidcState, nil).Once()
+		oidcStateRepository.EXPECT().DeleteById(ctx, oidcState.Id).Return(nil).Once()
+		oidcClient.EXPECT().Exchange(ctx, "google", clientOidc.ExchangeRequest{
+			RedirectUri:  "http://localhost:3000/auth/oidc/google/callback",
+			Code:         req.Code,
+			CodeVerifier: oidcState.CodeVerifier,
+			Nonce:        oidcState.Nonce,
+		}).Return(nil, clientOidc.ErrInvalidIdToken).Once()
+
+		res, err := usecase.CompleteOidcSignIn(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrOidcSignInFailed)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_findOrCreateOidcUser(t *testing.T) {
+	t.Run("returns the user linked to a known identity", func(t *testing.T) {
+		ctx := context.Background()
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		identityRepository := repositoryIdentity.NewMockIRepository(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			identityRepository: identityRepository,
+			userRepository:     userRepository,
+		}
+
+		identityRepository.EXPECT().FindByProviderAndSubject(ctx, "google", "subject-1").Return(&entity.Identity{UserId: user.Id}, nil).Once()
+		userRepository.EXPECT().FindById(ctx, user.Id).Return(user, nil).Once()
+
+		actualUser, err := usecase.findOrCreateOidcUser(ctx, "google", &clientOidc.Claims{Subject: "subject-1"})
+
+		require.NoError(t, err)
+		assert.Same(t, user, actualUser)
+	})
+
+	t.Run("refuses unverified email addresses for new identities", func(t *testing.T) {
+		ctx := context.Background()
+		identityRepository := repositoryIdentity.NewMockIRepository(t)
+		usecase := &Usecase{identityRepository: identityRepository}
+
+		identityRepository.EXPECT().FindByProviderAndSubject(ctx, "google", "subject-1").Return(nil, sql.ErrNoRows).Once()
+
+		actualUser, err := usecase.findOrCreateOidcUser(ctx, "google", &clientOidc.Claims{Subject: "subject-1", EmailAddress: "ada@example.com"})
+
+		require.ErrorIs(t, err, consts.ErrOidcEmailAddressNotVerified)
+		assert.Nil(t, actualUser)
+	})
+
+	t.Run("creates a verified passwordless user for new email addresses", func(t *testing.T) {
+		ctx := context.Background()
+		claims := &clientOidc.Claims{Subject: "subject-1", Name: "Ada Lovelace", EmailAddress: "ada@example.com", EmailVerified: true}
+		identityRepository := repositoryIdentity.NewMockIRepository(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			identityRepository: identityRepository,
+			userRepository:     userRepository,
+		}
+
+		identityRepository.EXPECT().FindByProviderAndSubject(ctx, "google", claims.Subject).Return(nil, sql.ErrNoRows).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, claims.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+		userRepository.EXPECT().Create(ctx, mock.AnythingOfType("*entity.User")).
+			Run(func(ctx context.Context, user *entity.User) {
+				user.Id = uuid.New()
+			}).
+			Return(nil).Once()
+
+		var createdIdentity *entity.Identity
+		identityRepository.EXPECT().Create(ctx, mock.AnythingOfType("*entity.Identity")).
+			Run(func(ctx context.Context, identity *entity.Identity) {
+				createdIdentity = identity
+			}).
+			Return(nil).Once()
+
+		actualUser, err := usecase.findOrCreateOidcUser(ctx, "google", claims)
+
+		require.NoError(t, err)
+		assert.Equal(t, claims.Name, actualUser.Name)
+		assert.Equal(t, claims.EmailAddress, actualUser.EmailAddress)
+		assert.Empty(t, actualUser.PasswordDigest)
+		assert.False(t, actualUser.IsTotpEnabled())
+		assert.True(t, actualUser.IsEmailVerified())
+		require.NotNil(t, createdIdentity)
+		assert.Equal(t, actualUser.Id, createdIdentity.UserId)
+		assert.Equal(t, "google", createdIdentity.Provider)
+		assert.Equal(t, claims.Subject, createdIdentity.Subject)
+	})
+
+	t.Run("links an unverified account after revoking its credentials", func(t *testing.T) {
+		ctx := context.Background()
+		claims := &clientOidc.Claims{Subject: "subject-1", EmailAddress: "ada@example.com", EmailVerified: true}
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}, EmailAddress: claims.EmailAddress, TotpSecret: util.GenerateTotpSecret(), TotpEnabledAt: time.Now()}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
+
+		identityRepository := repositoryIdentity.NewMockIRepository(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		userRecoveryCodeRepository := repositoryUserRecoveryCode.NewMockIRepository(t)
+		usecase := &Usecase{
+			identityRepository:         identityRepository,
+			userRepository:             userRepository,
+			userSessionRepository:      userSessionRepository,
+			apiKeyRepository:           apiKeyRepository,
+			userRecoveryCodeRepository: userRecoveryCodeRepository,
+		}
+
+		identityRepository.EXPECT().FindByProviderAndSubject(ctx, "google", claims.Subject).Return(nil, sql.ErrNoRows).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, claims.EmailAddress).Return(user, nil).Once()
+		userRepository.EXPECT().UpdatePasswordDigestById(ctx, user.Id, "").Return(nil).Once()
+		userSessionRepository.EXPECT().DeleteByUserId(ctx, user.Id).Return(nil).Once()
+		apiKeyRepository.EXPECT().RevokeAllByUserId(ctx, user.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		userRepository.EXPECT().ResetTotpById(ctx, user.Id).Return(nil).Once()
+		userRecoveryCodeRepository.EXPECT().DeleteByUserId(ctx, user.Id).Return(nil).Once()
+		userRepository.EXPECT().UpdateEmailVerifiedAtById(ctx, user.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		identityRepository.EXPECT().Create(ctx, mock.AnythingOfType("*entity.Identity")).Return(nil).Once()
+
+		actualUser, err := usecase.findOrCreateOidcUser(ctx, "google", claims)
+
+		require.NoError(t, err)
+		assert.Same(t, user, actualUser)
+		assert.Empty(t, actualUser.PasswordDigest)
+		assert.False(t, actualUser.IsTotpEnabled())
+		assert.True(t, actualUser.IsEmailVerified())
+	})
+}
+
+func TestUsecase_SignOut(t *testing.T) {
+	t.Run("deletes the session token and records the sign out", func(t *testing.T) {
+		userSession := &entity.UserSession{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUserSession(context.Background(), userSession)
+		req := SignOutRequest{Token: "session-token"}
+		auditRecorder := audit.NewMockIRecorder(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		usecase := &Usecase{auditRecorder: auditRecorder, userSessionRepository: userSessionRepository}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		userSessionRepository.EXPECT().DeleteByToken(mock.Anything, req.Token).Return(nil).Once()
+		auditRecorder.EXPECT().Record(mock.Anything, audit.Event{Action: audit.ActionSignOut, TargetType: audit.TargetUserSession, TargetId: userSession.Id}).Return(nil).Once()
+
+		err := usecase.SignOut(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns an error when deleting the session fails", func(t *testing.T) {
+		ctx := context.Background()
+		req := SignOutRequest{Token: "session-token"}
+		expectedErr := errors.New("delete session")
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		usecase := &Usecase{userSessionRepository: userSessionRepository}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		userSessionRepository.EXPECT().DeleteByToken(mock.Anything, req.Token).Return(expectedErr).Once()
+
+		err := usecase.SignOut(ctx, req)
+
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestUsecase_RequestPasswordReset(t *testing.T) {
+	t.Run("returns validation errors before looking up the user", func(t *testing.T) {
+		ctx := context.Background()
+		req := RequestPasswordResetRequest{EmailAddress: "not-an-email"}
+		validationErr := api.ValidationError{"emailAddress": []string{"Email address is invalid"}}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(validationErr).Once()
+
+		err := usecase.RequestPasswordReset(ctx, req)
+
+		actualValidationErr, ok := err.(api.ValidationError)
+		require.True(t, ok)
+		assert.Equal(t, validationErr, actualValidationErr)
+	})
+
+	t.Run("succeeds silently when the email address is not registered", func(t *testing.T) {
+		ctx := context.Background()
+		req := RequestPasswordResetRequest{EmailAddress: "ada@example.com"}
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:      validator,
+			userRepository: userRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+
+		err := usecase.RequestPasswordReset(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		ctx := context.Background()
+		req := RequestPasswordResetRequest{EmailAddress: "ada@example.com"}
+		expectedErr := errors.New("find user")
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:      validator,
+			userRepository: userRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, expectedErr).Once()
+
+		err := usecase.RequestPasswordReset(ctx, req)
+
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestUsecase_ResetPassword(t *testing.T) {
+	t.Run("returns validation errors before consuming the token", func(t *testing.T) {
+		ctx := context.Background()
+		req := ResetPasswordRequest{Token: "reset-token", Password: "short"}
+		validationErr := api.ValidationError{"password": []string{"Password must be at least 8 characters"}}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(validationErr).Once()
+
+		err := usecase.ResetPassword(ctx, req)
+
+		actualValidationErr, ok := err.(api.ValidationError)
+		require.True(t, ok)
+		assert.Equal(t, validationErr, actualValidationErr)
+	})
+}
+
+func TestUsecase_RequestMagicLink(t *testing.T) {
+	t.Run("returns validation errors before looking up the user", func(t *testing.T) {
+		ctx := context.Background()
+		req := RequestMagicLinkRequest{EmailAddress: "not-an-email"}
+		validationErr := api.ValidationError{"emailAddress": []string{"Email address is invalid"}}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(validationErr).Once()
+
+		err := usecase.RequestMagicLink(ctx, req)
+
+		actualValidationErr, ok := err.(api.ValidationError)
+		require.True(t, ok)
+		assert.Equal(t, validationErr, actualValidationErr)
+	})
+
+	t.Run("succeeds silently when the email address is not registered", func(t *testing.T) {
+		ctx := context.Background()
+		req := RequestMagicLinkRequest{EmailAddress: "ada@example.com"}
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:      validator,
+			userRepository: userRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+
+		err := usecase.RequestMagicLink(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("succeeds silently when the previous link was sent recently", func(t *testing.T) {
+		ctx := context.Background()
+		req := RequestMagicLinkRequest{EmailAddress: "ada@example.com"}
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}, EmailAddress: req.EmailAddress}
+		cfg := &config.Config{}
+		cfg.Auth.MagicLink.ResendInterval = time.Minute
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		magicLinkTokenRepository := repositoryMagicLinkToken.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                   cfg,
+			validator:                validator,
+			userRepository:           userRepository,
+			magicLinkTokenRepository: magicLinkTokenRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		magicLinkTokenRepository.EXPECT().FindLatestByUserId(ctx, user.Id).
+			Return(&entity.MagicLinkToken{Base: entity.Base{CreatedAt: time.Now().Add(-10 * time.Second)}}, nil).Once()
+
+		err := usecase.RequestMagicLink(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns repository errors", func(t *testing.T) {
+		ctx := context.Background()
+		req := RequestMagicLinkRequest{EmailAddress: "ada@example.com"}
+		expectedErr := errors.New("find user")
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:      validator,
+			userRepository: userRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, expectedErr).Once()
+
+		err := usecase.RequestMagicLink(ctx, req)
+
+		require.ErrorIs(t, err, expectedErr)
+	})
+}
+
+func TestUsecase_ConsumeMagicLink(t *testing.T) {
+	t.Run("returns validation errors before consuming the token", func(t *testing.T) {
+		ctx := context.Background()
+		req := ConsumeMagicLinkRequest{}
+		validationErr := api.ValidationError{"token": []string{"Token is required"}}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(validationErr).Once()
+
+		res, err := usecase.ConsumeMagicLink(ctx, req)
+
+		actualValidationErr, ok := err.(api.ValidationError)
+		require.True(t, ok)
+		assert.Equal(t, validationErr, actualValidationErr)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns locked out while the ip address is locked", func(t *testing.T) {
+		ctx := context.Background()
+		req := ConsumeMagicLinkRequest{IpAddress: "127.0.0.1", Token: "magic-link-token"}
+		validator := validation.NewMockIValidator(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:               validator,
+			signInLockoutRepository: signInLockoutRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeIp, req.IpAddress).
+			Return(&entity.SignInLockout{LockedUntil: time.Now().Add(time.Minute)}, nil).Once()
+
+		res, err := usecase.ConsumeMagicLink(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrSignInLockedOut)
+		assert.Nil(t, res)
+	})
+
+	t.Run("records a failed sign in for unknown tokens", func(t *testing.T) {
+		ctx := context.Background()
+		req := ConsumeMagicLinkRequest{IpAddress: "127.0.0.1", Token: "magic-link-token"}
+		cfg := &config.Config{}
+		cfg.Auth.Lockout.MaxFailedAttemptsPerIp = 20
+		cfg.Auth.Lockout.Window = 15 * time.Minute
+		validator := validation.NewMockIValidator(t)
+		magicLinkTokenRepository := repositoryMagicLinkToken.NewMockIRepository(t)
+		signInLockoutRepository := repositorySignInLockout.NewMockIRepository(t)
+		failedSignInAttemptRepository := repositoryFailedSignInAttempt.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
+		usecase := &Usecase{
+			auditRecorder:                 auditRecorder,
+			config:                        cfg,
+			validator:                     validator,
+			magicLinkTokenRepository:      magicLinkTokenRepository,
+			signInLockoutRepository:       signInLockoutRepository,
+			failedSignInAttemptRepository: failedSignInAttemptRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		signInLockoutRepository.EXPECT().FindLatestByScopeAndSubject(ctx, entity.SignInLockoutScopeIp, req.IpAddress).Return(nil, sql.ErrNoRows).Once()
+		magicLinkTokenRepository.EXPECT().FindByTokenForUpdate(mock.Anything, req.Token).Return(nil, sql.ErrNoRows).Once()
+		signInLockoutRepository.EXPECT().LockByScopeAndSubject(mock.Anything, entity.SignInLockoutScopeIp, req.IpAddress).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().Create(mock.Anything, &entity.FailedSignInAttempt{IpAddress: req.IpAddress}).Return(nil).Once()
+		failedSignInAttemptRepository.EXPECT().CountByIpAddressSince(mock.Anything, req.IpAddress, mock.AnythingOfType("time.Time")).Return(1, nil).Once()
+
+		auditRecorder.EXPECT().Record(mock.Anything, mock.Anything).Return(nil).Once()
+
+		res, err := usecase.ConsumeMagicLink(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrInvalidMagicLinkToken)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_VerifyEmailAddress(t *testing.T) {
+	t.Run("returns validation errors before consuming the token", func(t *testing.T) {
+		ctx := context.Background()
+		req := VerifyEmailAddressRequest{}
+		validationErr := api.ValidationError{"token": []string{"Token is required"}}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(validationErr).Once()
+
+		err := usecase.VerifyEmailAddress(ctx, req)
+
+		actualValidationErr, ok := err.(api.ValidationError)
+		require.True(t, ok)
+		assert.Equal(t, validationErr, actualValidationErr)
+	})
+}
+
+func TestUsecase_ResendEmailVerification(t *testing.T) {
+	t.Run("succeeds silently when the email address is not registered", func(t *testing.T) {
+		ctx := context.Background()
+		req := ResendEmailVerificationRequest{EmailAddress: "ada@example.com"}
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:      validator,
+			userRepository: userRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(nil, sql.ErrNoRows).Once()
+
+		err := usecase.ResendEmailVerification(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("succeeds silently when the email address is already verified", func(t *testing.T) {
+		ctx := context.Background()
+		req := ResendEmailVerificationRequest{EmailAddress: "ada@example.com"}
+		user := &entity.User{EmailAddress: req.EmailAddress, EmailVerifiedAt: time.Now()}
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			validator:      validator,
+			userRepository: userRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+
+		err := usecase.ResendEmailVerification(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("succeeds silently when the previous email was sent recently", func(t *testing.T) {
+		ctx := context.Background()
+		req := ResendEmailVerificationRequest{EmailAddress: "ada@example.com"}
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}, EmailAddress: req.EmailAddress}
+		latestToken := &entity.EmailVerificationToken{Base: entity.Base{CreatedAt: time.Now().Add(-10 * time.Second)}}
+		cfg := &config.Config{}
+		cfg.Auth.EmailVerification.ResendInterval = time.Minute
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		emailVerificationTokenRepository := repositoryEmailVerificationToken.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                           cfg,
+			validator:                        validator,
+			userRepository:                   userRepository,
+			emailVerificationTokenRepository: emailVerificationTokenRepository,
+		}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().FindByEmailAddress(ctx, req.EmailAddress).Return(user, nil).Once()
+		emailVerificationTokenRepository.EXPECT().FindLatestByUserId(ctx, user.Id).Return(latestToken, nil).Once()
+
+		err := usecase.ResendEmailVerification(ctx, req)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestUsecase_EnrollTotp(t *testing.T) {
+	t.Run("stores a new secret and returns its otpauth uri", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}, EmailAddress: "ada@example.com"}
+		ctx := current.SetUser(context.Background(), user)
+		cfg := &config.Config{}
+		cfg.Auth.Totp.Issuer = "Bibit"
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:         cfg,
+			userRepository: userRepository,
+		}
+
+		var storedSecret string
+		userRepository.EXPECT().UpdateTotpSecretById(ctx, user.Id, mock.AnythingOfType("string")).
+			Run(func(ctx context.Context, id uuid.UUID, totpSecret string) {
+				storedSecret = totpSecret
+			}).
+			Return(nil).Once()
+
+		res, err := usecase.EnrollTotp(ctx)
+
+		require.NoError(t, err)
+		assert.Equal(t, storedSecret, res.Secret)
+		assert.Equal(t, util.TotpUri("Bibit", user.EmailAddress, storedSecret), res.Uri)
+	})
+
+	t.Run("returns a conflict when totp is already enabled", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{TotpEnabledAt: time.Now()})
+		usecase := &Usecase{}
+
+		res, err := usecase.EnrollTotp(ctx)
+
+		require.ErrorIs(t, err, consts.ErrTotpAlreadyEnabled)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_ConfirmTotp(t *testing.T) {
+	t.Run("returns not enrolled when no secret has been issued", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{})
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+
+		res, err := usecase.ConfirmTotp(ctx, ConfirmTotpRequest{Code: "123456"})
+
+		require.ErrorIs(t, err, consts.ErrTotpNotEnrolled)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns invalid totp code for a wrong code", func(t *testing.T) {
+		secret := util.GenerateTotpSecret()
+		code, err := util.TotpCode(secret, time.Now().Add(-time.Hour))
+		require.NoError(t, err)
+
+		ctx := current.SetUser(context.Background(), &entity.User{TotpSecret: secret})
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(mock.Anything).Return(api.ValidationError{}).Once()
+
+		res, err := usecase.ConfirmTotp(ctx, ConfirmTotpRequest{Code: code})
+
+		require.ErrorIs(t, err, consts.ErrInvalidTotpCode)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_ListSessions(t *testing.T) {
+	t.Run("returns the active sessions of the user with the current one flagged", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		currentSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			IpAddress:  "127.0.0.1",
+			UserAgent:  "Go test",
+			LastSeenAt: time.Now(),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		otherSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			IpAddress:  "192.0.2.10",
+			UserAgent:  "curl",
+			LastSeenAt: time.Now().Add(-time.Minute),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		idleSession := &entity.UserSession{
+			Base:       entity.Base{Id: uuid.New()},
+			UserId:     user.Id,
+			LastSeenAt: time.Now().Add(-2 * time.Hour),
+			ExpiresAt:  time.Now().Add(time.Hour),
+		}
+		ctx := current.SetUserSession(current.SetUser(context.Background(), user), currentSession)
+
+		cfg := &config.Config{}
+		cfg.Auth.Session.IdleTimeout = time.Hour
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		usecase := &Usecase{
+			config:                cfg,
+			userSessionRepository: userSessionRepository,
+		}
+
+		userSessionRepository.EXPECT().FindAllByUserId(ctx, user.Id).
+			Return([]*entity.UserSession{currentSession, otherSession, idleSession}, nil).Once()
+
+		res, err := usecase.ListSessions(ctx)
+
+		require.NoError(t, err)
+		require.Len(t, res.Sessions, 2)
+		assert.Equal(t, currentSession.Id, res.Sessions[0].Id)
+		assert.True(t, res.Sessions[0].Current)
+		assert.Equal(t, otherSession.Id, res.Sessions[1].Id)
+		assert.Equal(t, otherSession.IpAddress, res.Sessions[1].IpAddress)
+		assert.Equal(t, otherSession.UserAgent, res.Sessions[1].UserAgent)
+		assert.False(t, res.Sessions[1].Current)
+	})
+
+	t.Run("returns unauthorized without a current session", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{})
+		usecase := &Usecase{}
+
+		res, err := usecase.ListSessions(ctx)
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_RevokeSession(t *testing.T) {
+	t.Run("deletes the session scoped to the current user", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		req := RevokeSessionRequest{Id: uuid.New()}
+		auditRecorder := audit.NewMockIRecorder(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		usecase := &Usecase{auditRecorder: auditRecorder, userSessionRepository: userSessionRepository}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		userSessionRepository.EXPECT().DeleteByIdAndUserId(mock.Anything, req.Id, user.Id).Return(true, nil).Once()
+		auditRecorder.EXPECT().Record(mock.Anything, audit.Event{Action: audit.ActionSessionRevoked, TargetType: audit.TargetUserSession, TargetId: req.Id}).Return(nil).Once()
+
+		err := usecase.RevokeSession(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns not found when the session belongs to someone else", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		req := RevokeSessionRequest{Id: uuid.New()}
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		usecase := &Usecase{userSessionRepository: userSessionRepository}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectRollback()
+
+		userSessionRepository.EXPECT().DeleteByIdAndUserId(mock.Anything, req.Id, user.Id).Return(false, nil).Once()
+
+		err := usecase.RevokeSession(ctx, req)
+
+		require.ErrorIs(t, err, consts.ErrUserSessionNotFound)
+	})
+}
+
+func TestUsecase_RevokeOtherSessions(t *testing.T) {
+	t.Run("deletes every session of the user except the current one", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		currentSession := &entity.UserSession{Base: entity.Base{Id: uuid.New()}, UserId: user.Id}
+		ctx := current.SetUserSession(current.SetUser(context.Background(), user), currentSession)
+		auditRecorder := audit.NewMockIRecorder(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		usecase := &Usecase{auditRecorder: auditRecorder, userSessionRepository: userSessionRepository}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		userSessionRepository.EXPECT().DeleteByUserIdExceptId(mock.Anything, user.Id, currentSession.Id).Return(nil).Once()
+		auditRecorder.EXPECT().Record(mock.Anything, audit.Event{
+			Action:     audit.ActionOtherSessionsRevoked,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"exceptUserSessionId": currentSession.Id},
+		}).Return(nil).Once()
+
+		err := usecase.RevokeOtherSessions(ctx)
+
+		require.NoError(t, err)
+	})
+}
+
+func TestUsecase_Me(t *testing.T) {
+	t.Run("returns the current user", func(t *testing.T) {
+		userID := uuid.New()
+		ctx := current.SetUser(context.Background(), &entity.User{
+			Base:         entity.Base{Id: userID},
+			Name:         "Ada Lovelace",
+			EmailAddress: "ada@example.com",
+		})
+		usecase := &Usecase{}
+
+		res, err := usecase.Me(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, userID, res.User.Id)
+		assert.Equal(t, "Ada Lovelace", res.User.Name)
+		assert.Equal(t, "ada@example.com", res.User.EmailAddress)
+		assert.False(t, res.Impersonated)
+		assert.Nil(t, res.Impersonator)
+	})
+
+	t.Run("marks the response when the session is impersonated", func(t *testing.T) {
+		impersonatorID := uuid.New()
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}, Name: "Ada Lovelace"})
+		ctx = current.SetImpersonator(ctx, &entity.User{
+			Base:         entity.Base{Id: impersonatorID},
+			Name:         "Grace Hopper",
+			EmailAddress: "grace@example.com",
+		})
+		usecase := &Usecase{}
+
+		res, err := usecase.Me(ctx)
+
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, "Ada Lovelace", res.User.Name)
+		assert.True(t, res.Impersonated)
+		assert.Equal(t, &ImpersonatorResponse{Id: impersonatorID, Name: "Grace Hopper", EmailAddress: "grace@example.com"}, res.Impersonator)
+	})
+
+	t.Run("returns unauthorized when there is no current user", func(t *testing.T) {
+		usecase := &Usecase{}
+
+		res, err := usecase.Me(context.Background())
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_UpdateProfile(t *testing.T) {
+	t.Run("updates the name of the current user", func(t *testing.T) {
+		user := &entity.User{
+			Base:         entity.Base{Id: uuid.New()},
+			Name:         "Ada Lovelace",
+			EmailAddress: "ada@example.com",
+		}
+		ctx := current.SetUser(context.Background(), user)
+		req := UpdateProfileRequest{Name: "Ada Byron"}
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{validator: validator, userRepository: userRepository}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().UpdateNameById(ctx, user.Id, "Ada Byron").Return(nil).Once()
+
+		res, err := usecase.UpdateProfile(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, "Ada Byron", res.User.Name)
+		assert.Equal(t, "ada@example.com", res.User.EmailAddress)
+	})
+
+	t.Run("returns validation errors from the validator", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{})
+		req := UpdateProfileRequest{}
+		validationErr := api.ValidationError{"name": {"Name is required"}}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(&req).Return(validationErr).Once()
+
+		res, err := usecase.UpdateProfile(ctx, req)
+
+		require.Equal(t, validationErr, err)
+		assert.Nil(t, res)
+	})
+}
+
+func TestUsecase_ChangePassword(t *testing.T) {
+	t.Run("refuses while impersonating", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetUserSession(ctx, &entity.UserSession{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetImpersonator(ctx, &entity.User{Base: entity.Base{Id: uuid.New()}})
+		usecase := &Usecase{}
+
+		err := usecase.ChangePassword(ctx, ChangePasswordRequest{CurrentPassword: "correct horse battery staple", NewPassword: "new password 123"})
+
+		require.ErrorIs(t, err, consts.ErrImpersonationRestricted)
+	})
+
+	t.Run("rejects an incorrect current password", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
+		ctx := current.SetUser(context.Background(), user)
+		ctx = current.SetUserSession(ctx, &entity.UserSession{Base: entity.Base{Id: uuid.New()}})
+		req := ChangePasswordRequest{CurrentPassword: "wrong password", NewPassword: "new password 123"}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+
+		err := usecase.ChangePassword(ctx, req)
+
+		var validationErr api.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{consts.ErrIncorrectPassword.Error()}, validationErr["currentPassword"])
+	})
+
+	t.Run("requires a recent sign in for accounts without a password", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetUserSession(ctx, &entity.UserSession{Base: entity.Base{Id: uuid.New(), CreatedAt: time.Now().Add(-time.Hour)}})
+		req := ChangePasswordRequest{NewPassword: "new password 123"}
+		cfg := &config.Config{}
+		cfg.Auth.Reauthentication.MaxAge = 10 * time.Minute
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{config: cfg, validator: validator}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+
+		err := usecase.ChangePassword(ctx, req)
+
+		var validationErr api.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{consts.ErrReauthenticationRequired.Error()}, validationErr["currentPassword"])
+	})
+
+	t.Run("returns unauthorized without a current session", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{})
+		usecase := &Usecase{}
+
+		err := usecase.ChangePassword(ctx, ChangePasswordRequest{})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+	})
+}
+
+func TestUsecase_ChangeEmailAddress(t *testing.T) {
+	t.Run("rejects email addresses that are already registered and an incorrect current password", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
+		ctx := current.SetUser(context.Background(), user)
+		ctx = current.SetUserSession(ctx, &entity.UserSession{Base: entity.Base{Id: uuid.New()}})
+		req := ChangeEmailAddressRequest{EmailAddress: "grace@example.com", CurrentPassword: "wrong password"}
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		usecase := &Usecase{validator: validator, userRepository: userRepository}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().ExistsByEmailAddress(ctx, "grace@example.com").Return(true, nil).Once()
+
+		err := usecase.ChangeEmailAddress(ctx, req)
+
+		var validationErr api.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Equal(t, []string{consts.ErrEmailAddressAlreadyRegistered.Error()}, validationErr["emailAddress"])
+		assert.Equal(t, []string{consts.ErrIncorrectPassword.Error()}, validationErr["currentPassword"])
+	})
+
+	t.Run("retires the links mailed to the previous address and signs out the other sessions", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}, EmailAddress: "ada@example.com", EmailVerifiedAt: time.Now()}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
+		userSession := &entity.UserSession{Base: entity.Base{Id: uuid.New()}}
+		ctx := current.SetUser(context.Background(), user)
+		ctx = current.SetUserSession(ctx, userSession)
+		req := ChangeEmailAddressRequest{EmailAddress: "grace@example.com", CurrentPassword: "correct horse battery staple"}
+		cfg := &config.Config{}
+		cfg.App.Url = "http://localhost:3000"
+		cfg.App.SecretKey = "secret-key"
+		cfg.Auth.EmailVerification.TokenLifetime = time.Hour
+		validator := validation.NewMockIValidator(t)
+		riverClient := clientRiver.NewMockIClient(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		emailVerificationTokenRepository := repositoryEmailVerificationToken.NewMockIRepository(t)
+		passwordResetTokenRepository := repositoryPasswordResetToken.NewMockIRepository(t)
+		magicLinkTokenRepository := repositoryMagicLinkToken.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
+		usecase := &Usecase{
+			auditRecorder:                    auditRecorder,
+			config:                           cfg,
+			validator:                        validator,
+			riverClient:                      riverClient,
+			userRepository:                   userRepository,
+			userSessionRepository:            userSessionRepository,
+			emailVerificationTokenRepository: emailVerificationTokenRepository,
+			passwordResetTokenRepository:     passwordResetTokenRepository,
+			magicLinkTokenRepository:         magicLinkTokenRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+		userRepository.EXPECT().ExistsByEmailAddress(ctx, req.EmailAddress).Return(false, nil).Once()
+		userRepository.EXPECT().UpdateEmailAddressById(mock.Anything, user.Id, req.EmailAddress).Return(nil).Once()
+		emailVerificationTokenRepository.EXPECT().UpdateUsedAtByUserId(mock.Anything, user.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		passwordResetTokenRepository.EXPECT().UpdateUsedAtByUserId(mock.Anything, user.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		magicLinkTokenRepository.EXPECT().UpdateUsedAtByUserId(mock.Anything, user.Id, mock.AnythingOfType("time.Time")).Return(nil).Once()
+		userSessionRepository.EXPECT().DeleteByUserIdExceptId(mock.Anything, user.Id, userSession.Id).Return(nil).Once()
+		auditRecorder.EXPECT().Record(mock.Anything, audit.Event{
+			Action:     audit.ActionOtherSessionsRevoked,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"exceptUserSessionId": userSession.Id, "reason": "email_address_changed"},
+		}).Return(nil).Once()
+		emailVerificationTokenRepository.EXPECT().Create(mock.Anything, mock.AnythingOfType("*entity.EmailVerificationToken")).Return(nil).Once()
+		riverClient.EXPECT().Insert(mock.Anything, mock.Anything, mock.Anything).Return(nil).Once()
+
+		err := usecase.ChangeEmailAddress(ctx, req)
+
+		require.NoError(t, err)
+		assert.Equal(t, req.EmailAddress, user.EmailAddress)
+		assert.False(t, user.IsEmailVerified())
+	})
+}
+
+func TestUsecase_DeleteAccount(t *testing.T) {
+	t.Run("refuses while impersonating", func(t *testing.T) {
+		ctx := current.SetUser(context.Background(), &entity.User{Base: entity.Base{Id: uuid.New()}})
+		ctx = current.SetImpersonator(ctx, &entity.User{Base: entity.Base{Id: uuid.New()}})
+		usecase := &Usecase{}
+
+		err := usecase.DeleteAccount(ctx, DeleteAccountRequest{CurrentPassword: "correct horse battery staple"})
+
+		require.ErrorIs(t, err, consts.ErrImpersonationRestricted)
+	})
+
+	t.Run("rejects an incorrect current password", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
+		ctx := current.SetUser(context.Background(), user)
+		req := DeleteAccountRequest{CurrentPassword: "wrong password"}
+		validator := validation.NewMockIValidator(t)
+		usecase := &Usecase{validator: validator}
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+
+		err := usecase.DeleteAccount(ctx, req)
+
+		var validationErr api.ValidationError
+		require.ErrorAs(t, err, &validationErr)
+		assert.Contains(t, validationErr, "currentPassword")
+	})
+
+	t.Run("deletes the account together with its credentials and pending links", func(t *testing.T) {
+		user := &entity.User{Base: entity.Base{Id: uuid.New()}}
+		require.NoError(t, user.HashPassword("correct horse battery staple", testPasswordHashParams))
+		ctx := current.SetUser(context.Background(), user)
+		req := DeleteAccountRequest{CurrentPassword: "correct horse battery staple"}
+		validator := validation.NewMockIValidator(t)
+		userRepository := repositoryUser.NewMockIRepository(t)
+		userSessionRepository := repositoryUserSession.NewMockIRepository(t)
+		apiKeyRepository := repositoryApiKey.NewMockIRepository(t)
+		passwordResetTokenRepository := repositoryPasswordResetToken.NewMockIRepository(t)
+		emailVerificationTokenRepository := repositoryEmailVerificationToken.NewMockIRepository(t)
+		magicLinkTokenRepository := repositoryMagicLinkToken.NewMockIRepository(t)
+		userRecoveryCodeRepository := repositoryUserRecoveryCode.NewMockIRepository(t)
+		identityRepository := repositoryIdentity.NewMockIRepository(t)
+		auditRecorder := audit.NewMockIRecorder(t)
+		usecase := &Usecase{
+			auditRecorder:                    auditRecorder,
+			validator:                        validator,
+			userRepository:                   userRepository,
+			userSessionRepository:            userSessionRepository,
+			apiKeyRepository:                 apiKeyRepository,
+			passwordResetTokenRepository:     passwordResetTokenRepository,
+			emailVerificationTokenRepository: emailVerificationTokenRepository,
+			magicLinkTokenRepository:         magicLinkTokenRepository,
+			userRecoveryCodeRepository:       userRecoveryCodeRepository,
+			identityRepository:               identityRepository,
+		}
+
+		sqlMock := registerTransactionDB(t)
+		sqlMock.ExpectBegin()
+		sqlMock.ExpectCommit()
+
+		validator.EXPECT().Struct(&req).Return(api.ValidationError{}).Once()
+		userSessionRepository.EXPECT().DeleteByUserId(mock.Anything, user.Id).Return(nil).Once()
+		auditRecorder.EXPECT().Record(mock.Anything, audit.Event{
+			Action:     audit.ActionAllSessionsRevoked,
+			TargetType: audit.TargetUser,
+			TargetId:   user.Id,
+			Metadata:   map[string]any{"reason": "account_deleted"},
+		}).Return(nil).Once()
+		apiKeyRepository.EXPECT().DeleteByUserId(mock.Anything, user.Id).Return(nil).Once()
+		passwordResetTokenRepository.EXPECT().DeleteByUserId(mock.Anything, user.Id).Return(nil).Once()
+		emailVerificationTokenRepository.EXPECT().DeleteByUserId(mock.Anything, user.Id).Return(nil).Once()
+		magicLinkTokenRepository.EXPECT().DeleteByUserId(mock.Anything, user.Id).Return(nil).Once()
+		userRecoveryCodeRepository.EXPECT().DeleteByUserId(mock.Anything, user.Id).Return(nil).Once()
+		identityRepository.EXPECT().DeleteByUserId(mock.Anything, user.Id).Return(nil).Once()
+		userRepository.EXPECT().DeleteById(mock.Anything, user.Id).Return(nil).Once()
+
+		err := usecase.DeleteAccount(ctx, req)
+
+		require.NoError(t, err)
+	})
+
+	t.Run("returns unauthorized when there is no current user", func(t *testing.T) {
+		usecase := &Usecase{}
+
+		err := usecase.DeleteAccount(context.Background(), DeleteAccountRequest{})
+
+		require.ErrorIs(t, err, consts.ErrUnauthorized)
+	})
+}
+
+func registerTransactionDB(t *testing.T) sqlmock.Sqlmock {
+	t.Helper()
+
+	rawDB, sqlMock, err := sqlmock.New()
+	require.NoError(t, err)
+
+	bunDB := bun.NewDB(rawDB, pgdialect.New())
+	postgresDB := &dbSql.PostgresDB{}
+	bunDBField := reflect.ValueOf(postgresDB).Elem().FieldByName("bunDB")
+	reflect.NewAt(bunDBField.Type(), unsafe.Pointer(bunDBField.UnsafeAddr())).Elem().Set(reflect.ValueOf(bunDB))
+
+	do.OverrideValue[*dbSql.PostgresDB](bootstrap.Injector, postgresDB)
+	t.Cleanup(func() {
+		sqlMock.ExpectClose()
+		require.NoError(t, bunDB.Close())
+		require.NoError(t, sqlMock.ExpectationsWereMet())
+	})
+
+	return sqlMock
 }
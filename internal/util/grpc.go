@@ -2,6 +2,7 @@ package util
 
 import (
 	"context"
+	"net"
 
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/peer"
@@ -12,7 +13,12 @@ func GrpcPeerAddress(ctx context.Context) string {
 	if !ok {
 		return ""
 	}
-	return p.Addr.String()
+
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
 }
 
 func GrpcMetadataValue(ctx context.Context, key string) string {
@@ -26,3 +32,12 @@ func GrpcMetadataValue(ctx context.Context, key string) string {
 	}
 	return values[0]
 }
+
+func GrpcSessionToken(ctx context.Context) string {
+	authorization := GrpcMetadataValue(ctx, "authorization")
+	token := BearerToken(authorization)
+	if token == "" {
+		return authorization
+	}
+	return token
+}
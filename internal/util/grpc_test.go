@@ -0,0 +1,22 @@
+package util
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/peer"
+)
+
+func TestGrpcPeerAddress(t *testing.T) {
+	t.Run("returns the peer host without the port", func(t *testing.T) {
+		ctx := peer.NewContext(context.Background(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP("192.0.2.10"), Port: 52341}})
+
+		assert.Equal(t, "192.0.2.10", GrpcPeerAddress(ctx))
+	})
+
+	t.Run("returns an empty string without a peer", func(t *testing.T) {
+		assert.Empty(t, GrpcPeerAddress(context.Background()))
+	})
+}
@@ -0,0 +1,20 @@
+package util
+
+import (
+	"strings"
+
+	"github.com/labstack/echo/v5"
+)
+
+func HttpBearerToken(c *echo.Context) string {
+	return BearerToken(c.Request().Header.Get(echo.HeaderAuthorization))
+}
+
+func BearerToken(authorization string) string {
+	scheme, token, found := strings.Cut(authorization, " ")
+	if !found || !strings.EqualFold(scheme, "Bearer") {
+		return ""
+	}
+
+	return strings.TrimSpace(token)
+}
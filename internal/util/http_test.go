@@ -0,0 +1,41 @@
+package util
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/labstack/echo/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHttpBearerToken(t *testing.T) {
+	t.Run("returns the token from the authorization header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(echo.HeaderAuthorization, "Bearer session-token")
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+		assert.Equal(t, "session-token", HttpBearerToken(ctx))
+	})
+
+	t.Run("returns an empty string without an authorization header", func(t *testing.T) {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		ctx := echo.New().NewContext(req, httptest.NewRecorder())
+
+		assert.Empty(t, HttpBearerToken(ctx))
+	})
+}
+
+func TestBearerToken(t *testing.T) {
+	t.Run("accepts the scheme case-insensitively", func(t *testing.T) {
+		assert.Equal(t, "session-token", BearerToken("bearer session-token"))
+	})
+
+	t.Run("rejects other schemes", func(t *testing.T) {
+		assert.Empty(t, BearerToken("Basic dXNlcjpwYXNz"))
+	})
+
+	t.Run("rejects a value without a scheme", func(t *testing.T) {
+		assert.Empty(t, BearerToken("session-token"))
+	})
+}
@@ -0,0 +1,135 @@
+package util
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"strings"
+
+	"golang.org/x/crypto/argon2"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var (
+	ErrPasswordMismatch  = errors.New("password: digest does not match")
+	ErrUnsupportedDigest = errors.New("password: unsupported digest format")
+)
+
+var (
+	passwordDigestEncoding   = base64.RawStdEncoding
+	bcryptPasswordDigestTags = []string{"$2a$", "$2b$", "$2y$"}
+)
+
+type Argon2idParams struct {
+	Memory      uint32
+	Iterations  uint32
+	Parallelism uint8
+	SaltLength  uint32
+	KeyLength   uint32
+}
+
+// HashPassword returns an argon2id digest in the PHC string format, e.g.
+// $argon2id$v=19$m=65536,t=3,p=2$<salt>$<key>, so that the parameters used
+// travel with the digest and can be upgraded later.
+func HashPassword(password string, params Argon2idParams) (string, error) {
+	salt := make([]byte, params.SaltLength)
+	_, err := rand.Read(salt)
+	if err != nil {
+		return "", err
+	}
+
+	key := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+
+	return fmt.Sprintf(
+		"$argon2id$v=%d$m=%d,t=%d,p=%d$%s$%s",
+		argon2.Version, params.Memory, params.Iterations, params.Parallelism,
+		passwordDigestEncoding.EncodeToString(salt), passwordDigestEncoding.EncodeToString(key),
+	), nil
+}
+
+// ComparePassword verifies password against an argon2id digest or a legacy
+// bcrypt digest.
+func ComparePassword(digest, password string) error {
+	if isBcryptDigest(digest) {
+		err := bcrypt.CompareHashAndPassword([]byte(digest), []byte(password))
+		if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) || errors.Is(err, bcrypt.ErrPasswordTooLong) {
+			return ErrPasswordMismatch
+		}
+
+		return err
+	}
+
+	params, salt, key, err := parseArgon2idDigest(digest)
+	if err != nil {
+		return err
+	}
+
+	otherKey := argon2.IDKey([]byte(password), salt, params.Iterations, params.Memory, params.Parallelism, params.KeyLength)
+	if subtle.ConstantTimeCompare(key, otherKey) != 1 {
+		return ErrPasswordMismatch
+	}
+
+	return nil
+}
+
+// PasswordNeedsRehash reports whether digest was produced by bcrypt or with
+// argon2id parameters other than params.
+func PasswordNeedsRehash(digest string, params Argon2idParams) bool {
+	if isBcryptDigest(digest) {
+		return true
+	}
+
+	digestParams, _, _, err := parseArgon2idDigest(digest)
+	if err != nil {
+		return true
+	}
+
+	return digestParams != params
+}
+
+func isBcryptDigest(digest string) bool {
+	for _, tag := range bcryptPasswordDigestTags {
+		if strings.HasPrefix(digest, tag) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parseArgon2idDigest(digest string) (Argon2idParams, []byte, []byte, error) {
+	params := Argon2idParams{}
+
+	parts := strings.Split(digest, "$")
+	if len(parts) != 6 || parts[0] != "" || parts[1] != "argon2id" {
+		return params, nil, nil, ErrUnsupportedDigest
+	}
+
+	var version int
+	_, err := fmt.Sscanf(parts[2], "v=%d", &version)
+	if err != nil || version != argon2.Version {
+		return params, nil, nil, ErrUnsupportedDigest
+	}
+
+	_, err = fmt.Sscanf(parts[3], "m=%d,t=%d,p=%d", &params.Memory, &params.Iterations, &params.Parallelism)
+	if err != nil {
+		return params, nil, nil, ErrUnsupportedDigest
+	}
+
+	salt, err := passwordDigestEncoding.DecodeString(parts[4])
+	if err != nil {
+		return params, nil, nil, ErrUnsupportedDigest
+	}
+
+	key, err := passwordDigestEncoding.DecodeString(parts[5])
+	if err != nil || len(key) == 0 {
+		return params, nil, nil, ErrUnsupportedDigest
+	}
+
+	params.SaltLength = uint32(len(salt))
+	params.KeyLength = uint32(len(key))
+
+	return params, salt, key, nil
+}
@@ -0,0 +1,96 @@
+package util
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/bcrypt"
+)
+
+var testArgon2idParams = Argon2idParams{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32}
+
+func TestHashPassword(t *testing.T) {
+	t.Run("returns a phc formatted argon2id digest", func(t *testing.T) {
+		digest, err := HashPassword("correct horse battery staple", testArgon2idParams)
+
+		require.NoError(t, err)
+		assert.True(t, strings.HasPrefix(digest, "$argon2id$v=19$m=64,t=1,p=1$"))
+		assert.Len(t, strings.Split(digest, "$"), 6)
+	})
+
+	t.Run("uses a random salt for every digest", func(t *testing.T) {
+		digest, err := HashPassword("correct horse battery staple", testArgon2idParams)
+		require.NoError(t, err)
+
+		otherDigest, err := HashPassword("correct horse battery staple", testArgon2idParams)
+		require.NoError(t, err)
+
+		assert.NotEqual(t, digest, otherDigest)
+	})
+}
+
+func TestComparePassword(t *testing.T) {
+	t.Run("accepts the original password for argon2id digests", func(t *testing.T) {
+		digest, err := HashPassword("correct horse battery staple", testArgon2idParams)
+		require.NoError(t, err)
+
+		assert.NoError(t, ComparePassword(digest, "correct horse battery staple"))
+		assert.ErrorIs(t, ComparePassword(digest, "this is not the password"), ErrPasswordMismatch)
+	})
+
+	t.Run("does not truncate passwords longer than 72 bytes", func(t *testing.T) {
+		password := strings.Repeat("x", 72)
+		digest, err := HashPassword(password+"a", testArgon2idParams)
+		require.NoError(t, err)
+
+		assert.NoError(t, ComparePassword(digest, password+"a"))
+		assert.ErrorIs(t, ComparePassword(digest, password+"b"), ErrPasswordMismatch)
+	})
+
+	t.Run("accepts legacy bcrypt digests", func(t *testing.T) {
+		digest, err := bcrypt.GenerateFromPassword([]byte("correct horse battery staple"), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		assert.NoError(t, ComparePassword(string(digest), "correct horse battery staple"))
+		assert.ErrorIs(t, ComparePassword(string(digest), "this is not the password"), ErrPasswordMismatch)
+	})
+
+	t.Run("rejects unsupported digests", func(t *testing.T) {
+		assert.ErrorIs(t, ComparePassword("", "correct horse battery staple"), ErrUnsupportedDigest)
+		assert.ErrorIs(t, ComparePassword("$argon2i$v=19$m=64,t=1,p=1$c2FsdA$a2V5", "correct horse battery staple"), ErrUnsupportedDigest)
+		assert.ErrorIs(t, ComparePassword("$argon2id$v=16$m=64,t=1,p=1$c2FsdA$a2V5", "correct horse battery staple"), ErrUnsupportedDigest)
+	})
+}
+
+func TestPasswordNeedsRehash(t *testing.T) {
+	t.Run("returns false when the digest uses the given parameters", func(t *testing.T) {
+		digest, err := HashPassword("correct horse battery staple", testArgon2idParams)
+		require.NoError(t, err)
+
+		assert.False(t, PasswordNeedsRehash(digest, testArgon2idParams))
+	})
+
+	t.Run("returns true when any parameter differs", func(t *testing.T) {
+		digest, err := HashPassword("correct horse battery staple", testArgon2idParams)
+		require.NoError(t, err)
+
+		for _, params := range []Argon2idParams{
+			{Memory: 128, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 32},
+			{Memory: 64, Iterations: 2, Parallelism: 1, SaltLength: 16, KeyLength: 32},
+			{Memory: 64, Iterations: 1, Parallelism: 2, SaltLength: 16, KeyLength: 32},
+			{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 32, KeyLength: 32},
+			{Memory: 64, Iterations: 1, Parallelism: 1, SaltLength: 16, KeyLength: 64},
+		} {
+			assert.True(t, PasswordNeedsRehash(digest, params))
+		}
+	})
+
+	t.Run("returns true for bcrypt digests", func(t *testing.T) {
+		digest, err := bcrypt.GenerateFromPassword([]byte("correct horse battery staple"), bcrypt.MinCost)
+		require.NoError(t, err)
+
+		assert.True(t, PasswordNeedsRehash(string(digest), testArgon2idParams))
+	})
+}
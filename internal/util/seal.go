@@ -0,0 +1,57 @@
+package util
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"errors"
+)
+
+var ErrInvalidSealedData = errors.New("invalid sealed data")
+
+// Seal encrypts plaintext with AES-256-GCM under a key derived from secret
+// and prepends the random nonce.
+func Seal(secret string, plaintext []byte) ([]byte, error) {
+	aead, err := newSealAead(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, err = rand.Read(nonce)
+	if err != nil {
+		return nil, err
+	}
+
+	return aead.Seal(nonce, nonce, plaintext, nil), nil
+}
+
+func Open(secret string, sealed []byte) ([]byte, error) {
+	aead, err := newSealAead(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrInvalidSealedData
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+	plaintext, err := aead.Open(nil, nonce, ciphertext, nil)
+	if err != nil {
+		return nil, ErrInvalidSealedData
+	}
+
+	return plaintext, nil
+}
+
+func newSealAead(secret string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(secret))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
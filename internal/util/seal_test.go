@@ -0,0 +1,47 @@
+package util
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSeal(t *testing.T) {
+	t.Run("round trips through Open without exposing the plaintext", func(t *testing.T) {
+		sealed, err := Seal("secret", []byte("https://example.com/reset?token=abc"))
+		require.NoError(t, err)
+		assert.NotContains(t, string(sealed), "token=abc")
+
+		plaintext, err := Open("secret", sealed)
+
+		require.NoError(t, err)
+		assert.Equal(t, "https://example.com/reset?token=abc", string(plaintext))
+	})
+
+	t.Run("uses a new nonce on every call", func(t *testing.T) {
+		first, err := Seal("secret", []byte("data"))
+		require.NoError(t, err)
+		second, err := Seal("secret", []byte("data"))
+		require.NoError(t, err)
+
+		assert.NotEqual(t, first, second)
+	})
+}
+
+func TestOpen(t *testing.T) {
+	t.Run("rejects data sealed with another secret", func(t *testing.T) {
+		sealed, err := Seal("secret", []byte("data"))
+		require.NoError(t, err)
+
+		_, err = Open("other-secret", sealed)
+
+		require.ErrorIs(t, err, ErrInvalidSealedData)
+	})
+
+	t.Run("rejects truncated data", func(t *testing.T) {
+		_, err := Open("secret", []byte("short"))
+
+		require.ErrorIs(t, err, ErrInvalidSealedData)
+	})
+}
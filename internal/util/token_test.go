@@ -0,0 +1,31 @@
+package util
+
+import (
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateToken(t *testing.T) {
+	t.Run("returns 256 bits of url-safe random data", func(t *testing.T) {
+		token := GenerateToken()
+
+		decoded, err := base64.RawURLEncoding.DecodeString(token)
+		require.NoError(t, err)
+		assert.Len(t, decoded, 32)
+	})
+
+	t.Run("returns a different token on every call", func(t *testing.T) {
+		assert.NotEqual(t, GenerateToken(), GenerateToken())
+	})
+}
+
+func TestDigestToken(t *testing.T) {
+	t.Run("returns the hex encoded SHA-256 digest", func(t *testing.T) {
+		digest := DigestToken("session-token")
+
+		assert.Equal(t, "c101e911469c969171040b50d70543313cf968fdef5bacc780776f8fb399ab36", digest)
+	})
+}
@@ -0,0 +1,97 @@
+package util
+
+import (
+	"encoding/base32"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// rfc6238Secret is the SHA-1 seed from the RFC 6238 test vectors.
+var rfc6238Secret = base32.StdEncoding.WithPadding(base32.NoPadding).EncodeToString([]byte("12345678901234567890"))
+
+func TestGenerateTotpSecret(t *testing.T) {
+	t.Run("returns 160 bits of base32 encoded random data", func(t *testing.T) {
+		secret := GenerateTotpSecret()
+
+		decoded, err := base32.StdEncoding.WithPadding(base32.NoPadding).DecodeString(secret)
+		require.NoError(t, err)
+		assert.Len(t, decoded, 20)
+		assert.NotEqual(t, secret, GenerateTotpSecret())
+	})
+}
+
+func TestTotpUri(t *testing.T) {
+	t.Run("returns an otpauth uri with the issuer, account and secret", func(t *testing.T) {
+		uri, err := url.Parse(TotpUri("Bibit", "ada@example.com", "JBSWY3DPEHPK3PXP"))
+		require.NoError(t, err)
+
+		assert.Equal(t, "otpauth", uri.Scheme)
+		assert.Equal(t, "totp", uri.Host)
+		assert.Equal(t, "/Bibit:ada@example.com", uri.Path)
+		assert.Equal(t, "JBSWY3DPEHPK3PXP", uri.Query().Get("secret"))
+		assert.Equal(t, "Bibit", uri.Query().Get("issuer"))
+	})
+}
+
+func TestTotpCode(t *testing.T) {
+	t.Run("matches the RFC 6238 test vectors", func(t *testing.T) {
+		for unix, expected := range map[int64]string{
+			59:         "287082",
+			1111111109: "081804",
+			1111111111: "050471",
+			1234567890: "005924",
+			2000000000: "279037",
+		} {
+			code, err := TotpCode(rfc6238Secret, time.Unix(unix, 0))
+
+			require.NoError(t, err)
+			assert.Equal(t, expected, code)
+		}
+	})
+
+	t.Run("returns an error for a malformed secret", func(t *testing.T) {
+		_, err := TotpCode("not base32!", time.Now())
+
+		require.Error(t, err)
+	})
+}
+
+func TestMatchTotpCode(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+
+	t.Run("accepts the code of the current period", func(t *testing.T) {
+		step, ok := MatchTotpCode(rfc6238Secret, "050471", now)
+
+		assert.True(t, ok)
+		assert.Equal(t, now.Unix()/30, step)
+	})
+
+	t.Run("accepts the code of an adjacent period and returns its step", func(t *testing.T) {
+		code, err := TotpCode(rfc6238Secret, now.Add(-30*time.Second))
+		require.NoError(t, err)
+
+		step, ok := MatchTotpCode(rfc6238Secret, code, now)
+
+		assert.True(t, ok)
+		assert.Equal(t, now.Unix()/30-1, step)
+	})
+
+	t.Run("rejects codes outside the allowed drift", func(t *testing.T) {
+		code, err := TotpCode(rfc6238Secret, now.Add(-2*time.Minute))
+		require.NoError(t, err)
+
+		_, ok := MatchTotpCode(rfc6238Secret, code, now)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("rejects malformed codes", func(t *testing.T) {
+		_, ok := MatchTotpCode(rfc6238Secret, "12345", now)
+
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,71 @@
+package validation
+
+import (
+	"fmt"
+	"math"
+	"mime"
+	"strconv"
+	"strings"
+
+	"github.com/anonychun/bibit/internal/api"
+)
+
+// FileRule declares which files an upload accepts, e.g.
+//
+//	var avatarRule = validation.FileRule{
+//		ContentTypes: []string{"image/png", "image/jpeg"},
+//		MaxByteSize:  5 << 20,
+//	}
+//
+// A content type ending in "/*" accepts the whole family except markup such as
+// SVG or HTML, which browsers can run scripts from; markup is only accepted
+// when listed exactly. Zero values accept any file.
+type FileRule struct {
+	ContentTypes []string
+	MaxByteSize  int64
+}
+
+func (fr FileRule) ValidateContentType(validationErr api.ValidationError, field, label, contentType string) {
+	if len(fr.ContentTypes) == 0 {
+		return
+	}
+
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err == nil {
+		for _, allowed := range fr.ContentTypes {
+			family, isWildcard := strings.CutSuffix(allowed, "/*")
+			if mediaType == allowed || (isWildcard && strings.HasPrefix(mediaType, family+"/") && !isMarkup(mediaType)) {
+				return
+			}
+		}
+	}
+
+	validationErr.Add(field, fmt.Sprintf("%s must be one of %s", label, strings.Join(fr.ContentTypes, ", ")))
+}
+
+func isMarkup(mediaType string) bool {
+	switch mediaType {
+	case "text/html", "text/xml", "application/xml":
+		return true
+	}
+
+	return strings.HasSuffix(mediaType, "+xml")
+}
+
+func (fr FileRule) ValidateByteSize(validationErr api.ValidationError, field, label string, byteSize int64) {
+	if fr.MaxByteSize > 0 && byteSize > fr.MaxByteSize {
+		validationErr.Add(field, fmt.Sprintf("%s must not be larger than %s", label, formatByteSize(fr.MaxByteSize)))
+	}
+}
+
+func formatByteSize(byteSize int64) string {
+	units := []string{"B", "KB", "MB", "GB"}
+	size := float64(byteSize)
+	unit := 0
+	for size >= 1024 && unit < len(units)-1 {
+		size /= 1024
+		unit++
+	}
+
+	return strconv.FormatFloat(math.Round(size*10)/10, 'f', -1, 64) + " " + units[unit]
+}
@@ -0,0 +1,83 @@
+package validation
+
+import (
+	"testing"
+
+	"github.com/anonychun/bibit/internal/api"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileRule_ValidateContentType(t *testing.T) {
+	rule := FileRule{ContentTypes: []string{"image/*", "application/pdf"}}
+
+	t.Run("accepts exact and wildcard matches ignoring parameters", func(t *testing.T) {
+		validationErr := make(api.ValidationError)
+
+		rule.ValidateContentType(validationErr, "file", "File", "application/pdf")
+		rule.ValidateContentType(validationErr, "file", "File", "image/png")
+		rule.ValidateContentType(validationErr, "file", "File", "Image/PNG; charset=utf-8")
+
+		assert.False(t, validationErr.IsFail())
+	})
+
+	t.Run("rejects markup matched only by a wildcard", func(t *testing.T) {
+		validationErr := make(api.ValidationError)
+
+		rule.ValidateContentType(validationErr, "file", "File", "Image/SVG+XML; charset=utf-8")
+		FileRule{ContentTypes: []string{"text/*"}}.ValidateContentType(validationErr, "text", "Text", "text/html")
+
+		assert.Equal(t, api.ValidationError{
+			"file": {"File must be one of image/*, application/pdf"},
+			"text": {"Text must be one of text/*"},
+		}, validationErr)
+	})
+
+	t.Run("accepts markup listed exactly", func(t *testing.T) {
+		validationErr := make(api.ValidationError)
+
+		FileRule{ContentTypes: []string{"image/svg+xml"}}.ValidateContentType(validationErr, "file", "File", "image/svg+xml")
+
+		assert.False(t, validationErr.IsFail())
+	})
+
+	t.Run("rejects other and malformed content types", func(t *testing.T) {
+		validationErr := make(api.ValidationError)
+
+		rule.ValidateContentType(validationErr, "file", "File", "text/plain; charset=utf-8")
+		rule.ValidateContentType(validationErr, "contentType", "Content type", "")
+
+		assert.Equal(t, api.ValidationError{
+			"file":        {"File must be one of image/*, application/pdf"},
+			"contentType": {"Content type must be one of image/*, application/pdf"},
+		}, validationErr)
+	})
+
+	t.Run("accepts anything without content types", func(t *testing.T) {
+		validationErr := make(api.ValidationError)
+
+		FileRule{}.ValidateContentType(validationErr, "file", "File", "application/x-msdownload")
+
+		assert.False(t, validationErr.IsFail())
+	})
+}
+
+func TestFileRule_ValidateByteSize(t *testing.T) {
+	t.Run("rejects files larger than the max byte size", func(t *testing.T) {
+		validationErr := make(api.ValidationError)
+		rule := FileRule{MaxByteSize: 5 << 20}
+
+		rule.ValidateByteSize(validationErr, "file", "File", 5<<20)
+		assert.False(t, validationErr.IsFail())
+
+		rule.ValidateByteSize(validationErr, "file", "File", 5<<20+1)
+		assert.Equal(t, api.ValidationError{"file": {"File must not be larger than 5 MB"}}, validationErr)
+	})
+
+	t.Run("accepts any size without a max byte size", func(t *testing.T) {
+		validationErr := make(api.ValidationError)
+
+		FileRule{}.ValidateByteSize(validationErr, "file", "File", 1<<40)
+
+		assert.False(t, validationErr.IsFail())
+	})
+}
@@ -2,10 +2,14 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/anonychun/bibit/internal/bootstrap"
 	clientRiver "github.com/anonychun/bibit/internal/client/river"
 	jobHello "github.com/anonychun/bibit/internal/job/hello"
+	jobPurgeExpiredAttachments "github.com/anonychun/bibit/internal/job/purge_expired_attachments"
+	jobPurgeExpiredOidcStates "github.com/anonychun/bibit/internal/job/purge_expired_oidc_states"
+	jobSendEmail "github.com/anonychun/bibit/internal/job/send_email"
 	"github.com/anonychun/bibit/internal/observability"
 	"github.com/riverqueue/river"
 	"github.com/samber/do/v2"
@@ -36,6 +40,43 @@ func NewWorker(i do.Injector) (*Worker, error) {
 		return nil, err
 	}
 
+	err = addWorkers(riverClient.Workers(),
+		do.MustInvoke[*jobSendEmail.Job](i),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addWorkers(riverClient.Workers(),
+		do.MustInvoke[*jobPurgeExpiredAttachments.Job](i),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	err = addWorkers(riverClient.Workers(),
+		do.MustInvoke[*jobPurgeExpiredOidcStates.Job](i),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	riverClient.Client().PeriodicJobs().Add(river.NewPeriodicJob(
+		river.PeriodicInterval(15*time.Minute),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return jobPurgeExpiredAttachments.Args{}, nil
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	))
+
+	riverClient.Client().PeriodicJobs().Add(river.NewPeriodicJob(
+		river.PeriodicInterval(time.Hour),
+		func() (river.JobArgs, *river.InsertOpts) {
+			return jobPurgeExpiredOidcStates.Args{}, nil
+		},
+		&river.PeriodicJobOpts{RunOnStart: true},
+	))
+
 	return &Worker{
 		riverClient:   riverClient,
 		observability: do.MustInvoke[*observability.Observability](i),
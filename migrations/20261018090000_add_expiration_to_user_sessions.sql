@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Sessions issued before this migration never expired and their tokens were
+-- stored in plain text, so they are expired here rather than given a lifetime
+-- the migration cannot read from AUTH_SESSION_MAX_LIFETIME. Their users have
+-- to sign in again once.
+ALTER TABLE user_sessions
+	ADD COLUMN last_seen_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	ADD COLUMN expires_at TIMESTAMPTZ NOT NULL DEFAULT now();
+
+ALTER TABLE user_sessions
+	ALTER COLUMN last_seen_at DROP DEFAULT,
+	ALTER COLUMN expires_at DROP DEFAULT;
+
+CREATE INDEX user_sessions_expires_at_idx ON user_sessions (expires_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX user_sessions_expires_at_idx;
+
+ALTER TABLE user_sessions
+	DROP COLUMN expires_at,
+	DROP COLUMN last_seen_at;
+-- +goose StatementEnd
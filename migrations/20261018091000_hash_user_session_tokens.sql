@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_sessions ADD COLUMN token_digest TEXT;
+
+UPDATE user_sessions SET token_digest = encode(sha256(convert_to(token, 'UTF8')), 'hex');
+
+ALTER TABLE user_sessions
+	ALTER COLUMN token_digest SET NOT NULL,
+	ADD CONSTRAINT user_sessions_token_digest_key UNIQUE (token_digest),
+	DROP COLUMN token;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM user_sessions;
+
+ALTER TABLE user_sessions
+	ADD COLUMN token TEXT NOT NULL UNIQUE,
+	DROP COLUMN token_digest;
+-- +goose StatementEnd
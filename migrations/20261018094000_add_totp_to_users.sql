@@ -0,0 +1,36 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users
+	ADD COLUMN totp_secret TEXT,
+	ADD COLUMN totp_enabled_at TIMESTAMPTZ;
+
+CREATE TABLE user_recovery_codes (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	code_digest TEXT NOT NULL,
+	used_at TIMESTAMPTZ,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (user_id, code_digest)
+);
+
+CREATE TABLE sign_in_challenges (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	token_digest TEXT NOT NULL UNIQUE,
+	expires_at TIMESTAMPTZ NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sign_in_challenges;
+
+DROP TABLE user_recovery_codes;
+
+ALTER TABLE users
+	DROP COLUMN totp_enabled_at,
+	DROP COLUMN totp_secret;
+-- +goose StatementEnd
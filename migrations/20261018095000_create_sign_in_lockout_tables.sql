@@ -0,0 +1,33 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE failed_sign_in_attempts (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	email_address TEXT NOT NULL,
+	ip_address TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX failed_sign_in_attempts_email_address_created_at_idx ON failed_sign_in_attempts (email_address, created_at);
+
+CREATE INDEX failed_sign_in_attempts_ip_address_created_at_idx ON failed_sign_in_attempts (ip_address, created_at);
+
+CREATE TABLE sign_in_lockouts (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	scope TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	failed_attempts INTEGER NOT NULL,
+	locked_until TIMESTAMPTZ NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX sign_in_lockouts_scope_subject_created_at_idx ON sign_in_lockouts (scope, subject, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE sign_in_lockouts;
+
+DROP TABLE failed_sign_in_attempts;
+-- +goose StatementEnd
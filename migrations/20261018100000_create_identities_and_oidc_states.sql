@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE identities (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	provider TEXT NOT NULL,
+	subject TEXT NOT NULL,
+	email_address TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (provider, subject)
+);
+
+CREATE TABLE oidc_states (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	provider TEXT NOT NULL,
+	state_digest TEXT NOT NULL UNIQUE,
+	nonce TEXT NOT NULL,
+	code_verifier TEXT NOT NULL,
+	expires_at TIMESTAMPTZ NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE oidc_states;
+
+DROP TABLE identities;
+-- +goose StatementEnd
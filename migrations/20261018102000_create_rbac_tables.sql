@@ -0,0 +1,44 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE roles (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	name TEXT NOT NULL UNIQUE,
+	description TEXT NOT NULL DEFAULT '',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE permissions (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	name TEXT NOT NULL UNIQUE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE TABLE role_permissions (
+	role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+	permission_id UUID NOT NULL REFERENCES permissions(id) ON DELETE CASCADE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (role_id, permission_id)
+);
+
+CREATE TABLE user_roles (
+	user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+	role_id UUID NOT NULL REFERENCES roles(id) ON DELETE CASCADE,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	PRIMARY KEY (user_id, role_id)
+);
+
+CREATE INDEX user_roles_role_id_idx ON user_roles (role_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE user_roles;
+
+DROP TABLE role_permissions;
+
+DROP TABLE permissions;
+
+DROP TABLE roles;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN deleted_at TIMESTAMPTZ;
+
+ALTER TABLE users DROP CONSTRAINT users_email_address_key;
+
+CREATE UNIQUE INDEX users_email_address_key ON users (email_address) WHERE deleted_at IS NULL;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM users WHERE deleted_at IS NOT NULL;
+
+DROP INDEX users_email_address_key;
+
+ALTER TABLE users ADD CONSTRAINT users_email_address_key UNIQUE (email_address);
+
+ALTER TABLE users DROP COLUMN deleted_at;
+-- +goose StatementEnd
@@ -0,0 +1,27 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_sessions ADD COLUMN impersonator_id UUID REFERENCES users(id) ON DELETE CASCADE;
+
+CREATE TABLE impersonation_logs (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	user_session_id UUID NOT NULL,
+	impersonator_id UUID NOT NULL REFERENCES users(id),
+	user_id UUID NOT NULL REFERENCES users(id),
+	method TEXT NOT NULL,
+	path TEXT NOT NULL,
+	ip_address TEXT NOT NULL,
+	user_agent TEXT NOT NULL,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX impersonation_logs_impersonator_id_idx ON impersonation_logs (impersonator_id);
+CREATE INDEX impersonation_logs_user_id_idx ON impersonation_logs (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE impersonation_logs;
+
+ALTER TABLE user_sessions DROP COLUMN impersonator_id;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE audit_events (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	action TEXT NOT NULL,
+	actor_id UUID,
+	impersonator_id UUID,
+	target_type TEXT NOT NULL DEFAULT '',
+	target_id UUID,
+	ip_address TEXT NOT NULL DEFAULT '',
+	user_agent TEXT NOT NULL DEFAULT '',
+	request_id TEXT NOT NULL DEFAULT '',
+	changes JSONB NOT NULL DEFAULT '{}',
+	metadata JSONB NOT NULL DEFAULT '{}',
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+
+CREATE INDEX audit_events_action_idx ON audit_events (action);
+CREATE INDEX audit_events_actor_id_idx ON audit_events (actor_id);
+CREATE INDEX audit_events_target_idx ON audit_events (target_type, target_id);
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE audit_events;
+-- +goose StatementEnd
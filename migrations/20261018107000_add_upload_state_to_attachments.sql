@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE attachments
+	ADD COLUMN checksum TEXT,
+	ADD COLUMN status TEXT NOT NULL DEFAULT 'committed',
+	ADD COLUMN expires_at TIMESTAMPTZ;
+
+ALTER TABLE attachments
+	ALTER COLUMN status DROP DEFAULT;
+
+CREATE INDEX attachments_pending_expires_at_idx ON attachments (expires_at) WHERE status = 'pending';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX attachments_pending_expires_at_idx;
+
+ALTER TABLE attachments
+	DROP COLUMN expires_at,
+	DROP COLUMN status,
+	DROP COLUMN checksum;
+-- +goose StatementEnd
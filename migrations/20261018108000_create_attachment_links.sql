@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE attachment_links (
+	id UUID PRIMARY KEY DEFAULT uuidv7(),
+	attachment_id UUID NOT NULL REFERENCES attachments(id) ON DELETE CASCADE,
+	record_type TEXT NOT NULL,
+	record_id UUID NOT NULL,
+	name TEXT NOT NULL,
+	position INTEGER NOT NULL DEFAULT 0,
+	created_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	updated_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+	UNIQUE (record_type, record_id, name, attachment_id)
+);
+
+CREATE INDEX attachment_links_record_idx ON attachment_links (record_type, record_id, name, position);
+CREATE INDEX attachment_links_attachment_id_idx ON attachment_links (attachment_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE attachment_links;
+-- +goose StatementEnd
@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE attachments
+	ADD COLUMN content_type TEXT,
+	ADD COLUMN metadata JSONB;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE attachments
+	DROP COLUMN metadata,
+	DROP COLUMN content_type;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Accounts created before email verification existed were never sent a
+-- token, so they are treated as verified instead of being locked out once
+-- AUTH_EMAIL_VERIFICATION_REQUIRED_ON_SIGNIN is enabled.
+UPDATE users SET email_verified_at = created_at
+WHERE email_verified_at IS NULL
+	AND NOT EXISTS (SELECT 1 FROM email_verification_tokens WHERE email_verification_tokens.user_id = users.id);
+-- +goose StatementEnd
+
+-- +goose Down
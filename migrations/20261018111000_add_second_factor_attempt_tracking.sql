@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE users ADD COLUMN totp_last_used_step BIGINT;
+
+ALTER TABLE sign_in_challenges ADD COLUMN attempts INT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE sign_in_challenges DROP COLUMN attempts;
+
+ALTER TABLE users DROP COLUMN totp_last_used_step;
+-- +goose StatementEnd
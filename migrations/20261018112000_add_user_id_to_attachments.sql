@@ -0,0 +1,13 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE attachments
+	ADD COLUMN user_id UUID REFERENCES users(id) ON DELETE SET NULL;
+
+CREATE INDEX attachments_user_id_idx ON attachments (user_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE attachments
+	DROP COLUMN user_id;
+-- +goose StatementEnd
@@ -0,0 +1,186 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.11
+// 	protoc        v7.35.0
+// source: api/v1/admin/impersonation/service.proto
+
+package impersonation
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type StartImpersonationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartImpersonationRequest) Reset() {
+	*x = StartImpersonationRequest{}
+	mi := &file_api_v1_admin_impersonation_service_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartImpersonationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartImpersonationRequest) ProtoMessage() {}
+
+func (x *StartImpersonationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_admin_impersonation_service_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartImpersonationRequest.ProtoReflect.Descriptor instead.
+func (*StartImpersonationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_admin_impersonation_service_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *StartImpersonationRequest) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type StartImpersonationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartImpersonationResponse) Reset() {
+	*x = StartImpersonationResponse{}
+	mi := &file_api_v1_admin_impersonation_service_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartImpersonationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartImpersonationResponse) ProtoMessage() {}
+
+func (x *StartImpersonationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_admin_impersonation_service_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartImpersonationResponse.ProtoReflect.Descriptor instead.
+func (*StartImpersonationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_admin_impersonation_service_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *StartImpersonationResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *StartImpersonationResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+var File_api_v1_admin_impersonation_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_admin_impersonation_service_proto_rawDesc = "" +
+	"\n" +
+	"(api/v1/admin/impersonation/service.proto\x12\x1aapi.v1.admin.impersonation\x1a\x1fgoogle/protobuf/timestamp.proto\"4\n" +
+	"\x19StartImpersonationRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\tR\x06userId\"m\n" +
+	"\x1aStartImpersonationResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt2\x8f\x01\n" +
+	"\aService\x12\x83\x01\n" +
+	"\x12StartImpersonation\x125.api.v1.admin.impersonation.StartImpersonationRequest\x1a6.api.v1.admin.impersonation.StartImpersonationResponseB>Z<github.com/anonychun/bibit/pkg/pb/api/v1/admin/impersonationb\x06proto3"
+
+var (
+	file_api_v1_admin_impersonation_service_proto_rawDescOnce sync.Once
+	file_api_v1_admin_impersonation_service_proto_rawDescData []byte
+)
+
+func file_api_v1_admin_impersonation_service_proto_rawDescGZIP() []byte {
+	file_api_v1_admin_impersonation_service_proto_rawDescOnce.Do(func() {
+		file_api_v1_admin_impersonation_service_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_admin_impersonation_service_proto_rawDesc), len(file_api_v1_admin_impersonation_service_proto_rawDesc)))
+	})
+	return file_api_v1_admin_impersonation_service_proto_rawDescData
+}
+
+var file_api_v1_admin_impersonation_service_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_api_v1_admin_impersonation_service_proto_goTypes = []any{
+	(*StartImpersonationRequest)(nil),  // 0: api.v1.admin.impersonation.StartImpersonationRequest
+	(*StartImpersonationResponse)(nil), // 1: api.v1.admin.impersonation.StartImpersonationResponse
+	(*timestamppb.Timestamp)(nil),      // 2: google.protobuf.Timestamp
+}
+var file_api_v1_admin_impersonation_service_proto_depIdxs = []int32{
+	2, // 0: api.v1.admin.impersonation.StartImpersonationResponse.expires_at:type_name -> google.protobuf.Timestamp
+	0, // 1: api.v1.admin.impersonation.Service.StartImpersonation:input_type -> api.v1.admin.impersonation.StartImpersonationRequest
+	1, // 2: api.v1.admin.impersonation.Service.StartImpersonation:output_type -> api.v1.admin.impersonation.StartImpersonationResponse
+	2, // [2:3] is the sub-list for method output_type
+	1, // [1:2] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_admin_impersonation_service_proto_init() }
+func file_api_v1_admin_impersonation_service_proto_init() {
+	if File_api_v1_admin_impersonation_service_proto != nil {
+		return
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_admin_impersonation_service_proto_rawDesc), len(file_api_v1_admin_impersonation_service_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_admin_impersonation_service_proto_goTypes,
+		DependencyIndexes: file_api_v1_admin_impersonation_service_proto_depIdxs,
+		MessageInfos:      file_api_v1_admin_impersonation_service_proto_msgTypes,
+	}.Build()
+	File_api_v1_admin_impersonation_service_proto = out.File
+	file_api_v1_admin_impersonation_service_proto_goTypes = nil
+	file_api_v1_admin_impersonation_service_proto_depIdxs = nil
+}
@@ -9,6 +9,7 @@ package auth
 import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -84,6 +85,7 @@ func (x *SignUpRequest) GetPassword() string {
 type SignUpResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -125,6 +127,13 @@ func (x *SignUpResponse) GetToken() string {
 	return ""
 }
 
+func (x *SignUpResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
 type SignInRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	EmailAddress  string                 `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
@@ -178,10 +187,13 @@ func (x *SignInRequest) GetPassword() string {
 }
 
 type SignInResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Token                string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SecondFactorRequired bool                   `protobuf:"varint,3,opt,name=second_factor_required,json=secondFactorRequired,proto3" json:"second_factor_required,omitempty"`
+	ChallengeToken       string                 `protobuf:"bytes,4,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
 func (x *SignInResponse) Reset() {
@@ -221,27 +233,49 @@ func (x *SignInResponse) GetToken() string {
 	return ""
 }
 
-type SignOutRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *SignInResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
 }
 
-func (x *SignOutRequest) Reset() {
-	*x = SignOutRequest{}
+func (x *SignInResponse) GetSecondFactorRequired() bool {
+	if x != nil {
+		return x.SecondFactorRequired
+	}
+	return false
+}
+
+func (x *SignInResponse) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+type CompleteSignInRequest struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ChallengeToken string                 `protobuf:"bytes,1,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	Code           string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *CompleteSignInRequest) Reset() {
+	*x = CompleteSignInRequest{}
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignOutRequest) String() string {
+func (x *CompleteSignInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignOutRequest) ProtoMessage() {}
+func (*CompleteSignInRequest) ProtoMessage() {}
 
-func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
+func (x *CompleteSignInRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -253,38 +287,47 @@ func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
-func (*SignOutRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompleteSignInRequest.ProtoReflect.Descriptor instead.
+func (*CompleteSignInRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *SignOutRequest) GetToken() string {
+func (x *CompleteSignInRequest) GetChallengeToken() string {
 	if x != nil {
-		return x.Token
+		return x.ChallengeToken
 	}
 	return ""
 }
 
-type SignOutResponse struct {
+func (x *CompleteSignInRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type CompleteSignInResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *SignOutResponse) Reset() {
-	*x = SignOutResponse{}
+func (x *CompleteSignInResponse) Reset() {
+	*x = CompleteSignInResponse{}
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *SignOutResponse) String() string {
+func (x *CompleteSignInResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SignOutResponse) ProtoMessage() {}
+func (*CompleteSignInResponse) ProtoMessage() {}
 
-func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
+func (x *CompleteSignInResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -296,31 +339,46 @@ func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SignOutResponse.ProtoReflect.Descriptor instead.
-func (*SignOutResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CompleteSignInResponse.ProtoReflect.Descriptor instead.
+func (*CompleteSignInResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{5}
 }
 
-type MeRequest struct {
+func (x *CompleteSignInResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CompleteSignInResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+type StartOidcSignInRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MeRequest) Reset() {
-	*x = MeRequest{}
+func (x *StartOidcSignInRequest) Reset() {
+	*x = StartOidcSignInRequest{}
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MeRequest) String() string {
+func (x *StartOidcSignInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MeRequest) ProtoMessage() {}
+func (*StartOidcSignInRequest) ProtoMessage() {}
 
-func (x *MeRequest) ProtoReflect() protoreflect.Message {
+func (x *StartOidcSignInRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -332,32 +390,39 @@ func (x *MeRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MeRequest.ProtoReflect.Descriptor instead.
-func (*MeRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartOidcSignInRequest.ProtoReflect.Descriptor instead.
+func (*StartOidcSignInRequest) Descriptor() ([]byte, []int) {
 	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{6}
 }
 
-type MeResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *MeResponse_User       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *StartOidcSignInRequest) GetProvider() string {
+	if x != nil {
+		return x.Provider
+	}
+	return ""
 }
 
-func (x *MeResponse) Reset() {
-	*x = MeResponse{}
+type StartOidcSignInResponse struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	AuthorizationUrl string                 `protobuf:"bytes,1,opt,name=authorization_url,json=authorizationUrl,proto3" json:"authorization_url,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *StartOidcSignInResponse) Reset() {
+	*x = StartOidcSignInResponse{}
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MeResponse) String() string {
+func (x *StartOidcSignInResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MeResponse) ProtoMessage() {}
+func (*StartOidcSignInResponse) ProtoMessage() {}
 
-func (x *MeResponse) ProtoReflect() protoreflect.Message {
+func (x *StartOidcSignInResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[7]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -369,41 +434,41 @@ func (x *MeResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MeResponse.ProtoReflect.Descriptor instead.
-func (*MeResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StartOidcSignInResponse.ProtoReflect.Descriptor instead.
+func (*StartOidcSignInResponse) Descriptor() ([]byte, []int) {
 	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *MeResponse) GetUser() *MeResponse_User {
+func (x *StartOidcSignInResponse) GetAuthorizationUrl() string {
 	if x != nil {
-		return x.User
+		return x.AuthorizationUrl
 	}
-	return nil
+	return ""
 }
 
-type MeResponse_User struct {
+type CompleteOidcSignInRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	EmailAddress  string                 `protobuf:"bytes,3,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	Provider      string                 `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Code          string                 `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	State         string                 `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MeResponse_User) Reset() {
-	*x = MeResponse_User{}
+func (x *CompleteOidcSignInRequest) Reset() {
+	*x = CompleteOidcSignInRequest{}
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MeResponse_User) String() string {
+func (x *CompleteOidcSignInRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MeResponse_User) ProtoMessage() {}
+func (*CompleteOidcSignInRequest) ProtoMessage() {}
 
-func (x *MeResponse_User) ProtoReflect() protoreflect.Message {
+func (x *CompleteOidcSignInRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_api_v1_app_auth_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -415,64 +480,1848 @@ func (x *MeResponse_User) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MeResponse_User.ProtoReflect.Descriptor instead.
-func (*MeResponse_User) Descriptor() ([]byte, []int) {
-	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{7, 0}
+// Deprecated: Use CompleteOidcSignInRequest.ProtoReflect.Descriptor instead.
+func (*CompleteOidcSignInRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *MeResponse_User) GetId() string {
+func (x *CompleteOidcSignInRequest) GetProvider() string {
 	if x != nil {
-		return x.Id
+		return x.Provider
 	}
 	return ""
 }
 
-func (x *MeResponse_User) GetName() string {
+func (x *CompleteOidcSignInRequest) GetCode() string {
 	if x != nil {
-		return x.Name
+		return x.Code
 	}
 	return ""
 }
 
-func (x *MeResponse_User) GetEmailAddress() string {
+func (x *CompleteOidcSignInRequest) GetState() string {
 	if x != nil {
-		return x.EmailAddress
+		return x.State
 	}
 	return ""
 }
 
-var File_api_v1_app_auth_service_proto protoreflect.FileDescriptor
+type CompleteOidcSignInResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Token                string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SecondFactorRequired bool                   `protobuf:"varint,3,opt,name=second_factor_required,json=secondFactorRequired,proto3" json:"second_factor_required,omitempty"`
+	ChallengeToken       string                 `protobuf:"bytes,4,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
 
-const file_api_v1_app_auth_service_proto_rawDesc = "" +
-	"\n" +
-	"\x1dapi/v1/app/auth/service.proto\x12\x0fapi.v1.app.auth\"d\n" +
-	"\rSignUpRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
-	"\remail_address\x18\x02 \x01(\tR\femailAddress\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"&\n" +
-	"\x0eSignUpResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"P\n" +
-	"\rSignInRequest\x12#\n" +
-	"\remail_address\x18\x01 \x01(\tR\femailAddress\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\"&\n" +
-	"\x0eSignInResponse\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"&\n" +
-	"\x0eSignOutRequest\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\"\x11\n" +
-	"\x0fSignOutResponse\"\v\n" +
-	"\tMeRequest\"\x93\x01\n" +
-	"\n" +
-	"MeResponse\x124\n" +
-	"\x04user\x18\x01 \x01(\v2 .api.v1.app.auth.MeResponse.UserR\x04user\x1aO\n" +
-	"\x04User\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
-	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
-	"\remail_address\x18\x03 \x01(\tR\femailAddress2\xac\x02\n" +
-	"\aService\x12I\n" +
-	"\x06SignUp\x12\x1e.api.v1.app.auth.SignUpRequest\x1a\x1f.api.v1.app.auth.SignUpResponse\x12I\n" +
-	"\x06SignIn\x12\x1e.api.v1.app.auth.SignInRequest\x1a\x1f.api.v1.app.auth.SignInResponse\x12L\n" +
-	"\aSignOut\x12\x1f.api.v1.app.auth.SignOutRequest\x1a .api.v1.app.auth.SignOutResponse\x12=\n" +
-	"\x02Me\x12\x1a.api.v1.app.auth.MeRequest\x1a\x1b.api.v1.app.auth.MeResponseB3Z1github.com/anonychun/bibit/pkg/pb/api/v1/app/authb\x06proto3"
+func (x *CompleteOidcSignInResponse) Reset() {
+	*x = CompleteOidcSignInResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CompleteOidcSignInResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CompleteOidcSignInResponse) ProtoMessage() {}
+
+func (x *CompleteOidcSignInResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CompleteOidcSignInResponse.ProtoReflect.Descriptor instead.
+func (*CompleteOidcSignInResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CompleteOidcSignInResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CompleteOidcSignInResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *CompleteOidcSignInResponse) GetSecondFactorRequired() bool {
+	if x != nil {
+		return x.SecondFactorRequired
+	}
+	return false
+}
+
+func (x *CompleteOidcSignInResponse) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+type SignOutRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutRequest) Reset() {
+	*x = SignOutRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutRequest) ProtoMessage() {}
+
+func (x *SignOutRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutRequest.ProtoReflect.Descriptor instead.
+func (*SignOutRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *SignOutRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type SignOutResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SignOutResponse) Reset() {
+	*x = SignOutResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SignOutResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SignOutResponse) ProtoMessage() {}
+
+func (x *SignOutResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SignOutResponse.ProtoReflect.Descriptor instead.
+func (*SignOutResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{11}
+}
+
+type RequestPasswordResetRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmailAddress  string                 `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetRequest) Reset() {
+	*x = RequestPasswordResetRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetRequest) ProtoMessage() {}
+
+func (x *RequestPasswordResetRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetRequest.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RequestPasswordResetRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+type RequestPasswordResetResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestPasswordResetResponse) Reset() {
+	*x = RequestPasswordResetResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestPasswordResetResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestPasswordResetResponse) ProtoMessage() {}
+
+func (x *RequestPasswordResetResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestPasswordResetResponse.ProtoReflect.Descriptor instead.
+func (*RequestPasswordResetResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{13}
+}
+
+type ResetPasswordRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Password      string                 `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordRequest) Reset() {
+	*x = ResetPasswordRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordRequest) ProtoMessage() {}
+
+func (x *ResetPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ResetPasswordRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ResetPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ResetPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetPasswordResponse) Reset() {
+	*x = ResetPasswordResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetPasswordResponse) ProtoMessage() {}
+
+func (x *ResetPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ResetPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{15}
+}
+
+type RequestMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmailAddress  string                 `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkRequest) Reset() {
+	*x = RequestMagicLinkRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkRequest) ProtoMessage() {}
+
+func (x *RequestMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *RequestMagicLinkRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+type RequestMagicLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestMagicLinkResponse) Reset() {
+	*x = RequestMagicLinkResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestMagicLinkResponse) ProtoMessage() {}
+
+func (x *RequestMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*RequestMagicLinkResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{17}
+}
+
+type ConsumeMagicLinkRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConsumeMagicLinkRequest) Reset() {
+	*x = ConsumeMagicLinkRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeMagicLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMagicLinkRequest) ProtoMessage() {}
+
+func (x *ConsumeMagicLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMagicLinkRequest.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ConsumeMagicLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type ConsumeMagicLinkResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	Token                string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	ExpiresAt            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	SecondFactorRequired bool                   `protobuf:"varint,3,opt,name=second_factor_required,json=secondFactorRequired,proto3" json:"second_factor_required,omitempty"`
+	ChallengeToken       string                 `protobuf:"bytes,4,opt,name=challenge_token,json=challengeToken,proto3" json:"challenge_token,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ConsumeMagicLinkResponse) Reset() {
+	*x = ConsumeMagicLinkResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConsumeMagicLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConsumeMagicLinkResponse) ProtoMessage() {}
+
+func (x *ConsumeMagicLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConsumeMagicLinkResponse.ProtoReflect.Descriptor instead.
+func (*ConsumeMagicLinkResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ConsumeMagicLinkResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ConsumeMagicLinkResponse) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ConsumeMagicLinkResponse) GetSecondFactorRequired() bool {
+	if x != nil {
+		return x.SecondFactorRequired
+	}
+	return false
+}
+
+func (x *ConsumeMagicLinkResponse) GetChallengeToken() string {
+	if x != nil {
+		return x.ChallengeToken
+	}
+	return ""
+}
+
+type VerifyEmailAddressRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailAddressRequest) Reset() {
+	*x = VerifyEmailAddressRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailAddressRequest) ProtoMessage() {}
+
+func (x *VerifyEmailAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailAddressRequest.ProtoReflect.Descriptor instead.
+func (*VerifyEmailAddressRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *VerifyEmailAddressRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type VerifyEmailAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyEmailAddressResponse) Reset() {
+	*x = VerifyEmailAddressResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyEmailAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyEmailAddressResponse) ProtoMessage() {}
+
+func (x *VerifyEmailAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyEmailAddressResponse.ProtoReflect.Descriptor instead.
+func (*VerifyEmailAddressResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{21}
+}
+
+type ResendEmailVerificationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EmailAddress  string                 `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendEmailVerificationRequest) Reset() {
+	*x = ResendEmailVerificationRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendEmailVerificationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEmailVerificationRequest) ProtoMessage() {}
+
+func (x *ResendEmailVerificationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendEmailVerificationRequest.ProtoReflect.Descriptor instead.
+func (*ResendEmailVerificationRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ResendEmailVerificationRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+type ResendEmailVerificationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResendEmailVerificationResponse) Reset() {
+	*x = ResendEmailVerificationResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResendEmailVerificationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResendEmailVerificationResponse) ProtoMessage() {}
+
+func (x *ResendEmailVerificationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResendEmailVerificationResponse.ProtoReflect.Descriptor instead.
+func (*ResendEmailVerificationResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{23}
+}
+
+type EnrollTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTotpRequest) Reset() {
+	*x = EnrollTotpRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTotpRequest) ProtoMessage() {}
+
+func (x *EnrollTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTotpRequest.ProtoReflect.Descriptor instead.
+func (*EnrollTotpRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{24}
+}
+
+type EnrollTotpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Secret        string                 `protobuf:"bytes,1,opt,name=secret,proto3" json:"secret,omitempty"`
+	Uri           string                 `protobuf:"bytes,2,opt,name=uri,proto3" json:"uri,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EnrollTotpResponse) Reset() {
+	*x = EnrollTotpResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EnrollTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EnrollTotpResponse) ProtoMessage() {}
+
+func (x *EnrollTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EnrollTotpResponse.ProtoReflect.Descriptor instead.
+func (*EnrollTotpResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *EnrollTotpResponse) GetSecret() string {
+	if x != nil {
+		return x.Secret
+	}
+	return ""
+}
+
+func (x *EnrollTotpResponse) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+type ConfirmTotpRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Code          string                 `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpRequest) Reset() {
+	*x = ConfirmTotpRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpRequest) ProtoMessage() {}
+
+func (x *ConfirmTotpRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpRequest.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ConfirmTotpRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type ConfirmTotpResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecoveryCodes []string               `protobuf:"bytes,1,rep,name=recovery_codes,json=recoveryCodes,proto3" json:"recovery_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConfirmTotpResponse) Reset() {
+	*x = ConfirmTotpResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConfirmTotpResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConfirmTotpResponse) ProtoMessage() {}
+
+func (x *ConfirmTotpResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConfirmTotpResponse.ProtoReflect.Descriptor instead.
+func (*ConfirmTotpResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *ConfirmTotpResponse) GetRecoveryCodes() []string {
+	if x != nil {
+		return x.RecoveryCodes
+	}
+	return nil
+}
+
+type ListSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsRequest) Reset() {
+	*x = ListSessionsRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsRequest) ProtoMessage() {}
+
+func (x *ListSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsRequest.ProtoReflect.Descriptor instead.
+func (*ListSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{28}
+}
+
+type ListSessionsResponse struct {
+	state         protoimpl.MessageState          `protogen:"open.v1"`
+	Sessions      []*ListSessionsResponse_Session `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse) Reset() {
+	*x = ListSessionsResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse) ProtoMessage() {}
+
+func (x *ListSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ListSessionsResponse) GetSessions() []*ListSessionsResponse_Session {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+type RevokeSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionRequest) Reset() {
+	*x = RevokeSessionRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionRequest) ProtoMessage() {}
+
+func (x *RevokeSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSessionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RevokeSessionRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type RevokeSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSessionResponse) Reset() {
+	*x = RevokeSessionResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSessionResponse) ProtoMessage() {}
+
+func (x *RevokeSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSessionResponse.ProtoReflect.Descriptor instead.
+func (*RevokeSessionResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{31}
+}
+
+type RevokeOtherSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsRequest) Reset() {
+	*x = RevokeOtherSessionsRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{32}
+}
+
+type RevokeOtherSessionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeOtherSessionsResponse) Reset() {
+	*x = RevokeOtherSessionsResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeOtherSessionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeOtherSessionsResponse) ProtoMessage() {}
+
+func (x *RevokeOtherSessionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeOtherSessionsResponse.ProtoReflect.Descriptor instead.
+func (*RevokeOtherSessionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{33}
+}
+
+type MeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeRequest) Reset() {
+	*x = MeRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeRequest) ProtoMessage() {}
+
+func (x *MeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeRequest.ProtoReflect.Descriptor instead.
+func (*MeRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{34}
+}
+
+type MeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *MeResponse_User       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Impersonated  bool                   `protobuf:"varint,2,opt,name=impersonated,proto3" json:"impersonated,omitempty"`
+	Impersonator  *MeResponse_User       `protobuf:"bytes,3,opt,name=impersonator,proto3" json:"impersonator,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeResponse) Reset() {
+	*x = MeResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeResponse) ProtoMessage() {}
+
+func (x *MeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeResponse.ProtoReflect.Descriptor instead.
+func (*MeResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *MeResponse) GetUser() *MeResponse_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MeResponse) GetImpersonated() bool {
+	if x != nil {
+		return x.Impersonated
+	}
+	return false
+}
+
+func (x *MeResponse) GetImpersonator() *MeResponse_User {
+	if x != nil {
+		return x.Impersonator
+	}
+	return nil
+}
+
+type UpdateProfileRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileRequest) Reset() {
+	*x = UpdateProfileRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileRequest) ProtoMessage() {}
+
+func (x *UpdateProfileRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProfileRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *UpdateProfileRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type UpdateProfileResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	User          *MeResponse_User       `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProfileResponse) Reset() {
+	*x = UpdateProfileResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProfileResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProfileResponse) ProtoMessage() {}
+
+func (x *UpdateProfileResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProfileResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProfileResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *UpdateProfileResponse) GetUser() *MeResponse_User {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type ChangePasswordRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword     string                 `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangePasswordRequest) Reset() {
+	*x = ChangePasswordRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordRequest) ProtoMessage() {}
+
+func (x *ChangePasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChangePasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangePasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangePasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangePasswordResponse) Reset() {
+	*x = ChangePasswordResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangePasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangePasswordResponse) ProtoMessage() {}
+
+func (x *ChangePasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangePasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{39}
+}
+
+type ChangeEmailAddressRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	EmailAddress    string                 `protobuf:"bytes,1,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	CurrentPassword string                 `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ChangeEmailAddressRequest) Reset() {
+	*x = ChangeEmailAddressRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmailAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmailAddressRequest) ProtoMessage() {}
+
+func (x *ChangeEmailAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmailAddressRequest.ProtoReflect.Descriptor instead.
+func (*ChangeEmailAddressRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChangeEmailAddressRequest) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+func (x *ChangeEmailAddressRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+type ChangeEmailAddressResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeEmailAddressResponse) Reset() {
+	*x = ChangeEmailAddressResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeEmailAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeEmailAddressResponse) ProtoMessage() {}
+
+func (x *ChangeEmailAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeEmailAddressResponse.ProtoReflect.Descriptor instead.
+func (*ChangeEmailAddressResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{41}
+}
+
+type DeleteAccountRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CurrentPassword string                 `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *DeleteAccountRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{43}
+}
+
+type ListSessionsResponse_Session struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	IpAddress     string                 `protobuf:"bytes,2,opt,name=ip_address,json=ipAddress,proto3" json:"ip_address,omitempty"`
+	UserAgent     string                 `protobuf:"bytes,3,opt,name=user_agent,json=userAgent,proto3" json:"user_agent,omitempty"`
+	LastSeenAt    *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=last_seen_at,json=lastSeenAt,proto3" json:"last_seen_at,omitempty"`
+	ExpiresAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Current       bool                   `protobuf:"varint,7,opt,name=current,proto3" json:"current,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListSessionsResponse_Session) Reset() {
+	*x = ListSessionsResponse_Session{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListSessionsResponse_Session) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSessionsResponse_Session) ProtoMessage() {}
+
+func (x *ListSessionsResponse_Session) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSessionsResponse_Session.ProtoReflect.Descriptor instead.
+func (*ListSessionsResponse_Session) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{29, 0}
+}
+
+func (x *ListSessionsResponse_Session) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse_Session) GetIpAddress() string {
+	if x != nil {
+		return x.IpAddress
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse_Session) GetUserAgent() string {
+	if x != nil {
+		return x.UserAgent
+	}
+	return ""
+}
+
+func (x *ListSessionsResponse_Session) GetLastSeenAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastSeenAt
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse_Session) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse_Session) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ListSessionsResponse_Session) GetCurrent() bool {
+	if x != nil {
+		return x.Current
+	}
+	return false
+}
+
+type MeResponse_User struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	EmailAddress  string                 `protobuf:"bytes,3,opt,name=email_address,json=emailAddress,proto3" json:"email_address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeResponse_User) Reset() {
+	*x = MeResponse_User{}
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeResponse_User) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeResponse_User) ProtoMessage() {}
+
+func (x *MeResponse_User) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_app_auth_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeResponse_User.ProtoReflect.Descriptor instead.
+func (*MeResponse_User) Descriptor() ([]byte, []int) {
+	return file_api_v1_app_auth_service_proto_rawDescGZIP(), []int{35, 0}
+}
+
+func (x *MeResponse_User) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MeResponse_User) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MeResponse_User) GetEmailAddress() string {
+	if x != nil {
+		return x.EmailAddress
+	}
+	return ""
+}
+
+var File_api_v1_app_auth_service_proto protoreflect.FileDescriptor
+
+const file_api_v1_app_auth_service_proto_rawDesc = "" +
+	"\n" +
+	"\x1dapi/v1/app/auth/service.proto\x12\x0fapi.v1.app.auth\x1a\x1fgoogle/protobuf/timestamp.proto\"d\n" +
+	"\rSignUpRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12#\n" +
+	"\remail_address\x18\x02 \x01(\tR\femailAddress\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"a\n" +
+	"\x0eSignUpResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"P\n" +
+	"\rSignInRequest\x12#\n" +
+	"\remail_address\x18\x01 \x01(\tR\femailAddress\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\xc0\x01\n" +
+	"\x0eSignInResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x124\n" +
+	"\x16second_factor_required\x18\x03 \x01(\bR\x14secondFactorRequired\x12'\n" +
+	"\x0fchallenge_token\x18\x04 \x01(\tR\x0echallengeToken\"T\n" +
+	"\x15CompleteSignInRequest\x12'\n" +
+	"\x0fchallenge_token\x18\x01 \x01(\tR\x0echallengeToken\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"i\n" +
+	"\x16CompleteSignInResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\"4\n" +
+	"\x16StartOidcSignInRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\"F\n" +
+	"\x17StartOidcSignInResponse\x12+\n" +
+	"\x11authorization_url\x18\x01 \x01(\tR\x10authorizationUrl\"a\n" +
+	"\x19CompleteOidcSignInRequest\x12\x1a\n" +
+	"\bprovider\x18\x01 \x01(\tR\bprovider\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\x12\x14\n" +
+	"\x05state\x18\x03 \x01(\tR\x05state\"\xcc\x01\n" +
+	"\x1aCompleteOidcSignInResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x124\n" +
+	"\x16second_factor_required\x18\x03 \x01(\bR\x14secondFactorRequired\x12'\n" +
+	"\x0fchallenge_token\x18\x04 \x01(\tR\x0echallengeToken\"&\n" +
+	"\x0eSignOutRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x11\n" +
+	"\x0fSignOutResponse\"B\n" +
+	"\x1bRequestPasswordResetRequest\x12#\n" +
+	"\remail_address\x18\x01 \x01(\tR\femailAddress\"\x1e\n" +
+	"\x1cRequestPasswordResetResponse\"H\n" +
+	"\x14ResetPasswordRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x17\n" +
+	"\x15ResetPasswordResponse\">\n" +
+	"\x17RequestMagicLinkRequest\x12#\n" +
+	"\remail_address\x18\x01 \x01(\tR\femailAddress\"\x1a\n" +
+	"\x18RequestMagicLinkResponse\"/\n" +
+	"\x17ConsumeMagicLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\xca\x01\n" +
+	"\x18ConsumeMagicLinkResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x129\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x124\n" +
+	"\x16second_factor_required\x18\x03 \x01(\bR\x14secondFactorRequired\x12'\n" +
+	"\x0fchallenge_token\x18\x04 \x01(\tR\x0echallengeToken\"1\n" +
+	"\x19VerifyEmailAddressRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x1c\n" +
+	"\x1aVerifyEmailAddressResponse\"E\n" +
+	"\x1eResendEmailVerificationRequest\x12#\n" +
+	"\remail_address\x18\x01 \x01(\tR\femailAddress\"!\n" +
+	"\x1fResendEmailVerificationResponse\"\x13\n" +
+	"\x11EnrollTotpRequest\">\n" +
+	"\x12EnrollTotpResponse\x12\x16\n" +
+	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x10\n" +
+	"\x03uri\x18\x02 \x01(\tR\x03uri\"(\n" +
+	"\x12ConfirmTotpRequest\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\"<\n" +
+	"\x13ConfirmTotpResponse\x12%\n" +
+	"\x0erecovery_codes\x18\x01 \x03(\tR\rrecoveryCodes\"\x15\n" +
+	"\x13ListSessionsRequest\"\x89\x03\n" +
+	"\x14ListSessionsResponse\x12I\n" +
+	"\bsessions\x18\x01 \x03(\v2-.api.v1.app.auth.ListSessionsResponse.SessionR\bsessions\x1a\xa5\x02\n" +
+	"\aSession\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1d\n" +
+	"\n" +
+	"ip_address\x18\x02 \x01(\tR\tipAddress\x12\x1d\n" +
+	"\n" +
+	"user_agent\x18\x03 \x01(\tR\tuserAgent\x12<\n" +
+	"\flast_seen_at\x18\x04 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"lastSeenAt\x129\n" +
+	"\n" +
+	"expires_at\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\texpiresAt\x129\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x18\n" +
+	"\acurrent\x18\a \x01(\bR\acurrent\"&\n" +
+	"\x14RevokeSessionRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\"\x17\n" +
+	"\x15RevokeSessionResponse\"\x1c\n" +
+	"\x1aRevokeOtherSessionsRequest\"\x1d\n" +
+	"\x1bRevokeOtherSessionsResponse\"\v\n" +
+	"\tMeRequest\"\xfd\x01\n" +
+	"\n" +
+	"MeResponse\x124\n" +
+	"\x04user\x18\x01 \x01(\v2 .api.v1.app.auth.MeResponse.UserR\x04user\x12\"\n" +
+	"\fimpersonated\x18\x02 \x01(\bR\fimpersonated\x12D\n" +
+	"\fimpersonator\x18\x03 \x01(\v2 .api.v1.app.auth.MeResponse.UserR\fimpersonator\x1aO\n" +
+	"\x04User\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12#\n" +
+	"\remail_address\x18\x03 \x01(\tR\femailAddress\"*\n" +
+	"\x14UpdateProfileRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"M\n" +
+	"\x15UpdateProfileResponse\x124\n" +
+	"\x04user\x18\x01 \x01(\v2 .api.v1.app.auth.MeResponse.UserR\x04user\"e\n" +
+	"\x15ChangePasswordRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\x12!\n" +
+	"\fnew_password\x18\x02 \x01(\tR\vnewPassword\"\x18\n" +
+	"\x16ChangePasswordResponse\"k\n" +
+	"\x19ChangeEmailAddressRequest\x12#\n" +
+	"\remail_address\x18\x01 \x01(\tR\femailAddress\x12)\n" +
+	"\x10current_password\x18\x02 \x01(\tR\x0fcurrentPassword\"\x1c\n" +
+	"\x1aChangeEmailAddressResponse\"A\n" +
+	"\x14DeleteAccountRequest\x12)\n" +
+	"\x10current_password\x18\x01 \x01(\tR\x0fcurrentPassword\"\x17\n" +
+	"\x15DeleteAccountResponse2\xea\x10\n" +
+	"\aService\x12I\n" +
+	"\x06SignUp\x12\x1e.api.v1.app.auth.SignUpRequest\x1a\x1f.api.v1.app.auth.SignUpResponse\x12I\n" +
+	"\x06SignIn\x12\x1e.api.v1.app.auth.SignInRequest\x1a\x1f.api.v1.app.auth.SignInResponse\x12a\n" +
+	"\x0eCompleteSignIn\x12&.api.v1.app.auth.CompleteSignInRequest\x1a'.api.v1.app.auth.CompleteSignInResponse\x12d\n" +
+	"\x0fStartOidcSignIn\x12'.api.v1.app.auth.StartOidcSignInRequest\x1a(.api.v1.app.auth.StartOidcSignInResponse\x12m\n" +
+	"\x12CompleteOidcSignIn\x12*.api.v1.app.auth.CompleteOidcSignInRequest\x1a+.api.v1.app.auth.CompleteOidcSignInResponse\x12L\n" +
+	"\aSignOut\x12\x1f.api.v1.app.auth.SignOutRequest\x1a .api.v1.app.auth.SignOutResponse\x12s\n" +
+	"\x14RequestPasswordReset\x12,.api.v1.app.auth.RequestPasswordResetRequest\x1a-.api.v1.app.auth.RequestPasswordResetResponse\x12^\n" +
+	"\rResetPassword\x12%.api.v1.app.auth.ResetPasswordRequest\x1a&.api.v1.app.auth.ResetPasswordResponse\x12g\n" +
+	"\x10RequestMagicLink\x12(.api.v1.app.auth.RequestMagicLinkRequest\x1a).api.v1.app.auth.RequestMagicLinkResponse\x12g\n" +
+	"\x10ConsumeMagicLink\x12(.api.v1.app.auth.ConsumeMagicLinkRequest\x1a).api.v1.app.auth.ConsumeMagicLinkResponse\x12m\n" +
+	"\x12VerifyEmailAddress\x12*.api.v1.app.auth.VerifyEmailAddressRequest\x1a+.api.v1.app.auth.VerifyEmailAddressResponse\x12|\n" +
+	"\x17ResendEmailVerification\x12/.api.v1.app.auth.ResendEmailVerificationRequest\x1a0.api.v1.app.auth.ResendEmailVerificationResponse\x12U\n" +
+	"\n" +
+	"EnrollTotp\x12\".api.v1.app.auth.EnrollTotpRequest\x1a#.api.v1.app.auth.EnrollTotpResponse\x12X\n" +
+	"\vConfirmTotp\x12#.api.v1.app.auth.ConfirmTotpRequest\x1a$.api.v1.app.auth.ConfirmTotpResponse\x12[\n" +
+	"\fListSessions\x12$.api.v1.app.auth.ListSessionsRequest\x1a%.api.v1.app.auth.ListSessionsResponse\x12^\n" +
+	"\rRevokeSession\x12%.api.v1.app.auth.RevokeSessionRequest\x1a&.api.v1.app.auth.RevokeSessionResponse\x12p\n" +
+	"\x13RevokeOtherSessions\x12+.api.v1.app.auth.RevokeOtherSessionsRequest\x1a,.api.v1.app.auth.RevokeOtherSessionsResponse\x12=\n" +
+	"\x02Me\x12\x1a.api.v1.app.auth.MeRequest\x1a\x1b.api.v1.app.auth.MeResponse\x12^\n" +
+	"\rUpdateProfile\x12%.api.v1.app.auth.UpdateProfileRequest\x1a&.api.v1.app.auth.UpdateProfileResponse\x12a\n" +
+	"\x0eChangePassword\x12&.api.v1.app.auth.ChangePasswordRequest\x1a'.api.v1.app.auth.ChangePasswordResponse\x12m\n" +
+	"\x12ChangeEmailAddress\x12*.api.v1.app.auth.ChangeEmailAddressRequest\x1a+.api.v1.app.auth.ChangeEmailAddressResponse\x12^\n" +
+	"\rDeleteAccount\x12%.api.v1.app.auth.DeleteAccountRequest\x1a&.api.v1.app.auth.DeleteAccountResponseB3Z1github.com/anonychun/bibit/pkg/pb/api/v1/app/authb\x06proto3"
 
 var (
 	file_api_v1_app_auth_service_proto_rawDescOnce sync.Once
@@ -486,33 +2335,118 @@ func file_api_v1_app_auth_service_proto_rawDescGZIP() []byte {
 	return file_api_v1_app_auth_service_proto_rawDescData
 }
 
-var file_api_v1_app_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_api_v1_app_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
 var file_api_v1_app_auth_service_proto_goTypes = []any{
-	(*SignUpRequest)(nil),   // 0: api.v1.app.auth.SignUpRequest
-	(*SignUpResponse)(nil),  // 1: api.v1.app.auth.SignUpResponse
-	(*SignInRequest)(nil),   // 2: api.v1.app.auth.SignInRequest
-	(*SignInResponse)(nil),  // 3: api.v1.app.auth.SignInResponse
-	(*SignOutRequest)(nil),  // 4: api.v1.app.auth.SignOutRequest
-	(*SignOutResponse)(nil), // 5: api.v1.app.auth.SignOutResponse
-	(*MeRequest)(nil),       // 6: api.v1.app.auth.MeRequest
-	(*MeResponse)(nil),      // 7: api.v1.app.auth.MeResponse
-	(*MeResponse_User)(nil), // 8: api.v1.app.auth.MeResponse.User
+	(*SignUpRequest)(nil),                   // 0: api.v1.app.auth.SignUpRequest
+	(*SignUpResponse)(nil),                  // 1: api.v1.app.auth.SignUpResponse
+	(*SignInRequest)(nil),                   // 2: api.v1.app.auth.SignInRequest
+	(*SignInResponse)(nil),                  // 3: api.v1.app.auth.SignInResponse
+	(*CompleteSignInRequest)(nil),           // 4: api.v1.app.auth.CompleteSignInRequest
+	(*CompleteSignInResponse)(nil),          // 5: api.v1.app.auth.CompleteSignInResponse
+	(*StartOidcSignInRequest)(nil),          // 6: api.v1.app.auth.StartOidcSignInRequest
+	(*StartOidcSignInResponse)(nil),         // 7: api.v1.app.auth.StartOidcSignInResponse
+	(*CompleteOidcSignInRequest)(nil),       // 8: api.v1.app.auth.CompleteOidcSignInRequest
+	(*CompleteOidcSignInResponse)(nil),      // 9: api.v1.app.auth.CompleteOidcSignInResponse
+	(*SignOutRequest)(nil),                  // 10: api.v1.app.auth.SignOutRequest
+	(*SignOutResponse)(nil),                 // 11: api.v1.app.auth.SignOutResponse
+	(*RequestPasswordResetRequest)(nil),     // 12: api.v1.app.auth.RequestPasswordResetRequest
+	(*RequestPasswordResetResponse)(nil),    // 13: api.v1.app.auth.RequestPasswordResetResponse
+	(*ResetPasswordRequest)(nil),            // 14: api.v1.app.auth.ResetPasswordRequest
+	(*ResetPasswordResponse)(nil),           // 15: api.v1.app.auth.ResetPasswordResponse
+	(*RequestMagicLinkRequest)(nil),         // 16: api.v1.app.auth.RequestMagicLinkRequest
+	(*RequestMagicLinkResponse)(nil),        // 17: api.v1.app.auth.RequestMagicLinkResponse
+	(*ConsumeMagicLinkRequest)(nil),         // 18: api.v1.app.auth.ConsumeMagicLinkRequest
+	(*ConsumeMagicLinkResponse)(nil),        // 19: api.v1.app.auth.ConsumeMagicLinkResponse
+	(*VerifyEmailAddressRequest)(nil),       // 20: api.v1.app.auth.VerifyEmailAddressRequest
+	(*VerifyEmailAddressResponse)(nil),      // 21: api.v1.app.auth.VerifyEmailAddressResponse
+	(*ResendEmailVerificationRequest)(nil),  // 22: api.v1.app.auth.ResendEmailVerificationRequest
+	(*ResendEmailVerificationResponse)(nil), // 23: api.v1.app.auth.ResendEmailVerificationResponse
+	(*EnrollTotpRequest)(nil),               // 24: api.v1.app.auth.EnrollTotpRequest
+	(*EnrollTotpResponse)(nil),              // 25: api.v1.app.auth.EnrollTotpResponse
+	(*ConfirmTotpRequest)(nil),              // 26: api.v1.app.auth.ConfirmTotpRequest
+	(*ConfirmTotpResponse)(nil),             // 27: api.v1.app.auth.ConfirmTotpResponse
+	(*ListSessionsRequest)(nil),             // 28: api.v1.app.auth.ListSessionsRequest
+	(*ListSessionsResponse)(nil),            // 29: api.v1.app.auth.ListSessionsResponse
+	(*RevokeSessionRequest)(nil),            // 30: api.v1.app.auth.RevokeSessionRequest
+	(*RevokeSessionResponse)(nil),           // 31: api.v1.app.auth.RevokeSessionResponse
+	(*RevokeOtherSessionsRequest)(nil),      // 32: api.v1.app.auth.RevokeOtherSessionsRequest
+	(*RevokeOtherSessionsResponse)(nil),     // 33: api.v1.app.auth.RevokeOtherSessionsResponse
+	(*MeRequest)(nil),                       // 34: api.v1.app.auth.MeRequest
+	(*MeResponse)(nil),                      // 35: api.v1.app.auth.MeResponse
+	(*UpdateProfileRequest)(nil),            // 36: api.v1.app.auth.UpdateProfileRequest
+	(*UpdateProfileResponse)(nil),           // 37: api.v1.app.auth.UpdateProfileResponse
+	(*ChangePasswordRequest)(nil),           // 38: api.v1.app.auth.ChangePasswordRequest
+	(*ChangePasswordResponse)(nil),          // 39: api.v1.app.auth.ChangePasswordResponse
+	(*ChangeEmailAddressRequest)(nil),       // 40: api.v1.app.auth.ChangeEmailAddressRequest
+	(*ChangeEmailAddressResponse)(nil),      // 41: api.v1.app.auth.ChangeEmailAddressResponse
+	(*DeleteAccountRequest)(nil),            // 42: api.v1.app.auth.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),           // 43: api.v1.app.auth.DeleteAccountResponse
+	(*ListSessionsResponse_Session)(nil),    // 44: api.v1.app.auth.ListSessionsResponse.Session
+	(*MeResponse_User)(nil),                 // 45: api.v1.app.auth.MeResponse.User
+	(*timestamppb.Timestamp)(nil),           // 46: google.protobuf.Timestamp
 }
 var file_api_v1_app_auth_service_proto_depIdxs = []int32{
-	8, // 0: api.v1.app.auth.MeResponse.user:type_name -> api.v1.app.auth.MeResponse.User
-	0, // 1: api.v1.app.auth.Service.SignUp:input_type -> api.v1.app.auth.SignUpRequest
-	2, // 2: api.v1.app.auth.Service.SignIn:input_type -> api.v1.app.auth.SignInRequest
-	4, // 3: api.v1.app.auth.Service.SignOut:input_type -> api.v1.app.auth.SignOutRequest
-	6, // 4: api.v1.app.auth.Service.Me:input_type -> api.v1.app.auth.MeRequest
-	1, // 5: api.v1.app.auth.Service.SignUp:output_type -> api.v1.app.auth.SignUpResponse
-	3, // 6: api.v1.app.auth.Service.SignIn:output_type -> api.v1.app.auth.SignInResponse
-	5, // 7: api.v1.app.auth.Service.SignOut:output_type -> api.v1.app.auth.SignOutResponse
-	7, // 8: api.v1.app.auth.Service.Me:output_type -> api.v1.app.auth.MeResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	46, // 0: api.v1.app.auth.SignUpResponse.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 1: api.v1.app.auth.SignInResponse.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 2: api.v1.app.auth.CompleteSignInResponse.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 3: api.v1.app.auth.CompleteOidcSignInResponse.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 4: api.v1.app.auth.ConsumeMagicLinkResponse.expires_at:type_name -> google.protobuf.Timestamp
+	44, // 5: api.v1.app.auth.ListSessionsResponse.sessions:type_name -> api.v1.app.auth.ListSessionsResponse.Session
+	45, // 6: api.v1.app.auth.MeResponse.user:type_name -> api.v1.app.auth.MeResponse.User
+	45, // 7: api.v1.app.auth.MeResponse.impersonator:type_name -> api.v1.app.auth.MeResponse.User
+	45, // 8: api.v1.app.auth.UpdateProfileResponse.user:type_name -> api.v1.app.auth.MeResponse.User
+	46, // 9: api.v1.app.auth.ListSessionsResponse.Session.last_seen_at:type_name -> google.protobuf.Timestamp
+	46, // 10: api.v1.app.auth.ListSessionsResponse.Session.expires_at:type_name -> google.protobuf.Timestamp
+	46, // 11: api.v1.app.auth.ListSessionsResponse.Session.created_at:type_name -> google.protobuf.Timestamp
+	0,  // 12: api.v1.app.auth.Service.SignUp:input_type -> api.v1.app.auth.SignUpRequest
+	2,  // 13: api.v1.app.auth.Service.SignIn:input_type -> api.v1.app.auth.SignInRequest
+	4,  // 14: api.v1.app.auth.Service.CompleteSignIn:input_type -> api.v1.app.auth.CompleteSignInRequest
+	6,  // 15: api.v1.app.auth.Service.StartOidcSignIn:input_type -> api.v1.app.auth.StartOidcSignInRequest
+	8,  // 16: api.v1.app.auth.Service.CompleteOidcSignIn:input_type -> api.v1.app.auth.CompleteOidcSignInRequest
+	10, // 17: api.v1.app.auth.Service.SignOut:input_type -> api.v1.app.auth.SignOutRequest
+	12, // 18: api.v1.app.auth.Service.RequestPasswordReset:input_type -> api.v1.app.auth.RequestPasswordResetRequest
+	14, // 19: api.v1.app.auth.Service.ResetPassword:input_type -> api.v1.app.auth.ResetPasswordRequest
+	16, // 20: api.v1.app.auth.Service.RequestMagicLink:input_type -> api.v1.app.auth.RequestMagicLinkRequest
+	18, // 21: api.v1.app.auth.Service.ConsumeMagicLink:input_type -> api.v1.app.auth.ConsumeMagicLinkRequest
+	20, // 22: api.v1.app.auth.Service.VerifyEmailAddress:input_type -> api.v1.app.auth.VerifyEmailAddressRequest
+	22, // 23: api.v1.app.auth.Service.ResendEmailVerification:input_type -> api.v1.app.auth.ResendEmailVerificationRequest
+	24, // 24: api.v1.app.auth.Service.EnrollTotp:input_type -> api.v1.app.auth.EnrollTotpRequest
+	26, // 25: api.v1.app.auth.Service.ConfirmTotp:input_type -> api.v1.app.auth.ConfirmTotpRequest
+	28, // 26: api.v1.app.auth.Service.ListSessions:input_type -> api.v1.app.auth.ListSessionsRequest
+	30, // 27: api.v1.app.auth.Service.RevokeSession:input_type -> api.v1.app.auth.RevokeSessionRequest
+	32, // 28: api.v1.app.auth.Service.RevokeOtherSessions:input_type -> api.v1.app.auth.RevokeOtherSessionsRequest
+	34, // 29: api.v1.app.auth.Service.Me:input_type -> api.v1.app.auth.MeRequest
+	36, // 30: api.v1.app.auth.Service.UpdateProfile:input_type -> api.v1.app.auth.UpdateProfileRequest
+	38, // 31: api.v1.app.auth.Service.ChangePassword:input_type -> api.v1.app.auth.ChangePasswordRequest
+	40, // 32: api.v1.app.auth.Service.ChangeEmailAddress:input_type -> api.v1.app.auth.ChangeEmailAddressRequest
+	42, // 33: api.v1.app.auth.Service.DeleteAccount:input_type -> api.v1.app.auth.DeleteAccountRequest
+	1,  // 34: api.v1.app.auth.Service.SignUp:output_type -> api.v1.app.auth.SignUpResponse
+	3,  // 35: api.v1.app.auth.Service.SignIn:output_type -> api.v1.app.auth.SignInResponse
+	5,  // 36: api.v1.app.auth.Service.CompleteSignIn:output_type -> api.v1.app.auth.CompleteSignInResponse
+	7,  // 37: api.v1.app.auth.Service.StartOidcSignIn:output_type -> api.v1.app.auth.StartOidcSignInResponse
+	9,  // 38: api.v1.app.auth.Service.CompleteOidcSignIn:output_type -> api.v1.app.auth.CompleteOidcSignInResponse
+	11, // 39: api.v1.app.auth.Service.SignOut:output_type -> api.v1.app.auth.SignOutResponse
+	13, // 40: api.v1.app.auth.Service.RequestPasswordReset:output_type -> api.v1.app.auth.RequestPasswordResetResponse
+	15, // 41: api.v1.app.auth.Service.ResetPassword:output_type -> api.v1.app.auth.ResetPasswordResponse
+	17, // 42: api.v1.app.auth.Service.RequestMagicLink:output_type -> api.v1.app.auth.RequestMagicLinkResponse
+	19, // 43: api.v1.app.auth.Service.ConsumeMagicLink:output_type -> api.v1.app.auth.ConsumeMagicLinkResponse
+	21, // 44: api.v1.app.auth.Service.VerifyEmailAddress:output_type -> api.v1.app.auth.VerifyEmailAddressResponse
+	23, // 45: api.v1.app.auth.Service.ResendEmailVerification:output_type -> api.v1.app.auth.ResendEmailVerificationResponse
+	25, // 46: api.v1.app.auth.Service.EnrollTotp:output_type -> api.v1.app.auth.EnrollTotpResponse
+	27, // 47: api.v1.app.auth.Service.ConfirmTotp:output_type -> api.v1.app.auth.ConfirmTotpResponse
+	29, // 48: api.v1.app.auth.Service.ListSessions:output_type -> api.v1.app.auth.ListSessionsResponse
+	31, // 49: api.v1.app.auth.Service.RevokeSession:output_type -> api.v1.app.auth.RevokeSessionResponse
+	33, // 50: api.v1.app.auth.Service.RevokeOtherSessions:output_type -> api.v1.app.auth.RevokeOtherSessionsResponse
+	35, // 51: api.v1.app.auth.Service.Me:output_type -> api.v1.app.auth.MeResponse
+	37, // 52: api.v1.app.auth.Service.UpdateProfile:output_type -> api.v1.app.auth.UpdateProfileResponse
+	39, // 53: api.v1.app.auth.Service.ChangePassword:output_type -> api.v1.app.auth.ChangePasswordResponse
+	41, // 54: api.v1.app.auth.Service.ChangeEmailAddress:output_type -> api.v1.app.auth.ChangeEmailAddressResponse
+	43, // 55: api.v1.app.auth.Service.DeleteAccount:output_type -> api.v1.app.auth.DeleteAccountResponse
+	34, // [34:56] is the sub-list for method output_type
+	12, // [12:34] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_api_v1_app_auth_service_proto_init() }
@@ -526,7 +2460,7 @@ func file_api_v1_app_auth_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_app_auth_service_proto_rawDesc), len(file_api_v1_app_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   9,
+			NumMessages:   46,
 			NumExtensions: 0,
 			NumServices:   1,
 		},